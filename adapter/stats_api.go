@@ -15,6 +15,7 @@
 package adapter
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -52,6 +53,27 @@ type StatsAPI interface {
 	UpdateDir(dir *StatDir) error
 }
 
+// StatsAPIContext extends StatsAPI with the context-aware variants of its methods.
+// The calls return the context error if the context is done before the stats
+// could be accessed, e.g. while waiting for the socket or for the stats segment
+// being updated by VPP.
+type StatsAPIContext interface {
+	StatsAPI
+
+	// ConnectContext establishes client connection to the stats API.
+	ConnectContext(ctx context.Context) error
+	// ListStatsContext lists indexed names for stats matching patterns.
+	ListStatsContext(ctx context.Context, patterns ...string) (indexes []StatIdentifier, err error)
+	// DumpStatsContext dumps all stat entries.
+	DumpStatsContext(ctx context.Context, patterns ...string) (entries []StatEntry, err error)
+	// PrepareDirContext prepares new stat dir for entries that match any of prefixes.
+	PrepareDirContext(ctx context.Context, patterns ...string) (*StatDir, error)
+	// PrepareDirOnIndexContext prepares new stat dir for entries that match any of indexes.
+	PrepareDirOnIndexContext(ctx context.Context, indexes ...uint32) (*StatDir, error)
+	// UpdateDirContext updates stat dir and all of their entries.
+	UpdateDirContext(ctx context.Context, dir *StatDir) error
+}
+
 // StatType represents type of stat directory and simply
 // defines what type of stat data is stored in the stat entry.
 type StatType string
@@ -285,6 +307,39 @@ func (s EmptyStat) Type() StatType {
 	return Empty
 }
 
+// ReduceSimpleCounterStat returns SimpleCounterStat s reduced for all indexes,
+// the counters of all threads are summed. The per-thread vectors may differ
+// in length.
+func ReduceSimpleCounterStat(s SimpleCounterStat) []uint64 {
+	var vals []uint64
+	for _, w := range s {
+		for i, v := range w {
+			if i >= len(vals) {
+				vals = append(vals, make([]uint64, i-len(vals)+1)...)
+			}
+			vals[i] += uint64(v)
+		}
+	}
+	return vals
+}
+
+// ReduceCombinedCounterStat returns CombinedCounterStat s reduced for all
+// indexes, the counters of all threads are summed. The per-thread vectors may
+// differ in length.
+func ReduceCombinedCounterStat(s CombinedCounterStat) [][2]uint64 {
+	var vals [][2]uint64
+	for _, w := range s {
+		for i, v := range w {
+			if i >= len(vals) {
+				vals = append(vals, make([][2]uint64, i-len(vals)+1)...)
+			}
+			vals[i][0] += v[0]
+			vals[i][1] += v[1]
+		}
+	}
+	return vals
+}
+
 // ReduceSimpleCounterStatIndex returns reduced SimpleCounterStat s for index i.
 func ReduceSimpleCounterStatIndex(s SimpleCounterStat, i int) uint64 {
 	var val uint64
@@ -0,0 +1,260 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package adapter
+
+import (
+	"math"
+)
+
+// HistogramBucket represents a classic (cumulative) histogram bucket.
+type HistogramBucket struct {
+	// UpperBound is the inclusive upper bound of the bucket, +Inf for the last bucket.
+	UpperBound float64
+	// CumulativeCount is the number of observations less or equal to UpperBound.
+	CumulativeCount uint64
+}
+
+// NativeHistogramSpan represents a span of consecutive buckets of a native histogram.
+type NativeHistogramSpan struct {
+	// Offset is the gap to the previous span, or the index of the first bucket for the first span.
+	Offset int32
+	// Length is the number of consecutive buckets in the span.
+	Length uint32
+}
+
+// NativeHistogram represents a Prometheus native (sparse) histogram with exponential
+// buckets. The bucket with index i covers the range (2^(i-1), 2^i] for schema 0.
+type NativeHistogram struct {
+	Schema         int32
+	Count          uint64
+	PositiveSpans  []NativeHistogramSpan
+	PositiveDeltas []int64 // count deltas between consecutive buckets
+}
+
+// lowerBound returns the smallest value counted in the bin j.
+func (b HistogramLog2Bin) lowerBound(j int) float64 {
+	return math.Ldexp(1, int(b.MinExp)+j)
+}
+
+// upperBound returns the largest value counted in the bin j.
+func (b HistogramLog2Bin) upperBound(j int) float64 {
+	return math.Ldexp(1, int(b.MinExp)+j+1) - 1
+}
+
+// Total returns the total number of observations.
+func (b HistogramLog2Bin) Total() uint64 {
+	var total uint64
+	for _, c := range b.Counts {
+		total += c
+	}
+	return total
+}
+
+// Mean returns the estimated mean of the observations using midpoints of the bins.
+// It returns NaN if there are no observations.
+func (b HistogramLog2Bin) Mean() float64 {
+	var total, sum float64
+	for j, c := range b.Counts {
+		if c == 0 {
+			continue
+		}
+		total += float64(c)
+		sum += float64(c) * (b.lowerBound(j) + b.upperBound(j)) / 2
+	}
+	if total == 0 {
+		return math.NaN()
+	}
+	return sum / total
+}
+
+// Percentile returns the estimated p-th percentile (0-100) of the observations.
+// The value is interpolated linearly within the bin containing the percentile,
+// the same way Prometheus histogram_quantile does. It returns NaN if there are
+// no observations or p is out of range.
+func (b HistogramLog2Bin) Percentile(p float64) float64 {
+	total := b.Total()
+	if total == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return math.NaN()
+	}
+	rank := p / 100 * float64(total)
+	var cumulative float64
+	for j, c := range b.Counts {
+		if c == 0 {
+			continue
+		}
+		if cumulative+float64(c) >= rank {
+			lo, hi := b.lowerBound(j), b.upperBound(j)
+			return lo + (hi-lo)*(rank-cumulative)/float64(c)
+		}
+		cumulative += float64(c)
+	}
+	// unreachable for p <= 100, kept for floating point rounding
+	for j := len(b.Counts) - 1; j >= 0; j-- {
+		if b.Counts[j] > 0 {
+			return b.upperBound(j)
+		}
+	}
+	return math.NaN()
+}
+
+// Sub returns the change of the counts since the previous histogram. If any
+// of the counts decreased, the histogram was reset and the current one is returned.
+func (b HistogramLog2Bin) Sub(prev HistogramLog2Bin) HistogramLog2Bin {
+	minExp := b.MinExp
+	if len(prev.Counts) > 0 && prev.MinExp < minExp {
+		minExp = prev.MinExp
+	}
+	cur := b.align(minExp)
+	prev = prev.align(minExp)
+	reset := HistogramLog2Bin{
+		MinExp: b.MinExp,
+		Counts: append([]uint64(nil), b.Counts...),
+	}
+	if len(prev.Counts) > len(cur.Counts) {
+		return reset
+	}
+	delta := HistogramLog2Bin{
+		MinExp: minExp,
+		Counts: make([]uint64, len(cur.Counts)),
+	}
+	for j, c := range cur.Counts {
+		var p uint64
+		if j < len(prev.Counts) {
+			p = prev.Counts[j]
+		}
+		if c < p {
+			return reset
+		}
+		delta.Counts[j] = c - p
+	}
+	return delta
+}
+
+// align returns the bin re-based to the lower minimal exponent.
+func (b HistogramLog2Bin) align(minExp uint64) HistogramLog2Bin {
+	if b.MinExp <= minExp {
+		return b
+	}
+	shift := int(b.MinExp - minExp)
+	counts := make([]uint64, shift+len(b.Counts))
+	copy(counts[shift:], b.Counts)
+	return HistogramLog2Bin{MinExp: minExp, Counts: counts}
+}
+
+// ClassicBuckets converts the histogram into classic cumulative buckets with
+// inclusive upper bounds 2^(MinExp+j+1)-1 followed by the +Inf bucket.
+func (b HistogramLog2Bin) ClassicBuckets() []HistogramBucket {
+	buckets := make([]HistogramBucket, 0, len(b.Counts)+1)
+	var cumulative uint64
+	for j, c := range b.Counts {
+		cumulative += c
+		buckets = append(buckets, HistogramBucket{
+			UpperBound:      b.upperBound(j),
+			CumulativeCount: cumulative,
+		})
+	}
+	return append(buckets, HistogramBucket{
+		UpperBound:      math.Inf(1),
+		CumulativeCount: cumulative,
+	})
+}
+
+// NativeHistogram converts the histogram into a native histogram with schema 0.
+// The bin j is mapped to the bucket with index MinExp+j+1, the observations equal
+// to the lower bound of the bin are thus reported in the next bucket.
+func (b HistogramLog2Bin) NativeHistogram() NativeHistogram {
+	h := NativeHistogram{
+		Count: b.Total(),
+	}
+	var prev int64
+	lastIndex := int32(0)
+	first := true
+	for j, c := range b.Counts {
+		if c == 0 {
+			continue
+		}
+		index := int32(b.MinExp) + int32(j) + 1
+		switch {
+		case first:
+			h.PositiveSpans = append(h.PositiveSpans, NativeHistogramSpan{Offset: index, Length: 1})
+			first = false
+		case index == lastIndex+1:
+			h.PositiveSpans[len(h.PositiveSpans)-1].Length++
+		default:
+			h.PositiveSpans = append(h.PositiveSpans, NativeHistogramSpan{Offset: index - lastIndex - 1, Length: 1})
+		}
+		h.PositiveDeltas = append(h.PositiveDeltas, int64(c)-prev)
+		prev = int64(c)
+		lastIndex = index
+	}
+	return h
+}
+
+// Merge merges histograms of all threads into a single histogram.
+func (s HistogramLog2Stat) Merge() HistogramLog2Bin {
+	var merged HistogramLog2Bin
+	first := true
+	for _, bin := range s {
+		if len(bin.Counts) == 0 {
+			continue
+		}
+		if first || bin.MinExp < merged.MinExp {
+			merged = merged.align(bin.MinExp)
+			merged.MinExp = bin.MinExp
+			first = false
+		}
+		bin = bin.align(merged.MinExp)
+		if len(bin.Counts) > len(merged.Counts) {
+			merged.Counts = append(merged.Counts, make([]uint64, len(bin.Counts)-len(merged.Counts))...)
+		}
+		for j, c := range bin.Counts {
+			merged.Counts[j] += c
+		}
+	}
+	return merged
+}
+
+// Sub returns the per-thread change of the counts since the previous snapshot.
+// Threads that are new or were reset are returned as they are.
+func (s HistogramLog2Stat) Sub(prev HistogramLog2Stat) HistogramLog2Stat {
+	delta := make(HistogramLog2Stat, len(s))
+	for i, bin := range s {
+		if i < len(prev) {
+			delta[i] = bin.Sub(prev[i])
+		} else {
+			delta[i] = bin.Sub(HistogramLog2Bin{MinExp: bin.MinExp})
+		}
+	}
+	return delta
+}
+
+// Total returns the total number of observations of all threads.
+func (s HistogramLog2Stat) Total() uint64 {
+	var total uint64
+	for _, bin := range s {
+		total += bin.Total()
+	}
+	return total
+}
+
+// Mean returns the estimated mean of the observations of all threads.
+func (s HistogramLog2Stat) Mean() float64 {
+	return s.Merge().Mean()
+}
+
+// Percentile returns the estimated p-th percentile (0-100) of the observations of all threads.
+func (s HistogramLog2Stat) Percentile(p float64) float64 {
+	return s.Merge().Percentile(p)
+}
@@ -0,0 +1,99 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package adapter
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestHistogramLog2Percentile(t *testing.T) {
+	RegisterTestingT(t)
+
+	// bins: [4,7]: 10, [8,15]: 0, [16,31]: 10
+	bin := HistogramLog2Bin{MinExp: 2, Counts: []uint64{10, 0, 10}}
+
+	Expect(bin.Total()).To(BeEquivalentTo(20))
+	Expect(bin.Mean()).To(BeNumerically("~", (5.5+23.5)/2))
+	Expect(bin.Percentile(0)).To(BeNumerically("~", 4))
+	Expect(bin.Percentile(25)).To(BeNumerically("~", 5.5))
+	Expect(bin.Percentile(50)).To(BeNumerically("~", 7))
+	Expect(bin.Percentile(75)).To(BeNumerically("~", 23.5))
+	Expect(bin.Percentile(100)).To(BeNumerically("~", 31))
+	Expect(math.IsNaN(bin.Percentile(101))).To(BeTrue())
+	Expect(math.IsNaN(HistogramLog2Bin{}.Percentile(50))).To(BeTrue())
+	Expect(math.IsNaN(HistogramLog2Bin{}.Mean())).To(BeTrue())
+}
+
+func TestHistogramLog2Merge(t *testing.T) {
+	RegisterTestingT(t)
+
+	stat := HistogramLog2Stat{
+		{MinExp: 3, Counts: []uint64{1, 2}},
+		{MinExp: 1, Counts: []uint64{5}},
+		{MinExp: 2, Counts: []uint64{1, 1, 1, 1}},
+		{},
+	}
+	merged := stat.Merge()
+	Expect(merged).To(Equal(HistogramLog2Bin{MinExp: 1, Counts: []uint64{5, 1, 2, 3, 1}}))
+	Expect(stat.Total()).To(BeEquivalentTo(12))
+	Expect(stat.Percentile(50)).To(Equal(merged.Percentile(50)))
+}
+
+func TestHistogramLog2Sub(t *testing.T) {
+	RegisterTestingT(t)
+
+	prev := HistogramLog2Stat{
+		{MinExp: 2, Counts: []uint64{1, 2}},
+		{MinExp: 2, Counts: []uint64{5, 5}},
+	}
+	cur := HistogramLog2Stat{
+		{MinExp: 2, Counts: []uint64{3, 2, 4}},
+		{MinExp: 2, Counts: []uint64{1, 0}},
+		{MinExp: 2, Counts: []uint64{7}},
+	}
+	Expect(cur.Sub(prev)).To(Equal(HistogramLog2Stat{
+		{MinExp: 2, Counts: []uint64{2, 0, 4}},
+		{MinExp: 2, Counts: []uint64{1, 0}}, // reset
+		{MinExp: 2, Counts: []uint64{7}},    // new thread
+	}))
+}
+
+func TestHistogramLog2Buckets(t *testing.T) {
+	RegisterTestingT(t)
+
+	bin := HistogramLog2Bin{MinExp: 2, Counts: []uint64{3, 1, 0, 0, 2}}
+
+	Expect(bin.ClassicBuckets()).To(Equal([]HistogramBucket{
+		{UpperBound: 7, CumulativeCount: 3},
+		{UpperBound: 15, CumulativeCount: 4},
+		{UpperBound: 31, CumulativeCount: 4},
+		{UpperBound: 63, CumulativeCount: 4},
+		{UpperBound: 127, CumulativeCount: 6},
+		{UpperBound: math.Inf(1), CumulativeCount: 6},
+	}))
+
+	Expect(bin.NativeHistogram()).To(Equal(NativeHistogram{
+		Schema: 0,
+		Count:  6,
+		PositiveSpans: []NativeHistogramSpan{
+			{Offset: 3, Length: 2},
+			{Offset: 2, Length: 1},
+		},
+		PositiveDeltas: []int64{3, -2, 1},
+	}))
+}
@@ -0,0 +1,282 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RingBufferFieldType defines type of the ring buffer entry field.
+type RingBufferFieldType string
+
+const (
+	RingBufferU8     RingBufferFieldType = "u8"
+	RingBufferU16    RingBufferFieldType = "u16"
+	RingBufferU32    RingBufferFieldType = "u32"
+	RingBufferU64    RingBufferFieldType = "u64"
+	RingBufferI8     RingBufferFieldType = "i8"
+	RingBufferI16    RingBufferFieldType = "i16"
+	RingBufferI32    RingBufferFieldType = "i32"
+	RingBufferI64    RingBufferFieldType = "i64"
+	RingBufferF32    RingBufferFieldType = "f32"
+	RingBufferF64    RingBufferFieldType = "f64"
+	RingBufferString RingBufferFieldType = "string" // NUL-terminated string with fixed length
+	RingBufferBytes  RingBufferFieldType = "bytes"  // byte array with fixed length
+	RingBufferPad    RingBufferFieldType = "pad"    // padding skipped by the decoder
+)
+
+// RingBufferField describes a single field of the ring buffer entry.
+type RingBufferField struct {
+	Name string              `json:"name"`
+	Type RingBufferFieldType `json:"type"`
+	// Length is the size of string, bytes and pad fields.
+	Length uint32 `json:"length,omitempty"`
+}
+
+// Size returns the size of the field in bytes.
+func (f RingBufferField) Size() (uint32, error) {
+	switch f.Type {
+	case RingBufferU8, RingBufferI8:
+		return 1, nil
+	case RingBufferU16, RingBufferI16:
+		return 2, nil
+	case RingBufferU32, RingBufferI32, RingBufferF32:
+		return 4, nil
+	case RingBufferU64, RingBufferI64, RingBufferF64:
+		return 8, nil
+	case RingBufferString, RingBufferBytes, RingBufferPad:
+		return f.Length, nil
+	}
+	return 0, fmt.Errorf("unknown ring buffer field type %q of field %q", f.Type, f.Name)
+}
+
+// RingBufferSchema describes the layout of the ring buffer entries. Fields are
+// laid out sequentially in host byte order without implicit padding.
+//
+// The schema published with the ring buffer (RingBufferStat.Schema) is not
+// decoded, its format is defined by the VPP component producing the ring,
+// so the schema of the entries must be provided by the caller.
+type RingBufferSchema struct {
+	Name   string            `json:"name,omitempty"`
+	Fields []RingBufferField `json:"fields"`
+}
+
+// Size returns the size of the entry described by the schema.
+func (s *RingBufferSchema) Size() (uint32, error) {
+	var size uint32
+	for _, f := range s.Fields {
+		n, err := f.Size()
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return size, nil
+}
+
+// Decode decodes a single ring buffer entry into a record.
+func (s *RingBufferSchema) Decode(entry []byte) (RingBufferRecord, error) {
+	record := RingBufferRecord{
+		Values: make(map[string]interface{}, len(s.Fields)),
+	}
+	var offset uint32
+	for _, f := range s.Fields {
+		size, err := f.Size()
+		if err != nil {
+			return record, err
+		}
+		if uint64(offset)+uint64(size) > uint64(len(entry)) {
+			return record, fmt.Errorf("ring buffer field %q at offset %d exceeds entry size %d", f.Name, offset, len(entry))
+		}
+		b := entry[offset : offset+size]
+		offset += size
+
+		var val interface{}
+		switch f.Type {
+		case RingBufferU8:
+			val = uint64(b[0])
+		case RingBufferU16:
+			val = uint64(binary.NativeEndian.Uint16(b))
+		case RingBufferU32:
+			val = uint64(binary.NativeEndian.Uint32(b))
+		case RingBufferU64:
+			val = binary.NativeEndian.Uint64(b)
+		case RingBufferI8:
+			val = int64(int8(b[0]))
+		case RingBufferI16:
+			val = int64(int16(binary.NativeEndian.Uint16(b)))
+		case RingBufferI32:
+			val = int64(int32(binary.NativeEndian.Uint32(b)))
+		case RingBufferI64:
+			val = int64(binary.NativeEndian.Uint64(b))
+		case RingBufferF32:
+			val = float64(math.Float32frombits(binary.NativeEndian.Uint32(b)))
+		case RingBufferF64:
+			val = math.Float64frombits(binary.NativeEndian.Uint64(b))
+		case RingBufferString:
+			if i := bytes.IndexByte(b, 0); i >= 0 {
+				b = b[:i]
+			}
+			val = string(b)
+		case RingBufferBytes:
+			val = bytes.Clone(b)
+		case RingBufferPad:
+			continue
+		}
+		record.Values[f.Name] = val
+	}
+	return record, nil
+}
+
+// RingBufferRecord represents a decoded ring buffer entry. Unsigned integers are
+// decoded as uint64, signed integers as int64, floats as float64, strings as string
+// and byte arrays as []byte.
+type RingBufferRecord struct {
+	// Thread is the index of the thread that produced the entry.
+	Thread uint32
+	// Sequence is the sequence number of the entry in the thread ring.
+	Sequence uint64
+	// Values contains decoded field values by field name.
+	Values map[string]interface{}
+}
+
+// Uint returns value of unsigned integer field.
+func (r RingBufferRecord) Uint(name string) (uint64, bool) {
+	v, ok := r.Values[name].(uint64)
+	return v, ok
+}
+
+// Int returns value of signed integer field.
+func (r RingBufferRecord) Int(name string) (int64, bool) {
+	v, ok := r.Values[name].(int64)
+	return v, ok
+}
+
+// Float returns value of floating point field.
+func (r RingBufferRecord) Float(name string) (float64, bool) {
+	v, ok := r.Values[name].(float64)
+	return v, ok
+}
+
+// String returns value of string field.
+func (r RingBufferRecord) String(name string) (string, bool) {
+	v, ok := r.Values[name].(string)
+	return v, ok
+}
+
+// Entry returns the raw entry produced by the thread at given sequence number,
+// or nil if the entry is not in the ring (not produced yet or overwritten).
+// The Sequence of the thread is the number of entries produced so far and
+// Head is the ring position of the next entry.
+func (s RingBufferStat) Entry(thread uint32, sequence uint64) []byte {
+	if int(thread) >= len(s.Threads) || int(thread) >= len(s.Data) || s.Config.RingSize == 0 {
+		return nil
+	}
+	meta := s.Threads[thread]
+	if sequence >= meta.Sequence || meta.Sequence-sequence > uint64(s.Config.RingSize) {
+		return nil
+	}
+	ringSize := uint64(s.Config.RingSize)
+	pos := (uint64(meta.Head) + ringSize - (meta.Sequence-sequence)%ringSize) % ringSize
+	start := pos * uint64(s.Config.EntrySize)
+	end := start + uint64(s.Config.EntrySize)
+	if end > uint64(len(s.Data[thread])) {
+		return nil
+	}
+	return s.Data[thread][start:end]
+}
+
+// RingBufferOverrun reports entries lost because the writer lapped the reader.
+type RingBufferOverrun struct {
+	Thread uint32
+	// Lost is the number of entries overwritten before they were read.
+	Lost uint64
+}
+
+// RingBufferReader reads ring buffer snapshots and returns only entries produced
+// since the previous read. It tracks the sequence of each thread separately.
+type RingBufferReader struct {
+	schema    *RingBufferSchema
+	size      uint32
+	sequences []uint64
+	started   bool
+}
+
+// NewRingBufferReader returns a reader decoding entries using the schema.
+func NewRingBufferReader(schema *RingBufferSchema) (*RingBufferReader, error) {
+	if schema == nil {
+		return nil, errors.New("ring buffer schema is required")
+	}
+	size, err := schema.Size()
+	if err != nil {
+		return nil, err
+	}
+	return &RingBufferReader{schema: schema, size: size}, nil
+}
+
+// Read returns records produced since the previous Read ordered by thread and
+// sequence. The first Read returns all entries present in the ring. Overruns
+// are reported for threads whose writer overwrote entries not read yet,
+// records of such threads start with the oldest entry still in the ring.
+// If the sequence of a thread decreases (e.g. the ring was re-created),
+// reading of the thread starts from the beginning.
+func (r *RingBufferReader) Read(stat RingBufferStat) ([]RingBufferRecord, []RingBufferOverrun, error) {
+	if r.size > stat.Config.EntrySize {
+		return nil, nil, fmt.Errorf("ring buffer schema size %d exceeds entry size %d", r.size, stat.Config.EntrySize)
+	}
+	if len(r.sequences) != len(stat.Threads) {
+		sequences := make([]uint64, len(stat.Threads))
+		copy(sequences, r.sequences)
+		r.sequences = sequences
+	}
+
+	ringSize := uint64(stat.Config.RingSize)
+	var records []RingBufferRecord
+	var overruns []RingBufferOverrun
+	for t, meta := range stat.Threads {
+		thread := uint32(t)
+		next := r.sequences[t]
+		if meta.Sequence < next {
+			next = 0
+		}
+		if meta.Sequence-next > ringSize {
+			oldest := meta.Sequence - ringSize
+			if r.started {
+				overruns = append(overruns, RingBufferOverrun{Thread: thread, Lost: oldest - next})
+			}
+			next = oldest
+		}
+		for seq := next; seq < meta.Sequence; seq++ {
+			entry := stat.Entry(thread, seq)
+			if entry == nil {
+				return nil, nil, fmt.Errorf("ring buffer entry %d of thread %d is not available", seq, thread)
+			}
+			record, err := r.schema.Decode(entry)
+			if err != nil {
+				return nil, nil, err
+			}
+			record.Thread = thread
+			record.Sequence = seq
+			records = append(records, record)
+		}
+		r.sequences[t] = meta.Sequence
+	}
+	r.started = true
+	return records, overruns, nil
+}
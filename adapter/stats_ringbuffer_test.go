@@ -0,0 +1,136 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package adapter
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+var testRingSchema = &RingBufferSchema{
+	Name: "event",
+	Fields: []RingBufferField{
+		{Name: "id", Type: RingBufferU32},
+		{Name: "delta", Type: RingBufferI16},
+		{Type: RingBufferPad, Length: 2},
+		{Name: "tag", Type: RingBufferString, Length: 8},
+	},
+}
+
+const testEntrySize = 16
+
+// testRing builds a ring buffer with 4 entries per thread where each thread
+// produced entries with ids 0..produced-1
+func testRing(produced ...uint64) RingBufferStat {
+	const ringSize = 4
+	stat := RingBufferStat{
+		Config: RingBufferConfig{
+			EntrySize:     testEntrySize,
+			RingSize:      ringSize,
+			NThreads:      uint32(len(produced)),
+			SchemaVersion: 1,
+		},
+	}
+	for _, n := range produced {
+		data := make([]byte, ringSize*testEntrySize)
+		for seq := uint64(0); seq < n; seq++ {
+			entry := data[(seq%ringSize)*testEntrySize:]
+			binary.NativeEndian.PutUint32(entry, uint32(seq))
+			binary.NativeEndian.PutUint16(entry[4:], uint16(-int16(seq)))
+			copy(entry[8:16], "tag\x00\x00\x00\x00\x00")
+		}
+		stat.Data = append(stat.Data, data)
+		stat.Threads = append(stat.Threads, RingBufferThreadMeta{
+			Head:          uint32(n % ringSize),
+			SchemaVersion: 1,
+			Sequence:      n,
+		})
+	}
+	return stat
+}
+
+func recordIDs(records []RingBufferRecord, thread uint32) []uint64 {
+	var ids []uint64
+	for _, r := range records {
+		if r.Thread != thread {
+			continue
+		}
+		id, _ := r.Uint("id")
+		Expect(r.Sequence).To(Equal(id))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestRingBufferSchemaDecode(t *testing.T) {
+	RegisterTestingT(t)
+
+	schema := testRingSchema
+	Expect(schema.Size()).To(BeEquivalentTo(testEntrySize))
+
+	record, err := schema.Decode(testRing(3).Entry(0, 2))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(record.Values).To(Equal(map[string]interface{}{
+		"id":    uint64(2),
+		"delta": int64(-2),
+		"tag":   "tag",
+	}))
+
+	_, err = schema.Decode(make([]byte, testEntrySize-1))
+	Expect(err).Should(HaveOccurred())
+
+	_, err = (&RingBufferSchema{Fields: []RingBufferField{{Name: "x", Type: "u128"}}}).Size()
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestRingBufferReader(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := NewRingBufferReader(nil)
+	Expect(err).Should(HaveOccurred())
+	_, err = NewRingBufferReader(&RingBufferSchema{Fields: []RingBufferField{{Name: "x", Type: "u128"}}})
+	Expect(err).Should(HaveOccurred())
+
+	reader, err := NewRingBufferReader(testRingSchema)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// first read returns everything in the ring
+	records, overruns, err := reader.Read(testRing(2, 6))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(overruns).To(BeEmpty())
+	Expect(recordIDs(records, 0)).To(Equal([]uint64{0, 1}))
+	Expect(recordIDs(records, 1)).To(Equal([]uint64{2, 3, 4, 5}))
+
+	// only new entries are returned
+	records, overruns, err = reader.Read(testRing(3, 6))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(overruns).To(BeEmpty())
+	Expect(recordIDs(records, 0)).To(Equal([]uint64{2}))
+	Expect(recordIDs(records, 1)).To(BeEmpty())
+
+	// writer of thread 1 lapped the reader
+	records, overruns, err = reader.Read(testRing(3, 13))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(overruns).To(Equal([]RingBufferOverrun{{Thread: 1, Lost: 3}}))
+	Expect(recordIDs(records, 1)).To(Equal([]uint64{9, 10, 11, 12}))
+
+	// ring re-created
+	records, _, err = reader.Read(testRing(1, 1))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(recordIDs(records, 0)).To(Equal([]uint64{0}))
+	Expect(recordIDs(records, 1)).To(Equal([]uint64{0}))
+}
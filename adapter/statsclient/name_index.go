@@ -0,0 +1,175 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsclient
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// nameIndex caches the names of the directory entries together with
+// the entries matching the patterns. When the directory epoch changes,
+// the names are compared with the cached names in the shared memory,
+// since the segment does not record which entries changed, and only the
+// new or changed names are read and matched again. The entries can be looked up by name without walking the
+// directory vector.
+type nameIndex struct {
+	mu sync.Mutex
+
+	// maximum number of cached patterns, the cache is cleared when exceeded
+	maxPatterns int
+
+	epoch   int64
+	synced  bool
+	names   []string          // entry name on each directory index
+	indexes map[string]uint32 // directory index of each entry name
+
+	patterns map[string]*patternMatches
+}
+
+// patternMatches keeps the compiled pattern and the entries it matches
+type patternMatches struct {
+	regex   *regexp.Regexp
+	matches []bool // matches on each directory index
+}
+
+func newNameIndex(maxPatterns int) *nameIndex {
+	return &nameIndex{
+		maxPatterns: maxPatterns,
+		indexes:     make(map[string]uint32),
+		patterns:    make(map[string]*patternMatches),
+	}
+}
+
+// reset clears all cached entries, e.g. after reconnecting to a new stats segment.
+func (idx *nameIndex) reset() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.synced = false
+	idx.names = nil
+	idx.indexes = make(map[string]uint32)
+	idx.patterns = make(map[string]*patternMatches)
+}
+
+// sync updates the cached names from the directory vector if the epoch has
+// changed. The caller must hold idx.mu.
+func (idx *nameIndex) sync(ss statSegment, vector dirVector, epoch int64) {
+	if idx.synced && idx.epoch == epoch {
+		return
+	}
+	vecLen := *(*uint32)(vectorLen(vector))
+	for i := vecLen; i < uint32(len(idx.names)); i++ {
+		if idx.indexes[idx.names[i]] == i {
+			delete(idx.indexes, idx.names[i])
+		}
+	}
+	if uint32(len(idx.names)) > vecLen {
+		idx.names = idx.names[:vecLen]
+		for _, p := range idx.patterns {
+			p.matches = p.matches[:vecLen]
+		}
+	}
+	for i := uint32(0); i < vecLen; i++ {
+		if i < uint32(len(idx.names)) {
+			// compare the name in place, only the changed names are read
+			if idx.names[i] == string(ss.GetStatDirNameOnIndex(vector, i)) {
+				continue
+			}
+			if old := idx.names[i]; old != "" && idx.indexes[old] == i {
+				delete(idx.indexes, old)
+			}
+		} else {
+			idx.names = append(idx.names, "")
+			for _, p := range idx.patterns {
+				p.matches = append(p.matches, false)
+			}
+		}
+		_, name, _ := ss.GetStatDirOnIndex(vector, i)
+		idx.names[i] = string(name)
+		if len(name) > 0 {
+			idx.indexes[idx.names[i]] = i
+		}
+		for _, p := range idx.patterns {
+			p.matches[i] = len(name) > 0 && p.regex.Match(name)
+		}
+	}
+	idx.epoch = epoch
+	idx.synced = true
+}
+
+// match returns the cached matches of the pattern, the pattern
+// is compiled and matched against all entries if not cached yet.
+// The caller must hold idx.mu.
+func (idx *nameIndex) match(pattern string) (*patternMatches, error) {
+	if p, ok := idx.patterns[pattern]; ok {
+		return p, nil
+	}
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("compiling regexp failed: %v", err)
+	}
+	if len(idx.patterns) >= idx.maxPatterns {
+		idx.patterns = make(map[string]*patternMatches)
+	}
+	p := &patternMatches{
+		regex:   r,
+		matches: make([]bool, len(idx.names)),
+	}
+	for i, name := range idx.names {
+		p.matches[i] = name != "" && r.MatchString(name)
+	}
+	idx.patterns[pattern] = p
+	return p, nil
+}
+
+// listIndexes lists indexes for all entries that match any of the patterns.
+func (idx *nameIndex) listIndexes(ss statSegment, vector dirVector, epoch int64, patterns ...string) ([]uint32, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.sync(ss, vector, epoch)
+
+	matches := make([]*patternMatches, len(patterns))
+	for i, pattern := range patterns {
+		p, err := idx.match(pattern)
+		if err != nil {
+			return nil, err
+		}
+		matches[i] = p
+	}
+	var indexes []uint32
+	for i := range idx.names {
+		for _, p := range matches {
+			if p.matches[i] {
+				indexes = append(indexes, uint32(i))
+				break
+			}
+		}
+	}
+	return indexes, nil
+}
+
+// lookup returns the directory index of the entry with the given name.
+func (idx *nameIndex) lookup(ss statSegment, vector dirVector, epoch int64, name string) (uint32, bool) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.sync(ss, vector, epoch)
+
+	index, ok := idx.indexes[name]
+	return index, ok
+}
@@ -15,6 +15,7 @@
 package statsclient
 
 import (
+	"bytes"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -83,6 +84,11 @@ type statSegment interface {
 	// the same memory address as the argument.
 	GetStatDirOnIndex(v dirVector, index uint32) (dirSegment, dirName, adapter.StatType)
 
+	// GetStatDirNameOnIndex returns the directory name on the index without
+	// copying it off the shared memory, so it must not be retained by the
+	// caller. It allows comparing the names without allocating them.
+	GetStatDirNameOnIndex(v dirVector, index uint32) dirName
+
 	// GetEpoch re-loads stats header and returns current epoch
 	//and 'inProgress' value
 	GetEpoch() (int64, bool)
@@ -121,6 +127,15 @@ func vectorLen(v dirVector) dirVector {
 	return dirVector(&vec.length)
 }
 
+// statDirName returns the NUL-terminated name of the directory entry,
+// nil if the name is not terminated.
+func statDirName(name *[128]byte) dirName {
+	if n := bytes.IndexByte(name[:], 0); n >= 0 {
+		return name[:n]
+	}
+	return nil
+}
+
 func getStatType(dirTypeNum dirType, useLegacyMapping bool) (dirTyp adapter.StatType) {
 	var exists bool
 	if useLegacyMapping {
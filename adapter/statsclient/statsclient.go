@@ -17,11 +17,11 @@ package statsclient
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
-	"regexp"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -43,6 +43,10 @@ const (
 
 	// DefaultSocketRetryTimeout is the maximum time for the stats socket
 	DefaultSocketRetryTimeout = 3 * time.Second
+
+	// DefaultMaxCachedPatterns is the default maximum number of patterns
+	// with the matching entries cached by the client
+	DefaultMaxCachedPatterns = 256
 )
 
 var (
@@ -68,14 +72,15 @@ func debugf(f string, a ...interface{}) {
 	}
 }
 
-// implements StatsAPI
-var _ adapter.StatsAPI = (*StatsClient)(nil)
+// implements StatsAPIContext
+var _ adapter.StatsAPIContext = (*StatsClient)(nil)
 
 // StatsClient is the pure Go implementation for VPP stats API.
 type StatsClient struct {
 	socket       string
 	retryPeriod  time.Duration
 	retryTimeout time.Duration
+	maxPatterns  int
 
 	headerData []byte
 
@@ -90,6 +95,9 @@ type StatsClient struct {
 	// to protect statseg access from concurrent reconnect
 	accessLock sync.RWMutex
 
+	// cached entry names and pattern matches
+	index *nameIndex
+
 	statSegment
 }
 
@@ -112,6 +120,15 @@ func SetSocketRetryTimeout(t time.Duration) Option {
 	}
 }
 
+// SetMaxCachedPatterns is an optional parameter to define the maximum
+// number of patterns with the matching entries cached by the client,
+// the cache is cleared when exceeded
+func SetMaxCachedPatterns(n int) Option {
+	return func(c *StatsClient) {
+		c.maxPatterns = n
+	}
+}
+
 // NewStatsClient returns a new StatsClient using socket.
 // If socket is empty string DefaultSocketName is used.
 func NewStatsClient(socket string, options ...Option) *StatsClient {
@@ -130,13 +147,24 @@ func NewStatsClient(socket string, options ...Option) *StatsClient {
 	if s.retryTimeout == 0 {
 		s.retryTimeout = DefaultSocketRetryTimeout
 	}
+	if s.maxPatterns == 0 {
+		s.maxPatterns = DefaultMaxCachedPatterns
+	}
+	s.index = newNameIndex(s.maxPatterns)
 	return s
 }
 
 // Connect to validated VPP stats socket and start monitoring
 // socket file changes
 func (sc *StatsClient) Connect() (err error) {
-	if err := sc.waitForSocket(); err != nil {
+	return sc.ConnectContext(context.Background())
+}
+
+// ConnectContext connects to validated VPP stats socket and starts
+// monitoring socket file changes. Waiting for the socket is cancelled
+// when the context is done.
+func (sc *StatsClient) ConnectContext(ctx context.Context) (err error) {
+	if err := sc.waitForSocket(ctx); err != nil {
 		return err
 	}
 	sc.done = make(chan struct{})
@@ -164,18 +192,22 @@ func (sc *StatsClient) Disconnect() error {
 }
 
 func (sc *StatsClient) ListStats(patterns ...string) (entries []adapter.StatIdentifier, err error) {
+	return sc.ListStatsContext(context.Background(), patterns...)
+}
+
+func (sc *StatsClient) ListStatsContext(ctx context.Context, patterns ...string) (entries []adapter.StatIdentifier, err error) {
 	sc.accessLock.RLock()
 	defer sc.accessLock.RUnlock()
 
 	if !sc.isConnected() {
 		return nil, adapter.ErrStatsDisconnected
 	}
-	accessEpoch := sc.accessStart()
-	if accessEpoch == 0 {
-		return nil, adapter.ErrStatsAccessFailed
+	accessEpoch, err := sc.accessStart(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	entries, err = sc.getIdentifierEntries(patterns...)
+	entries, err = sc.getIdentifierEntries(accessEpoch, patterns...)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +219,10 @@ func (sc *StatsClient) ListStats(patterns ...string) (entries []adapter.StatIden
 }
 
 func (sc *StatsClient) DumpStats(patterns ...string) (entries []adapter.StatEntry, err error) {
+	return sc.DumpStatsContext(context.Background(), patterns...)
+}
+
+func (sc *StatsClient) DumpStatsContext(ctx context.Context, patterns ...string) (entries []adapter.StatEntry, err error) {
 	sc.accessLock.RLock()
 	defer sc.accessLock.RUnlock()
 
@@ -194,12 +230,12 @@ func (sc *StatsClient) DumpStats(patterns ...string) (entries []adapter.StatEntr
 		return nil, adapter.ErrStatsDisconnected
 	}
 
-	accessEpoch := sc.accessStart()
-	if accessEpoch == 0 {
-		return nil, adapter.ErrStatsAccessFailed
+	accessEpoch, err := sc.accessStart(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	entries, err = sc.getStatEntries(patterns...)
+	entries, err = sc.getStatEntries(accessEpoch, patterns...)
 	if err != nil {
 		return nil, err
 	}
@@ -211,6 +247,10 @@ func (sc *StatsClient) DumpStats(patterns ...string) (entries []adapter.StatEntr
 }
 
 func (sc *StatsClient) PrepareDir(patterns ...string) (*adapter.StatDir, error) {
+	return sc.PrepareDirContext(context.Background(), patterns...)
+}
+
+func (sc *StatsClient) PrepareDirContext(ctx context.Context, patterns ...string) (*adapter.StatDir, error) {
 	sc.accessLock.RLock()
 	defer sc.accessLock.RUnlock()
 
@@ -218,12 +258,12 @@ func (sc *StatsClient) PrepareDir(patterns ...string) (*adapter.StatDir, error)
 		return nil, adapter.ErrStatsDisconnected
 	}
 
-	accessEpoch := sc.accessStart()
-	if accessEpoch == 0 {
-		return nil, adapter.ErrStatsAccessFailed
+	accessEpoch, err := sc.accessStart(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	entries, err := sc.getStatEntries(patterns...)
+	entries, err := sc.getStatEntries(accessEpoch, patterns...)
 	if err != nil {
 		return nil, err
 	}
@@ -241,6 +281,10 @@ func (sc *StatsClient) PrepareDir(patterns ...string) (*adapter.StatDir, error)
 }
 
 func (sc *StatsClient) PrepareDirOnIndex(indexes ...uint32) (*adapter.StatDir, error) {
+	return sc.PrepareDirOnIndexContext(context.Background(), indexes...)
+}
+
+func (sc *StatsClient) PrepareDirOnIndexContext(ctx context.Context, indexes ...uint32) (*adapter.StatDir, error) {
 	sc.accessLock.RLock()
 	defer sc.accessLock.RUnlock()
 
@@ -248,9 +292,9 @@ func (sc *StatsClient) PrepareDirOnIndex(indexes ...uint32) (*adapter.StatDir, e
 		return nil, adapter.ErrStatsDisconnected
 	}
 
-	accessEpoch := sc.accessStart()
-	if accessEpoch == 0 {
-		return nil, adapter.ErrStatsAccessFailed
+	accessEpoch, err := sc.accessStart(ctx)
+	if err != nil {
+		return nil, err
 	}
 	vector := sc.GetDirectoryVector()
 	if vector == nil {
@@ -273,8 +317,41 @@ func (sc *StatsClient) PrepareDirOnIndex(indexes ...uint32) (*adapter.StatDir, e
 	return dir, nil
 }
 
+// LookupIndex returns the directory index of the stat entry with the given name,
+// which can be used with PrepareDirOnIndex. The names are cached across the
+// directory epochs, so the lookup does not walk the directory vector unless
+// the directory has changed.
+func (sc *StatsClient) LookupIndex(name string) (index uint32, found bool, err error) {
+	sc.accessLock.RLock()
+	defer sc.accessLock.RUnlock()
+
+	if !sc.isConnected() {
+		return 0, false, adapter.ErrStatsDisconnected
+	}
+
+	accessEpoch, err := sc.accessStart(context.Background())
+	if err != nil {
+		return 0, false, err
+	}
+	vector := sc.GetDirectoryVector()
+	if vector == nil {
+		return 0, false, fmt.Errorf("failed to lookup index: directory vector is nil")
+	}
+	index, found = sc.index.lookup(sc.statSegment, vector, accessEpoch, name)
+
+	if !sc.accessEnd(accessEpoch) {
+		return 0, false, adapter.ErrStatsDataBusy
+	}
+	return index, found, nil
+}
+
 // UpdateDir refreshes directory data for all counters
 func (sc *StatsClient) UpdateDir(dir *adapter.StatDir) (err error) {
+	return sc.UpdateDirContext(context.Background(), dir)
+}
+
+// UpdateDirContext refreshes directory data for all counters
+func (sc *StatsClient) UpdateDirContext(ctx context.Context, dir *adapter.StatDir) (err error) {
 	sc.accessLock.RLock()
 	defer sc.accessLock.RUnlock()
 
@@ -287,9 +364,9 @@ func (sc *StatsClient) UpdateDir(dir *adapter.StatDir) (err error) {
 		return adapter.ErrStatsDirStale
 	}
 
-	accessEpoch := sc.accessStart()
-	if accessEpoch == 0 {
-		return adapter.ErrStatsAccessFailed
+	accessEpoch, err := sc.accessStart(ctx)
+	if err != nil {
+		return err
 	}
 	dirVector := sc.GetDirectoryVector()
 	if dirVector == nil {
@@ -307,12 +384,13 @@ func (sc *StatsClient) UpdateDir(dir *adapter.StatDir) (err error) {
 }
 
 // checks the socket existence and waits for it for the designated
-// time if it is not available immediately
-func (sc *StatsClient) waitForSocket() error {
+// time if it is not available immediately, or until the context is done
+func (sc *StatsClient) waitForSocket(ctx context.Context) error {
 	if _, err := os.Stat(sc.socket); err != nil {
 		if os.IsNotExist(err) {
 			n := time.Now()
 			ticker := time.NewTicker(sc.retryPeriod)
+			defer ticker.Stop()
 			timeout := time.After(sc.retryTimeout)
 			for {
 				select {
@@ -323,6 +401,8 @@ func (sc *StatsClient) waitForSocket() error {
 				case <-timeout:
 					return fmt.Errorf("stats socket file %s is not ready within timeout (after %.2f s) ",
 						sc.socket, time.Since(n).Seconds())
+				case <-ctx.Done():
+					return ctx.Err()
 				}
 			}
 		} else {
@@ -395,6 +475,9 @@ func (sc *StatsClient) connect() (ss statSegment, err error) {
 			version, minVersion, maxVersion)
 	}
 
+	// the new segment may reuse the epoch with a different directory
+	sc.index.reset()
+
 	// set connected
 	atomic.CompareAndSwapUint32(&sc.connected, 0, 1)
 
@@ -402,16 +485,41 @@ func (sc *StatsClient) connect() (ss statSegment, err error) {
 }
 
 // reconnect disconnects from the socket, re-validates it and
-// connects again
-func (sc *StatsClient) reconnect() (err error) {
+// connects again. The access lock is not held while waiting for
+// the socket, so the calls meanwhile fail with ErrStatsDisconnected
+// instead of blocking until the socket is back.
+func (sc *StatsClient) reconnect(done <-chan struct{}) (err error) {
 	sc.accessLock.Lock()
-	defer sc.accessLock.Unlock()
-	if err = sc.disconnect(); err != nil {
+	err = sc.disconnect()
+	sc.accessLock.Unlock()
+	if err != nil {
 		return fmt.Errorf("error disconnecting socket: %v", err)
 	}
-	if err = sc.waitForSocket(); err != nil {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if err = sc.waitForSocket(ctx); err != nil {
+		if ctx.Err() != nil {
+			// the client was disconnected meanwhile
+			return nil
+		}
 		return fmt.Errorf("error while waiting on socket: %v", err)
 	}
+
+	sc.accessLock.Lock()
+	defer sc.accessLock.Unlock()
+	select {
+	case <-done:
+		return nil
+	default:
+	}
 	if sc.statSegment, err = sc.connect(); err != nil {
 		return fmt.Errorf("error connecting socket: %v", err)
 	}
@@ -446,18 +554,19 @@ func (sc *StatsClient) monitorSocket() {
 
 	atomic.StoreUint32(&sc.monitored, 1)
 
+	done := sc.done
 	go func() {
 		for {
 			select {
 			case event := <-watcher.Events:
 				if event.Op == fsnotify.Remove && event.Name == sc.socket {
-					if err := sc.reconnect(); err != nil {
+					if err := sc.reconnect(done); err != nil {
 						Log.Errorf("error occurred during socket reconnect: %v", err)
 					}
 				}
 			case err := <-watcher.Errors:
 				Log.Errorf("socket monitor delivered error event: %v", err)
-			case <-sc.done:
+			case <-done:
 				err := watcher.Close()
 				Log.Debugf("socket monitor closed (error: %v)", err)
 				return
@@ -471,20 +580,24 @@ func (sc *StatsClient) monitorSocket() {
 }
 
 // Starts monitoring 'inProgress' field. Returns stats segment
-// access epoch when completed, or zero value if not finished
-// within MaxWaitInProgress
-func (sc *StatsClient) accessStart() (epoch int64) {
+// access epoch when completed, ErrStatsAccessFailed if not finished
+// within MaxWaitInProgress, or the context error if the context is done
+func (sc *StatsClient) accessStart(ctx context.Context) (epoch int64, err error) {
 	t := time.Now()
 
 	epoch, inProg := sc.GetEpoch()
 	for inProg {
 		if time.Since(t) > MaxWaitInProgress {
-			return int64(0)
+			return 0, adapter.ErrStatsAccessFailed
+		}
+		select {
+		case <-time.After(CheckDelayInProgress):
+		case <-ctx.Done():
+			return 0, ctx.Err()
 		}
-		time.Sleep(CheckDelayInProgress)
 		epoch, inProg = sc.GetEpoch()
 	}
-	return epoch
+	return epoch, ctx.Err()
 }
 
 // AccessEnd returns true if stats data reading was finished, false
@@ -498,12 +611,12 @@ func (sc *StatsClient) accessEnd(accessEpoch int64) bool {
 }
 
 // getStatEntries retrieves all stats matching desired patterns, or all stats if no pattern is provided.
-func (sc *StatsClient) getStatEntries(patterns ...string) (entries []adapter.StatEntry, err error) {
+func (sc *StatsClient) getStatEntries(epoch int64, patterns ...string) (entries []adapter.StatEntry, err error) {
 	vector := sc.GetDirectoryVector()
 	if vector == nil {
 		return nil, fmt.Errorf("failed to get stat entries: directory vector is nil")
 	}
-	indexes, err := sc.listIndexes(vector, patterns...)
+	indexes, err := sc.listIndexes(vector, epoch, patterns...)
 	if err != nil {
 		return nil, err
 	}
@@ -512,12 +625,12 @@ func (sc *StatsClient) getStatEntries(patterns ...string) (entries []adapter.Sta
 
 // getIdentifierEntries retrieves all identifiers matching desired patterns, or all identifiers
 // if no pattern is provided.
-func (sc *StatsClient) getIdentifierEntries(patterns ...string) (identifiers []adapter.StatIdentifier, err error) {
+func (sc *StatsClient) getIdentifierEntries(epoch int64, patterns ...string) (identifiers []adapter.StatIdentifier, err error) {
 	vector := sc.GetDirectoryVector()
 	if vector == nil {
 		return nil, fmt.Errorf("failed to get identifier entries: directory vector is nil")
 	}
-	indexes, err := sc.listIndexes(vector, patterns...)
+	indexes, err := sc.listIndexes(vector, epoch, patterns...)
 	if err != nil {
 		return nil, err
 	}
@@ -573,27 +686,12 @@ func (sc *StatsClient) getIdentifierEntriesOnIndex(vector dirVector, indexes ...
 }
 
 // listIndexes lists indexes for all stat entries that match any of the regex patterns.
-func (sc *StatsClient) listIndexes(vector dirVector, patterns ...string) (indexes []uint32, err error) {
+// The matching indexes are cached by the name index for the given epoch.
+func (sc *StatsClient) listIndexes(vector dirVector, epoch int64, patterns ...string) (indexes []uint32, err error) {
 	if len(patterns) == 0 {
 		return sc.listIndexesFunc(vector, nil)
 	}
-	var regexes = make([]*regexp.Regexp, len(patterns))
-	for i, pattern := range patterns {
-		r, err := regexp.Compile(pattern)
-		if err != nil {
-			return nil, fmt.Errorf("compiling regexp failed: %v", err)
-		}
-		regexes[i] = r
-	}
-	nameMatches := func(name dirName) bool {
-		for _, r := range regexes {
-			if r.Match(name) {
-				return true
-			}
-		}
-		return false
-	}
-	return sc.listIndexesFunc(vector, nameMatches)
+	return sc.index.listIndexes(sc.statSegment, vector, epoch, patterns...)
 }
 
 // listIndexesFunc lists stats indexes. The optional function
@@ -0,0 +1,356 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsclient
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsclient/statsegtest"
+)
+
+type testCtx struct {
+	segment *statsegtest.Segment
+	client  *StatsClient
+}
+
+func setupTest(t *testing.T, entries []adapter.StatEntry, symlinks ...statsegtest.Symlink) *testCtx {
+	RegisterTestingT(t)
+
+	segment, err := statsegtest.NewSegment(0)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(segment.Write(entries, symlinks...)).To(Succeed())
+
+	socket := filepath.Join(t.TempDir(), "stats.sock")
+	Expect(segment.Serve(socket)).To(Succeed())
+
+	client := NewStatsClient(socket)
+	Expect(client.Connect()).To(Succeed())
+
+	return &testCtx{
+		segment: segment,
+		client:  client,
+	}
+}
+
+func (ctx *testCtx) teardownTest() {
+	Expect(ctx.client.Disconnect()).To(Succeed())
+	Expect(ctx.segment.Close()).To(Succeed())
+}
+
+func newEntry(name string, data adapter.Stat) adapter.StatEntry {
+	return adapter.StatEntry{
+		StatIdentifier: adapter.StatIdentifier{Name: []byte(name)},
+		Type:           data.Type(),
+		Data:           data,
+	}
+}
+
+func testEntries() []adapter.StatEntry {
+	return []adapter.StatEntry{
+		newEntry("/sys/heartbeat", adapter.ScalarStat(42)),
+		newEntry("/sys/num_worker_threads", adapter.GaugeStat(2)),
+		newEntry("/if/names", adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0")}),
+		newEntry("/if/drops", adapter.SimpleCounterStat{{1, 2}, {3, 4}}),
+		newEntry("/if/rx", adapter.CombinedCounterStat{{{1, 64}, {2, 128}}, {{3, 192}, {4, 256}}}),
+		newEntry("/removed", adapter.EmptyStat("<none>")),
+		newEntry("/latency", adapter.HistogramLog2Stat{
+			{MinExp: 3, Counts: []uint64{1, 5, 2}},
+			{MinExp: 3, Counts: []uint64{0, 7, 1}},
+		}),
+		newEntry("/ring", adapter.RingBufferStat{
+			Config: adapter.RingBufferConfig{
+				EntrySize:     4,
+				RingSize:      2,
+				NThreads:      2,
+				SchemaSize:    6,
+				SchemaVersion: 1,
+			},
+			Threads: []adapter.RingBufferThreadMeta{
+				{Head: 1, SchemaVersion: 1, Sequence: 3},
+				{Head: 0, SchemaVersion: 1, Sequence: 0},
+			},
+			Schema: []byte("schema"),
+			Data:   [][]byte{{1, 2, 3, 4, 5, 6, 7, 8}, make([]byte, 8)},
+		}),
+	}
+}
+
+func TestStatsClientDumpStats(t *testing.T) {
+	ctx := setupTest(t, testEntries(), statsegtest.Symlink{
+		Name:   "/interfaces/eth0/drops",
+		Target: "/if/drops",
+		Index:  1,
+	})
+	defer ctx.teardownTest()
+
+	entries, err := ctx.client.DumpStats()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(entries).To(HaveLen(9))
+
+	for i, expected := range testEntries() {
+		Expect(entries[i].Index).To(BeEquivalentTo(i))
+		Expect(string(entries[i].Name)).To(Equal(string(expected.Name)))
+		Expect(entries[i].Type).To(Equal(expected.Type))
+		Expect(entries[i].Symlink).To(BeFalse())
+		if ring, ok := entries[i].Data.(adapter.RingBufferStat); ok {
+			exp := expected.Data.(adapter.RingBufferStat)
+			Expect(ring.Config).To(Equal(exp.Config))
+			Expect(ring.Schema).To(Equal(exp.Schema))
+			Expect(ring.Data).To(Equal(exp.Data))
+			Expect(ring.Threads).To(HaveLen(2))
+			Expect(ring.Threads[0].Head).To(BeEquivalentTo(1))
+			Expect(ring.Threads[0].Sequence).To(BeEquivalentTo(3))
+			continue
+		}
+		Expect(entries[i].Data).To(Equal(expected.Data))
+	}
+
+	link := entries[8]
+	Expect(string(link.Name)).To(Equal("/interfaces/eth0/drops"))
+	Expect(link.Symlink).To(BeTrue())
+	Expect(link.Type).To(Equal(adapter.SimpleCounterVector))
+	Expect(link.Data).To(Equal(adapter.SimpleCounterStat{{2}, {4}}))
+}
+
+func TestStatsClientListStats(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	identifiers, err := ctx.client.ListStats("^/if/", "/heartbeat$")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(identifiers).To(Equal([]adapter.StatIdentifier{
+		{Index: 0, Name: []byte("/sys/heartbeat")},
+		{Index: 2, Name: []byte("/if/names")},
+		{Index: 3, Name: []byte("/if/drops")},
+		{Index: 4, Name: []byte("/if/rx")},
+	}))
+}
+
+func TestStatsClientUpdateDir(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	dir, err := ctx.client.PrepareDir("^/if/drops$", "^/if/rx$")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(dir.Epoch).To(Equal(ctx.segment.Epoch()))
+	Expect(dir.Entries).To(HaveLen(2))
+
+	// values change in place, the epoch stays the same
+	entries := testEntries()
+	entries[3].Data = adapter.SimpleCounterStat{{10, 20}, {30, 40}}
+	entries[4].Data = adapter.CombinedCounterStat{{{5, 320}, {6, 384}}, {{7, 448}, {8, 512}}}
+	Expect(ctx.segment.Write(entries)).To(Succeed())
+
+	Expect(ctx.client.UpdateDir(dir)).To(Succeed())
+	Expect(dir.Entries[0].Data).To(Equal(entries[3].Data))
+	Expect(dir.Entries[1].Data).To(Equal(entries[4].Data))
+
+	// new interface changes the directory layout
+	entries[2].Data = adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0"), adapter.Name("eth1")}
+	Expect(ctx.segment.Write(entries)).To(Succeed())
+	Expect(ctx.client.UpdateDir(dir)).To(MatchError(adapter.ErrStatsDirStale))
+}
+
+func TestStatsClientInProgress(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	ctx.segment.SetInProgress(true)
+	_, err := ctx.client.DumpStats()
+	Expect(err).To(MatchError(adapter.ErrStatsAccessFailed))
+
+	ctx.segment.SetInProgress(false)
+	_, err = ctx.client.DumpStats()
+	Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestStatsClientContextCancel(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	ctx.segment.SetInProgress(true)
+	start := time.Now()
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	_, err := ctx.client.DumpStatsContext(timeoutCtx)
+	Expect(err).To(MatchError(context.DeadlineExceeded))
+	Expect(time.Since(start)).To(BeNumerically("<", MaxWaitInProgress))
+
+	ctx.segment.SetInProgress(false)
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ctx.client.PrepareDirContext(canceledCtx)
+	Expect(err).To(MatchError(context.Canceled))
+
+	dir, err := ctx.client.PrepareDirContext(context.Background(), "^/if/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.client.UpdateDirContext(canceledCtx, dir)).To(MatchError(context.Canceled))
+}
+
+func TestStatsClientConnectContext(t *testing.T) {
+	RegisterTestingT(t)
+
+	client := NewStatsClient(filepath.Join(t.TempDir(), "missing.sock"), SetSocketRetryTimeout(time.Minute))
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	Expect(client.ConnectContext(timeoutCtx)).To(MatchError(context.DeadlineExceeded))
+}
+
+func TestStatsClientReconnect(t *testing.T) {
+	RegisterTestingT(t)
+
+	segment, err := statsegtest.NewSegment(0)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer segment.Close()
+	Expect(segment.Write(testEntries())).To(Succeed())
+	socket := filepath.Join(t.TempDir(), "stats.sock")
+	Expect(segment.Serve(socket)).To(Succeed())
+
+	client := NewStatsClient(socket, SetSocketRetryTimeout(time.Minute))
+	Expect(client.Connect()).To(Succeed())
+
+	// the calls do not block while the client waits for the socket
+	Expect(os.Remove(socket)).To(Succeed())
+	Eventually(client.isConnected).Should(BeFalse())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := client.DumpStatsContext(context.Background())
+		errs <- err
+	}()
+	Eventually(errs, time.Second).Should(Receive(MatchError(adapter.ErrStatsDisconnected)))
+
+	// disconnecting ends the wait for the socket
+	Expect(client.Disconnect()).To(Succeed())
+}
+
+func TestStatsClientNameIndex(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	index, found, err := ctx.client.LookupIndex("/if/drops")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	dir, err := ctx.client.PrepareDirOnIndex(index)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(dir.Entries[0].Name)).To(Equal("/if/drops"))
+
+	_, found, err = ctx.client.LookupIndex("/if/missing")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(found).To(BeFalse())
+
+	identifiers, err := ctx.client.ListStats("^/if/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(identifiers).To(HaveLen(3))
+
+	// replace the interface entries, the cached matches must follow
+	entries := append(testEntries()[:2], newEntry("/if/tx", adapter.CombinedCounterStat{{{1, 64}}}), newEntry("/new", adapter.ScalarStat(1)))
+	Expect(ctx.segment.Write(entries)).To(Succeed())
+
+	identifiers, err = ctx.client.ListStats("^/if/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(identifiers).To(Equal([]adapter.StatIdentifier{{Index: 2, Name: []byte("/if/tx")}}))
+	_, found, err = ctx.client.LookupIndex("/if/drops")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(found).To(BeFalse())
+	index, found, err = ctx.client.LookupIndex("/new")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	Expect(index).To(BeEquivalentTo(3))
+}
+
+func TestNameIndexSync(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	sc := ctx.client
+	idx := newNameIndex(2)
+	epoch, _ := sc.GetEpoch()
+	idx.sync(sc.statSegment, sc.GetDirectoryVector(), epoch)
+	Expect(idx.names).To(HaveLen(len(testEntries())))
+	Expect(idx.indexes).To(HaveKeyWithValue("/sys/num_worker_threads", uint32(1)))
+
+	p, err := idx.match("^/sys/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(p.matches).To(Equal([]bool{true, true, false, false, false, false, false, false}))
+
+	entries := testEntries()
+	entries[1] = newEntry("/sys/renamed", adapter.GaugeStat(2))
+	Expect(ctx.segment.Write(entries[:4])).To(Succeed())
+	epoch, _ = sc.GetEpoch()
+	idx.sync(sc.statSegment, sc.GetDirectoryVector(), epoch)
+	Expect(idx.names).To(Equal([]string{"/sys/heartbeat", "/sys/renamed", "/if/names", "/if/drops"}))
+	Expect(idx.indexes).To(HaveKeyWithValue("/sys/renamed", uint32(1)))
+	Expect(idx.indexes).ToNot(HaveKey("/sys/num_worker_threads"))
+	Expect(idx.indexes).ToNot(HaveKey("/if/rx"))
+	Expect(p.matches).To(Equal([]bool{true, true, false, false}))
+
+	// the pattern cache is cleared when exceeding the limit
+	_, err = idx.match("^/if/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(idx.patterns).To(HaveLen(2))
+	_, err = idx.match("^/err/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(idx.patterns).To(HaveLen(1))
+}
+
+// countingSegment counts the directory names read off the segment.
+type countingSegment struct {
+	statSegment
+	reads int
+}
+
+func (s *countingSegment) GetStatDirOnIndex(v dirVector, index uint32) (dirSegment, dirName, adapter.StatType) {
+	s.reads++
+	return s.statSegment.GetStatDirOnIndex(v, index)
+}
+
+func TestNameIndexSyncChanged(t *testing.T) {
+	ctx := setupTest(t, testEntries())
+	defer ctx.teardownTest()
+
+	sc := ctx.client
+	ss := &countingSegment{statSegment: sc.statSegment}
+	idx := newNameIndex(2)
+	epoch, _ := sc.GetEpoch()
+	idx.sync(ss, sc.GetDirectoryVector(), epoch)
+	Expect(ss.reads).To(Equal(len(testEntries())))
+
+	// only the renamed entry is read
+	entries := testEntries()
+	entries[5] = newEntry("/renamed", adapter.EmptyStat("<none>"))
+	Expect(ctx.segment.Write(entries)).To(Succeed())
+	ss.reads = 0
+	epoch, _ = sc.GetEpoch()
+	idx.sync(ss, sc.GetDirectoryVector(), epoch)
+	Expect(ss.reads).To(Equal(1))
+	Expect(idx.names[5]).To(Equal("/renamed"))
+	Expect(idx.indexes).To(HaveKeyWithValue("/renamed", uint32(5)))
+	Expect(idx.indexes).ToNot(HaveKey("/removed"))
+
+	// only the added entry is read
+	Expect(ctx.segment.Write(append(entries, newEntry("/added", adapter.ScalarStat(1))))).To(Succeed())
+	ss.reads = 0
+	epoch, _ = sc.GetEpoch()
+	idx.sync(ss, sc.GetDirectoryVector(), epoch)
+	Expect(ss.reads).To(Equal(1))
+	Expect(idx.indexes).To(HaveKeyWithValue("/added", uint32(len(entries))))
+}
@@ -75,19 +75,18 @@ func (ss *statSegmentV2) GetDirectoryVector() dirVector {
 func (ss *statSegmentV2) GetStatDirOnIndex(v dirVector, index uint32) (dirSegment, dirName, adapter.StatType) {
 	statSegDir := dirSegment(uintptr(v) + uintptr(index)*unsafe.Sizeof(statSegDirectoryEntryV2{}))
 	dir := (*statSegDirectoryEntryV2)(statSegDir)
-	var name []byte
-	for n := 0; n < len(dir.name); n++ {
-		if dir.name[n] == 0 {
-			// Copy the name off the statseg shared memory region before returning.
-			// The caller must not hold a reference into the mmap'd region after
-			// DumpStats returns, because VPP may unmap the segment at any time.
-			name = bytes.Clone(dir.name[:n])
-			break
-		}
-	}
+	// Copy the name off the statseg shared memory region before returning.
+	// The caller must not hold a reference into the mmap'd region after
+	// DumpStats returns, because VPP may unmap the segment at any time.
+	name := bytes.Clone(statDirName(&dir.name))
 	return statSegDir, name, getStatType(dir.directoryType, ss.getErrorVector() != nil)
 }
 
+func (ss *statSegmentV2) GetStatDirNameOnIndex(v dirVector, index uint32) dirName {
+	dir := (*statSegDirectoryEntryV2)(unsafe.Pointer(uintptr(v) + uintptr(index)*unsafe.Sizeof(statSegDirectoryEntryV2{})))
+	return statDirName(&dir.name)
+}
+
 func (ss *statSegmentV2) GetEpoch() (int64, bool) {
 	sh := ss.loadSharedHeader(ss.sharedHeader)
 	return sh.epoch, sh.inProgress != 0
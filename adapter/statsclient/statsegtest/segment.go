@@ -0,0 +1,477 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+// Package statsegtest provides a synthetic VPP stats segment for testing.
+//
+// The Segment builds a valid version 2 stats segment in a memfd from
+// adapter.StatEntry values and serves it over a unix socket the same way
+// VPP does, so that the statsclient can be tested without running VPP.
+package statsegtest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+
+	"go.fd.io/govpp/adapter"
+)
+
+const (
+	// DefaultSegmentSize is the size of the segment used when zero is passed to NewSegment.
+	DefaultSegmentSize = 1 << 20
+
+	// segmentVersion is the version of the stats segment layout written by Segment
+	segmentVersion = 2
+
+	// segmentBase is the fake address of the segment in the writer process,
+	// all pointers stored in the segment are relative to this address
+	segmentBase = 0x7f0000000000
+
+	headerSize     = 48
+	dirEntrySize   = 144
+	dirNameLen     = 128
+	vecHeaderSize  = 8
+	ringHeaderSize = 28
+	ringMetaSize   = 64
+)
+
+// directory types used by the version 2 segment layout
+const (
+	dirTypeScalarIndex           = 1
+	dirTypeSimpleCounterVector   = 2
+	dirTypeCombinedCounterVector = 3
+	dirTypeNameVector            = 4
+	dirTypeEmpty                 = 5
+	dirTypeSymlink               = 6
+	dirTypeHistogramLog2         = 7
+	dirTypeRingBuffer            = 8
+	dirTypeGaugeIndex            = 9
+)
+
+// header field offsets
+const (
+	offVersion    = 0
+	offBase       = 8
+	offEpoch      = 16
+	offInProgress = 24
+	offDirVector  = 32
+	offErrVector  = 40
+)
+
+// Symlink describes a directory entry which points to a single item
+// of another directory entry, e.g. /interfaces/<name>/rx pointing
+// to the interface index of /if/rx.
+type Symlink struct {
+	Name   string
+	Target string
+	Index  uint32
+}
+
+// Segment is a synthetic VPP stats segment backed by a memfd.
+type Segment struct {
+	mu     sync.Mutex
+	file   *os.File
+	data   []byte
+	size   int
+	next   int
+	layout string
+
+	server *server
+}
+
+// NewSegment creates a new empty stats segment with the given size in bytes.
+// If size is zero, DefaultSegmentSize is used.
+func NewSegment(size int) (*Segment, error) {
+	if size == 0 {
+		size = DefaultSegmentSize
+	}
+	if size < headerSize {
+		return nil, fmt.Errorf("segment size %d is smaller than the header size %d", size, headerSize)
+	}
+	fd, err := unix.MemfdCreate("govpp-stats-segment", unix.MFD_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("creating memfd failed: %v", err)
+	}
+	if err := unix.Ftruncate(fd, int64(size)); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("resizing memfd failed: %v", err)
+	}
+	file := os.NewFile(uintptr(fd), "govpp-stats-segment")
+	data, err := syscall.Mmap(int(file.Fd()), 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("mapping memfd failed: %v", err)
+	}
+	s := &Segment{
+		file: file,
+		data: data,
+		size: size,
+	}
+	s.putUint64(offVersion, segmentVersion)
+	s.putUint64(offBase, segmentBase)
+	s.putUint64(offErrVector, 0)
+	atomic.StoreInt64(s.headerField(offEpoch), 1)
+	if err := s.Write(nil); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Write lays out the entries followed by the symlinks into the segment.
+// The directory index of each entry is given by its position, the Index
+// field of the entries is ignored. The epoch is incremented only when
+// the directory layout changes (names, types or vector dimensions), so
+// writing the same entries with different values updates the counters
+// in place like VPP does.
+//
+// Entries of type adapter.ErrorIndex are not part of the version 2 layout
+// and are rejected, VPP exports error counters as simple counter vectors.
+func (s *Segment) Write(entries []adapter.StatEntry, symlinks ...Symlink) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data == nil {
+		return fmt.Errorf("segment is closed")
+	}
+
+	layout, err := directoryLayout(entries, symlinks)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(s.headerField(offInProgress), 1)
+	defer atomic.StoreInt64(s.headerField(offInProgress), 0)
+
+	s.next = headerSize
+	dirLen := len(entries) + len(symlinks)
+	dirVec, err := s.allocVector(dirLen, dirEntrySize)
+	if err != nil {
+		return err
+	}
+	for i, entry := range entries {
+		typ, union, err := s.writeStat(entry.Data)
+		if err != nil {
+			return fmt.Errorf("writing entry %s failed: %v", entry.Name, err)
+		}
+		s.putDirEntry(dirVec+i*dirEntrySize, typ, union, string(entry.Name))
+	}
+	for i, link := range symlinks {
+		target := -1
+		for j, entry := range entries {
+			if string(entry.Name) == link.Target {
+				target = j
+				break
+			}
+		}
+		if target < 0 {
+			return fmt.Errorf("symlink %s points to unknown entry %s", link.Name, link.Target)
+		}
+		union := uint64(target) | uint64(link.Index)<<32
+		s.putDirEntry(dirVec+(len(entries)+i)*dirEntrySize, dirTypeSymlink, union, link.Name)
+	}
+	s.putUint64(offDirVector, s.pointer(dirVec))
+
+	if layout != s.layout {
+		s.layout = layout
+		atomic.AddInt64(s.headerField(offEpoch), 1)
+	}
+	return nil
+}
+
+// Epoch returns the current epoch of the segment.
+func (s *Segment) Epoch() int64 {
+	return atomic.LoadInt64(s.headerField(offEpoch))
+}
+
+// BumpEpoch increments the epoch of the segment, which invalidates
+// all directories prepared by the clients.
+func (s *Segment) BumpEpoch() {
+	atomic.AddInt64(s.headerField(offEpoch), 1)
+}
+
+// SetInProgress sets the in-progress flag of the segment. Clients do
+// not read the segment data while the flag is set.
+func (s *Segment) SetInProgress(inProgress bool) {
+	var v int64
+	if inProgress {
+		v = 1
+	}
+	atomic.StoreInt64(s.headerField(offInProgress), v)
+}
+
+// Close stops serving the segment, unmaps the shared memory and closes the memfd.
+func (s *Segment) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var errs []string
+	if s.server != nil {
+		if err := s.server.close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		s.server = nil
+	}
+	if s.data != nil {
+		if err := syscall.Munmap(s.data); err != nil {
+			errs = append(errs, fmt.Sprintf("unmapping segment failed: %v", err))
+		}
+		s.data = nil
+	}
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("closing memfd failed: %v", err))
+		}
+		s.file = nil
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("closing segment failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// writeStat writes stat data into the segment and returns the directory
+// type with the value of the directory entry union.
+func (s *Segment) writeStat(stat adapter.Stat) (typ int32, union uint64, err error) {
+	switch d := stat.(type) {
+	case nil:
+		return dirTypeEmpty, 0, nil
+	case adapter.EmptyStat:
+		return dirTypeEmpty, 0, nil
+	case adapter.ScalarStat:
+		return dirTypeScalarIndex, uint64(d), nil
+	case adapter.GaugeStat:
+		return dirTypeGaugeIndex, uint64(d), nil
+	case adapter.SimpleCounterStat:
+		threads := make([][]uint64, len(d))
+		for i, counters := range d {
+			if counters == nil {
+				continue
+			}
+			threads[i] = make([]uint64, len(counters))
+			for j, c := range counters {
+				threads[i][j] = uint64(c)
+			}
+		}
+		vec, err := s.writePerThread(threads, 1)
+		return dirTypeSimpleCounterVector, vec, err
+	case adapter.CombinedCounterStat:
+		threads := make([][]uint64, len(d))
+		for i, counters := range d {
+			if counters == nil {
+				continue
+			}
+			threads[i] = make([]uint64, 0, 2*len(counters))
+			for _, c := range counters {
+				threads[i] = append(threads[i], c[0], c[1])
+			}
+		}
+		vec, err := s.writePerThread(threads, 2)
+		return dirTypeCombinedCounterVector, vec, err
+	case adapter.NameStat:
+		vec, err := s.allocVector(len(d), 8)
+		if err != nil {
+			return 0, 0, err
+		}
+		for i, name := range d {
+			if len(name) == 0 {
+				continue
+			}
+			nameVec, err := s.allocVector(len(name)+1, 1)
+			if err != nil {
+				return 0, 0, err
+			}
+			copy(s.data[nameVec:], name)
+			s.data[nameVec+len(name)] = 0
+			s.putUint64(vec+i*8, s.pointer(nameVec))
+		}
+		return dirTypeNameVector, s.pointer(vec), nil
+	case adapter.HistogramLog2Stat:
+		threads := make([][]uint64, len(d))
+		for i, bin := range d {
+			threads[i] = append([]uint64{bin.MinExp}, bin.Counts...)
+		}
+		vec, err := s.writePerThread(threads, 1)
+		return dirTypeHistogramLog2, vec, err
+	case adapter.RingBufferStat:
+		base, err := s.writeRingBuffer(d)
+		return dirTypeRingBuffer, base, err
+	case adapter.ErrorStat:
+		return 0, 0, fmt.Errorf("error index stats are not supported by segment version %d", segmentVersion)
+	default:
+		return 0, 0, fmt.Errorf("unsupported stat type %T", stat)
+	}
+}
+
+// writePerThread writes vector of per-thread vectors, where each thread
+// vector element consists of elemWords 64-bit words.
+func (s *Segment) writePerThread(threads [][]uint64, elemWords int) (uint64, error) {
+	vec, err := s.allocVector(len(threads), 8)
+	if err != nil {
+		return 0, err
+	}
+	for i, words := range threads {
+		if words == nil {
+			continue
+		}
+		threadVec, err := s.allocVector(len(words)/elemWords, 8*elemWords)
+		if err != nil {
+			return 0, err
+		}
+		for j, w := range words {
+			s.putUint64(threadVec+j*8, w)
+		}
+		s.putUint64(vec+i*8, s.pointer(threadVec))
+	}
+	return s.pointer(vec), nil
+}
+
+// writeRingBuffer writes ring buffer header followed by per-thread metadata,
+// the schema and the per-thread ring data.
+func (s *Segment) writeRingBuffer(rb adapter.RingBufferStat) (uint64, error) {
+	cfg := rb.Config
+	metaOffset := align(ringHeaderSize, ringMetaSize)
+	schemaOffset := metaOffset + int(cfg.NThreads)*ringMetaSize
+	dataOffset := align(schemaOffset+len(rb.Schema), 8)
+	threadDataSize := int(cfg.RingSize) * int(cfg.EntrySize)
+
+	base, err := s.alloc(dataOffset + int(cfg.NThreads)*threadDataSize)
+	if err != nil {
+		return 0, err
+	}
+	le := binary.NativeEndian
+	hdr := s.data[base:]
+	le.PutUint32(hdr[0:], cfg.EntrySize)
+	le.PutUint32(hdr[4:], cfg.RingSize)
+	le.PutUint32(hdr[8:], cfg.NThreads)
+	le.PutUint32(hdr[12:], uint32(len(rb.Schema)))
+	le.PutUint32(hdr[16:], cfg.SchemaVersion)
+	le.PutUint32(hdr[20:], uint32(metaOffset))
+	le.PutUint32(hdr[24:], uint32(dataOffset))
+
+	for i := 0; i < int(cfg.NThreads); i++ {
+		var meta adapter.RingBufferThreadMeta
+		if i < len(rb.Threads) {
+			meta = rb.Threads[i]
+		}
+		m := s.data[base+metaOffset+i*ringMetaSize:]
+		le.PutUint32(m[0:], meta.Head)
+		le.PutUint32(m[4:], meta.SchemaVersion)
+		le.PutUint64(m[8:], meta.Sequence)
+		if len(rb.Schema) > 0 {
+			le.PutUint32(m[16:], uint32(schemaOffset))
+			le.PutUint32(m[20:], uint32(len(rb.Schema)))
+		}
+	}
+	copy(s.data[base+schemaOffset:], rb.Schema)
+	for i := 0; i < int(cfg.NThreads) && i < len(rb.Data); i++ {
+		start := base + dataOffset + i*threadDataSize
+		copy(s.data[start:start+threadDataSize], rb.Data[i])
+	}
+	return s.pointer(base), nil
+}
+
+func (s *Segment) putDirEntry(offset int, typ int32, union uint64, name string) {
+	e := s.data[offset : offset+dirEntrySize]
+	binary.NativeEndian.PutUint32(e[0:], uint32(typ))
+	binary.NativeEndian.PutUint64(e[8:], union)
+	clear(e[16:])
+	copy(e[16:], name)
+}
+
+// allocVector allocates VPP vector with n elements of the given size
+// and returns offset of the vector data (behind the vector header).
+func (s *Segment) allocVector(n, elemSize int) (int, error) {
+	offset, err := s.alloc(vecHeaderSize + n*elemSize)
+	if err != nil {
+		return 0, err
+	}
+	s.putUint64(offset, uint64(n))
+	return offset + vecHeaderSize, nil
+}
+
+// alloc allocates zeroed 8-byte aligned block of memory in the segment.
+func (s *Segment) alloc(size int) (int, error) {
+	offset := align(s.next, 8)
+	if offset+size > s.size {
+		return 0, fmt.Errorf("segment size %d exceeded (required %d)", s.size, offset+size)
+	}
+	clear(s.data[offset : offset+size])
+	s.next = offset + size
+	return offset, nil
+}
+
+// pointer converts segment offset to the address as seen by the writer.
+func (s *Segment) pointer(offset int) uint64 {
+	return segmentBase + uint64(offset)
+}
+
+func (s *Segment) putUint64(offset int, v uint64) {
+	binary.NativeEndian.PutUint64(s.data[offset:], v)
+}
+
+func (s *Segment) headerField(offset int) *int64 {
+	return (*int64)(unsafe.Pointer(&s.data[offset]))
+}
+
+// directoryLayout returns a string describing the directory layout, which
+// changes whenever the clients have to re-read the directory.
+func directoryLayout(entries []adapter.StatEntry, symlinks []Symlink) (string, error) {
+	var b strings.Builder
+	for _, entry := range entries {
+		if len(entry.Name) >= dirNameLen {
+			return "", fmt.Errorf("entry name %s exceeds %d characters", entry.Name, dirNameLen-1)
+		}
+		fmt.Fprintf(&b, "%s:%T", entry.Name, entry.Data)
+		switch d := entry.Data.(type) {
+		case adapter.SimpleCounterStat:
+			for _, t := range d {
+				fmt.Fprintf(&b, ",%d", len(t))
+			}
+		case adapter.CombinedCounterStat:
+			for _, t := range d {
+				fmt.Fprintf(&b, ",%d", len(t))
+			}
+		case adapter.NameStat:
+			fmt.Fprintf(&b, ",%d", len(d))
+		case adapter.HistogramLog2Stat:
+			for _, t := range d {
+				fmt.Fprintf(&b, ",%d", len(t.Counts))
+			}
+		case adapter.RingBufferStat:
+			fmt.Fprintf(&b, ",%+v,%d", d.Config, len(d.Schema))
+		}
+		b.WriteByte('\n')
+	}
+	for _, link := range symlinks {
+		if len(link.Name) >= dirNameLen {
+			return "", fmt.Errorf("symlink name %s exceeds %d characters", link.Name, dirNameLen-1)
+		}
+		fmt.Fprintf(&b, "%s->%s[%d]\n", link.Name, link.Target, link.Index)
+	}
+	return b.String(), nil
+}
+
+func align(n, to int) int {
+	return (n + to - 1) / to * to
+}
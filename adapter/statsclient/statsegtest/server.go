@@ -0,0 +1,84 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package statsegtest
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/ftrvxmtrx/fd"
+)
+
+// server passes the segment memfd to every client connecting to the socket
+type server struct {
+	socket   string
+	listener *net.UnixListener
+	wg       sync.WaitGroup
+}
+
+// Serve starts serving the segment on the unix socket. Like VPP, the memfd
+// is sent to every connecting client and the connection is closed afterwards.
+// The socket file is removed when the segment is closed.
+func (s *Segment) Serve(socket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.data == nil {
+		return fmt.Errorf("segment is closed")
+	}
+	if s.server != nil {
+		return fmt.Errorf("segment is already served on %s", s.server.socket)
+	}
+	listener, err := net.ListenUnix("unixpacket", &net.UnixAddr{Net: "unixpacket", Name: socket})
+	if err != nil {
+		return fmt.Errorf("listening on stats socket %s failed: %v", socket, err)
+	}
+	listener.SetUnlinkOnClose(true)
+	srv := &server{
+		socket:   socket,
+		listener: listener,
+	}
+	srv.wg.Add(1)
+	go srv.serve(s.file)
+	s.server = srv
+	return nil
+}
+
+func (srv *server) serve(file *os.File) {
+	defer srv.wg.Done()
+	for {
+		conn, err := srv.listener.AcceptUnix()
+		if err != nil {
+			// the listener was closed
+			return
+		}
+		// the client reports missing descriptor on its own
+		_ = fd.Put(conn, file)
+		_ = conn.Close()
+	}
+}
+
+func (srv *server) close() error {
+	err := srv.listener.Close()
+	srv.wg.Wait()
+	if err != nil {
+		return fmt.Errorf("closing stats socket %s failed: %v", srv.socket, err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsnapshot
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// implements StatsAPI
+var _ adapter.StatsAPI = (*StatsAdapter)(nil)
+
+// StatsAdapter is a read-only stats adapter serving entries of a snapshot.
+// The returned entries are copies, so modifying them does not change
+// the snapshot.
+type StatsAdapter struct {
+	path string
+
+	mu       sync.RWMutex
+	snapshot *Snapshot
+	byIndex  map[uint32]int // position of the entries in the snapshot by index
+}
+
+// NewStatsAdapter returns a new stats adapter serving the snapshot file,
+// which is loaded on Connect.
+func NewStatsAdapter(path string) *StatsAdapter {
+	return &StatsAdapter{
+		path: path,
+	}
+}
+
+// NewSnapshotStatsAdapter returns a new stats adapter serving the snapshot.
+func NewSnapshotStatsAdapter(s *Snapshot) *StatsAdapter {
+	a := &StatsAdapter{}
+	a.setSnapshot(s)
+	return a
+}
+
+// Connect loads the snapshot file, if the adapter was created with one.
+func (a *StatsAdapter) Connect() error {
+	if a.path == "" {
+		return nil
+	}
+	s, err := Load(a.path)
+	if err != nil {
+		return fmt.Errorf("loading stats snapshot failed: %w", err)
+	}
+	a.mu.Lock()
+	a.setSnapshot(s)
+	a.mu.Unlock()
+	return nil
+}
+
+// Disconnect releases the snapshot loaded from the file.
+func (a *StatsAdapter) Disconnect() error {
+	if a.path == "" {
+		return nil
+	}
+	a.mu.Lock()
+	a.setSnapshot(nil)
+	a.mu.Unlock()
+	return nil
+}
+
+// Snapshot returns the served snapshot.
+func (a *StatsAdapter) Snapshot() *Snapshot {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.snapshot
+}
+
+func (a *StatsAdapter) ListStats(patterns ...string) ([]adapter.StatIdentifier, error) {
+	entries, _, err := a.matchEntries(patterns)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]adapter.StatIdentifier, len(entries))
+	for i, entry := range entries {
+		identifiers[i] = entry.StatIdentifier
+	}
+	return identifiers, nil
+}
+
+func (a *StatsAdapter) DumpStats(patterns ...string) ([]adapter.StatEntry, error) {
+	entries, _, err := a.matchEntries(patterns)
+	return entries, err
+}
+
+func (a *StatsAdapter) PrepareDir(patterns ...string) (*adapter.StatDir, error) {
+	entries, epoch, err := a.matchEntries(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return &adapter.StatDir{
+		Epoch:   epoch,
+		Entries: entries,
+	}, nil
+}
+
+func (a *StatsAdapter) PrepareDirOnIndex(indexes ...uint32) (*adapter.StatDir, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.snapshot == nil {
+		return nil, adapter.ErrStatsDisconnected
+	}
+	dir := &adapter.StatDir{
+		Epoch: a.snapshot.Epoch,
+	}
+	for _, index := range indexes {
+		entry, ok := a.entryOnIndex(index)
+		if !ok {
+			return nil, fmt.Errorf("stat entry index %d not found in snapshot", index)
+		}
+		dir.Entries = append(dir.Entries, copyEntry(*entry))
+	}
+	return dir, nil
+}
+
+// UpdateDir refreshes the entries from the snapshot. Since the snapshot
+// never changes, the data stays the same as long as the epoch matches.
+func (a *StatsAdapter) UpdateDir(dir *adapter.StatDir) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.snapshot == nil {
+		return adapter.ErrStatsDisconnected
+	}
+	if dir.Epoch != a.snapshot.Epoch {
+		return adapter.ErrStatsDirStale
+	}
+	for i := range dir.Entries {
+		if entry, ok := a.entryOnIndex(dir.Entries[i].Index); ok {
+			dir.Entries[i].Data = copyStat(entry.Data)
+		}
+	}
+	return nil
+}
+
+// matchEntries returns entries matching any of the regex patterns, or all
+// entries if no pattern is given, together with the snapshot epoch.
+func (a *StatsAdapter) matchEntries(patterns []string) ([]adapter.StatEntry, int64, error) {
+	regexes := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, 0, fmt.Errorf("compiling regexp failed: %v", err)
+		}
+		regexes[i] = r
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.snapshot == nil {
+		return nil, 0, adapter.ErrStatsDisconnected
+	}
+	var entries []adapter.StatEntry
+	for _, entry := range a.snapshot.Entries {
+		if len(regexes) > 0 && !matchAny(regexes, entry.Name) {
+			continue
+		}
+		entries = append(entries, copyEntry(entry))
+	}
+	return entries, a.snapshot.Epoch, nil
+}
+
+// setSnapshot sets the served snapshot and indexes its entries.
+// The caller must hold a.mu unless the adapter is not shared yet.
+func (a *StatsAdapter) setSnapshot(s *Snapshot) {
+	a.snapshot = s
+	a.byIndex = nil
+	if s == nil {
+		return
+	}
+	a.byIndex = make(map[uint32]int, len(s.Entries))
+	for i, entry := range s.Entries {
+		if _, ok := a.byIndex[entry.Index]; !ok {
+			a.byIndex[entry.Index] = i
+		}
+	}
+}
+
+func (a *StatsAdapter) entryOnIndex(index uint32) (*adapter.StatEntry, bool) {
+	i, ok := a.byIndex[index]
+	if !ok {
+		return nil, false
+	}
+	return &a.snapshot.Entries[i], true
+}
+
+// copyEntry returns copy of the entry not sharing any data with it.
+func copyEntry(entry adapter.StatEntry) adapter.StatEntry {
+	entry.Name = append([]byte(nil), entry.Name...)
+	entry.Data = copyStat(entry.Data)
+	return entry
+}
+
+// copyStat returns copy of the stat not sharing any data with it.
+func copyStat(stat adapter.Stat) adapter.Stat {
+	switch s := stat.(type) {
+	case adapter.ErrorStat:
+		return append(adapter.ErrorStat(nil), s...)
+	case adapter.SimpleCounterStat:
+		c := make(adapter.SimpleCounterStat, len(s))
+		for i := range s {
+			c[i] = append([]adapter.Counter(nil), s[i]...)
+		}
+		return c
+	case adapter.CombinedCounterStat:
+		c := make(adapter.CombinedCounterStat, len(s))
+		for i := range s {
+			c[i] = append([]adapter.CombinedCounter(nil), s[i]...)
+		}
+		return c
+	case adapter.NameStat:
+		c := make(adapter.NameStat, len(s))
+		for i := range s {
+			c[i] = append(adapter.Name(nil), s[i]...)
+		}
+		return c
+	case adapter.HistogramLog2Stat:
+		c := make(adapter.HistogramLog2Stat, len(s))
+		for i := range s {
+			c[i] = adapter.HistogramLog2Bin{
+				MinExp: s[i].MinExp,
+				Counts: append([]uint64(nil), s[i].Counts...),
+			}
+		}
+		return c
+	case adapter.RingBufferStat:
+		c := adapter.RingBufferStat{
+			Config:  s.Config,
+			Threads: append([]adapter.RingBufferThreadMeta(nil), s.Threads...),
+			Schema:  append([]byte(nil), s.Schema...),
+			Data:    make([][]byte, len(s.Data)),
+		}
+		for i := range s.Data {
+			c.Data[i] = append([]byte(nil), s.Data[i]...)
+		}
+		return c
+	}
+	// the scalar stats are values
+	return stat
+}
+
+func matchAny(regexes []*regexp.Regexp, name []byte) bool {
+	for _, r := range regexes {
+		if r.Match(name) {
+			return true
+		}
+	}
+	return false
+}
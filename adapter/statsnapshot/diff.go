@@ -0,0 +1,148 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsnapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// Value is a single flattened value of a stat entry.
+type Value struct {
+	// Counter is the value of simple and error counters, the packet count
+	// of combined counters or the total count of histograms.
+	Counter uint64
+	// Bytes is the byte count of combined counters.
+	Bytes uint64
+	// Scalar is the value of scalar and gauge stats.
+	Scalar float64
+	// Name is the value of name vector items.
+	Name string
+}
+
+func (v Value) String() string {
+	switch {
+	case v.Name != "":
+		return strconv.Quote(v.Name)
+	case v.Bytes != 0:
+		return fmt.Sprintf("%d packets, %d bytes", v.Counter, v.Bytes)
+	case v.Scalar != 0:
+		return strconv.FormatFloat(v.Scalar, 'g', -1, 64)
+	}
+	return strconv.FormatUint(v.Counter, 10)
+}
+
+// Change represents a value that differs between two snapshots.
+type Change struct {
+	// Name is the stat entry name.
+	Name string
+	// Index is the index in the vector (interface index, node index..),
+	// zero for the entries with a single value.
+	Index uint32
+	// Old is the value in the old snapshot, nil if the value was added.
+	Old *Value
+	// New is the value in the new snapshot, nil if the value was removed.
+	New *Value
+}
+
+func (c Change) String() string {
+	from, to := "-", "-"
+	if c.Old != nil {
+		from = c.Old.String()
+	}
+	if c.New != nil {
+		to = c.New.String()
+	}
+	return fmt.Sprintf("%s[%d]: %s -> %s", c.Name, c.Index, from, to)
+}
+
+type valueKey struct {
+	name  string
+	index uint32
+}
+
+// Diff returns values that were changed, added or removed in the new snapshot
+// compared to the old one. The values of counters are reduced for all threads.
+// The changes are ordered by the entries of the new snapshot followed by
+// the removed values.
+func Diff(from, to *Snapshot) []Change {
+	oldValues, oldOrder := flatten(from)
+	newValues, order := flatten(to)
+
+	var changes []Change
+	for _, key := range order {
+		n := newValues[key]
+		o, ok := oldValues[key]
+		if !ok {
+			changes = append(changes, Change{Name: key.name, Index: key.index, New: &n})
+			continue
+		}
+		if o != n {
+			changes = append(changes, Change{Name: key.name, Index: key.index, Old: &o, New: &n})
+		}
+	}
+	for _, key := range oldOrder {
+		if _, ok := newValues[key]; !ok {
+			o := oldValues[key]
+			changes = append(changes, Change{Name: key.name, Index: key.index, Old: &o})
+		}
+	}
+	return changes
+}
+
+// flatten returns all values of the snapshot with their order of appearance.
+func flatten(s *Snapshot) (map[valueKey]Value, []valueKey) {
+	values := make(map[valueKey]Value)
+	var order []valueKey
+	add := func(name string, index int, v Value) {
+		key := valueKey{name: name, index: uint32(index)}
+		if _, ok := values[key]; !ok {
+			order = append(order, key)
+		}
+		values[key] = v
+	}
+	for _, entry := range s.Entries {
+		name := string(entry.Name)
+		switch d := entry.Data.(type) {
+		case adapter.ScalarStat:
+			add(name, 0, Value{Scalar: float64(d)})
+		case adapter.GaugeStat:
+			add(name, 0, Value{Scalar: float64(d)})
+		case adapter.ErrorStat:
+			var v Value
+			for _, c := range d {
+				v.Counter += uint64(c)
+			}
+			add(name, 0, v)
+		case adapter.SimpleCounterStat:
+			for i, c := range adapter.ReduceSimpleCounterStat(d) {
+				add(name, i, Value{Counter: c})
+			}
+		case adapter.CombinedCounterStat:
+			for i, c := range adapter.ReduceCombinedCounterStat(d) {
+				add(name, i, Value{Counter: c[0], Bytes: c[1]})
+			}
+		case adapter.NameStat:
+			for i, n := range d {
+				add(name, i, Value{Name: string(n)})
+			}
+		case adapter.HistogramLog2Stat:
+			add(name, 0, Value{Counter: d.Total()})
+		}
+	}
+	return values, order
+}
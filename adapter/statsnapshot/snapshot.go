@@ -0,0 +1,249 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package statsnapshot provides offline snapshots of the VPP stats segment.
+//
+// A Snapshot can be captured from any adapter.StatsAPI, saved to a file as
+// gzip-compressed JSON and loaded later on another machine. The StatsAdapter
+// serves the loaded snapshot as a read-only adapter.StatsAPI, and Diff reports
+// counters that changed between two snapshots.
+package statsnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// FormatVersion is the version of the snapshot file format.
+const FormatVersion = 1
+
+// Snapshot is a copy of the stats segment entries taken at a single moment.
+type Snapshot struct {
+	Timestamp time.Time
+	Epoch     int64
+	Entries   []adapter.StatEntry
+}
+
+// Capture takes a snapshot of all stat entries matching any of the patterns,
+// or all entries if no pattern is given.
+func Capture(stats adapter.StatsAPI, patterns ...string) (*Snapshot, error) {
+	dir, err := stats.PrepareDir(patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("preparing stats dir failed: %w", err)
+	}
+	return &Snapshot{
+		Timestamp: time.Now(),
+		Epoch:     dir.Epoch,
+		Entries:   dir.Entries,
+	}, nil
+}
+
+// snapshotFile is the serialized form of the snapshot
+type snapshotFile struct {
+	Version   int         `json:"version"`
+	Timestamp time.Time   `json:"timestamp"`
+	Epoch     int64       `json:"epoch"`
+	Entries   []entryFile `json:"entries"`
+}
+
+// entryFile is the serialized form of the stat entry, the data type
+// is stored explicitly to decode the data back
+type entryFile struct {
+	Index    uint32           `json:"index"`
+	Name     string           `json:"name"`
+	Type     adapter.StatType `json:"type"`
+	Symlink  bool             `json:"symlink,omitempty"`
+	DataType adapter.StatType `json:"data_type,omitempty"`
+	Data     json.RawMessage  `json:"data,omitempty"`
+}
+
+// Write writes the snapshot to w as gzip-compressed JSON.
+func Write(w io.Writer, s *Snapshot) error {
+	file := snapshotFile{
+		Version:   FormatVersion,
+		Timestamp: s.Timestamp,
+		Epoch:     s.Epoch,
+		Entries:   make([]entryFile, len(s.Entries)),
+	}
+	for i, entry := range s.Entries {
+		e := entryFile{
+			Index:   entry.Index,
+			Name:    string(entry.Name),
+			Type:    entry.Type,
+			Symlink: entry.Symlink,
+		}
+		if entry.Data != nil {
+			data, err := encodeStat(entry.Data)
+			if err != nil {
+				return fmt.Errorf("encoding stat entry %s failed: %w", entry.Name, err)
+			}
+			e.DataType = entry.Data.Type()
+			e.Data = data
+		}
+		file.Entries[i] = e
+	}
+
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(&file); err != nil {
+		return fmt.Errorf("encoding snapshot failed: %w", err)
+	}
+	return gz.Close()
+}
+
+// Read reads the snapshot from r. Both gzip-compressed and plain JSON is accepted.
+func Read(r io.Reader) (*Snapshot, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("reading compressed snapshot failed: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	} else {
+		r = br
+	}
+
+	var file snapshotFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("decoding snapshot failed: %w", err)
+	}
+	if file.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format version: %d", file.Version)
+	}
+
+	s := &Snapshot{
+		Timestamp: file.Timestamp,
+		Epoch:     file.Epoch,
+		Entries:   make([]adapter.StatEntry, len(file.Entries)),
+	}
+	for i, e := range file.Entries {
+		entry := adapter.StatEntry{
+			StatIdentifier: adapter.StatIdentifier{
+				Index: e.Index,
+				Name:  []byte(e.Name),
+			},
+			Type:    e.Type,
+			Symlink: e.Symlink,
+		}
+		if len(e.Data) > 0 {
+			data, err := decodeStat(e.DataType, e.Data)
+			if err != nil {
+				return nil, fmt.Errorf("decoding stat entry %s failed: %w", e.Name, err)
+			}
+			entry.Data = data
+		}
+		s.Entries[i] = entry
+	}
+	return s, nil
+}
+
+// Save writes the snapshot to the file.
+func Save(path string, s *Snapshot) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Write(f, s); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Load reads the snapshot from the file.
+func Load(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+func encodeStat(stat adapter.Stat) (json.RawMessage, error) {
+	switch s := stat.(type) {
+	case adapter.NameStat:
+		// names are stored as strings instead of base64
+		names := make([]*string, len(s))
+		for i, n := range s {
+			if n != nil {
+				name := string(n)
+				names[i] = &name
+			}
+		}
+		return json.Marshal(names)
+	default:
+		return json.Marshal(stat)
+	}
+}
+
+func decodeStat(typ adapter.StatType, data json.RawMessage) (adapter.Stat, error) {
+	var err error
+	switch typ {
+	case adapter.ScalarIndex:
+		var s adapter.ScalarStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.GaugeIndex:
+		var s adapter.GaugeStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.ErrorIndex:
+		var s adapter.ErrorStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.SimpleCounterVector:
+		var s adapter.SimpleCounterStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.CombinedCounterVector:
+		var s adapter.CombinedCounterStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.NameVector:
+		var names []*string
+		if err = json.Unmarshal(data, &names); err != nil {
+			return nil, err
+		}
+		s := make(adapter.NameStat, len(names))
+		for i, n := range names {
+			if n != nil {
+				s[i] = adapter.Name(*n)
+			}
+		}
+		return s, nil
+	case adapter.Empty:
+		var s adapter.EmptyStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.HistogramLog2:
+		var s adapter.HistogramLog2Stat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	case adapter.RingBuffer:
+		var s adapter.RingBufferStat
+		err = json.Unmarshal(data, &s)
+		return s, err
+	}
+	return nil, fmt.Errorf("unknown stat data type %q", typ)
+}
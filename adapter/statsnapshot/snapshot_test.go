@@ -0,0 +1,155 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsnapshot
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/core"
+)
+
+func newEntry(index uint32, name string, data adapter.Stat) adapter.StatEntry {
+	return adapter.StatEntry{
+		StatIdentifier: adapter.StatIdentifier{Index: index, Name: []byte(name)},
+		Type:           data.Type(),
+		Data:           data,
+	}
+}
+
+func testSnapshot() *Snapshot {
+	return &Snapshot{
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		Epoch:     7,
+		Entries: []adapter.StatEntry{
+			newEntry(0, "/sys/heartbeat", adapter.ScalarStat(42)),
+			newEntry(1, "/sys/num_worker_threads", adapter.GaugeStat(1)),
+			newEntry(2, "/err/ip4-input/bad checksum", adapter.ErrorStat{1, 2}),
+			newEntry(3, "/if/names", adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0"), nil}),
+			newEntry(4, "/if/drops", adapter.SimpleCounterStat{{1, 2, 0}, {3, 4, 0}}),
+			newEntry(5, "/if/rx", adapter.CombinedCounterStat{{{1, 64}, {2, 128}, {0, 0}}, {{3, 192}, {4, 256}, {0, 0}}}),
+			newEntry(6, "/removed", adapter.EmptyStat("<none>")),
+			newEntry(7, "/latency", adapter.HistogramLog2Stat{{MinExp: 3, Counts: []uint64{1, 5, 2}}}),
+			newEntry(8, "/ring", adapter.RingBufferStat{
+				Config:  adapter.RingBufferConfig{EntrySize: 2, RingSize: 1, NThreads: 1},
+				Threads: []adapter.RingBufferThreadMeta{{Head: 0, Sequence: 1}},
+				Schema:  []byte("{}"),
+				Data:    [][]byte{{1, 2}},
+			}),
+			{StatIdentifier: adapter.StatIdentifier{Index: 9, Name: []byte("/unknown")}, Type: adapter.Unknown},
+		},
+	}
+}
+
+func TestSnapshotSaveLoad(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "stats.json.gz")
+	Expect(Save(path, testSnapshot())).To(Succeed())
+
+	loaded, err := Load(path)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(loaded.Timestamp.Equal(testSnapshot().Timestamp)).To(BeTrue())
+	Expect(loaded.Epoch).To(Equal(testSnapshot().Epoch))
+	Expect(loaded.Entries).To(Equal(testSnapshot().Entries))
+}
+
+func TestStatsAdapter(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "stats.json.gz")
+	Expect(Save(path, testSnapshot())).To(Succeed())
+
+	statsAdapter := NewStatsAdapter(path)
+	_, err := statsAdapter.DumpStats()
+	Expect(err).To(MatchError(adapter.ErrStatsDisconnected))
+
+	conn, err := core.ConnectStats(statsAdapter)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	var ifStats api.InterfaceStats
+	Expect(conn.GetInterfaceStats(&ifStats)).To(Succeed())
+	Expect(ifStats.Interfaces).To(HaveLen(3))
+	Expect(ifStats.Interfaces[1].InterfaceName).To(Equal("eth0"))
+	Expect(ifStats.Interfaces[1].Drops).To(BeEquivalentTo(6))
+	Expect(ifStats.Interfaces[1].Rx).To(Equal(api.InterfaceCounterCombined{Packets: 6, Bytes: 384}))
+
+	identifiers, err := statsAdapter.ListStats("^/sys/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(identifiers).To(HaveLen(2))
+
+	dir, err := statsAdapter.PrepareDirOnIndex(4)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(dir.Entries).To(HaveLen(1))
+	Expect(statsAdapter.UpdateDir(dir)).To(Succeed())
+	dir.Epoch++
+	Expect(statsAdapter.UpdateDir(dir)).To(MatchError(adapter.ErrStatsDirStale))
+}
+
+func TestStatsAdapterCopies(t *testing.T) {
+	RegisterTestingT(t)
+
+	snapshot := testSnapshot()
+	statsAdapter := NewSnapshotStatsAdapter(snapshot)
+
+	dir, err := statsAdapter.PrepareDirOnIndex(8, 4, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(dir.Entries).To(Equal([]adapter.StatEntry{snapshot.Entries[8], snapshot.Entries[4], snapshot.Entries[3]}))
+	_, err = statsAdapter.PrepareDirOnIndex(10)
+	Expect(err).To(HaveOccurred())
+
+	// modifying the returned entries does not change the snapshot
+	dir.Entries[0].Data.(adapter.RingBufferStat).Data[0][0] = 9
+	dir.Entries[1].Data.(adapter.SimpleCounterStat)[0][0] = 9
+	dir.Entries[2].Data.(adapter.NameStat)[0][0] = 'x'
+	Expect(statsAdapter.UpdateDir(dir)).To(Succeed())
+	Expect(dir.Entries).To(Equal([]adapter.StatEntry{snapshot.Entries[8], snapshot.Entries[4], snapshot.Entries[3]}))
+
+	entries, err := statsAdapter.DumpStats("^/err/")
+	Expect(err).ShouldNot(HaveOccurred())
+	entries[0].Name[1] = 'x'
+	entries[0].Data.(adapter.ErrorStat)[0] = 9
+	Expect(snapshot.Entries).To(Equal(testSnapshot().Entries))
+}
+
+func TestDiff(t *testing.T) {
+	RegisterTestingT(t)
+
+	from := testSnapshot()
+	to := testSnapshot()
+	to.Entries[3].Data = adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0"), adapter.Name("eth1")}
+	to.Entries[4].Data = adapter.SimpleCounterStat{{1, 2, 1}, {3, 5, 0}}
+	to.Entries[7].Data = adapter.HistogramLog2Stat{{MinExp: 3, Counts: []uint64{1, 5, 3}}}
+	to.Entries = to.Entries[1:]
+
+	changes := Diff(from, to)
+	Expect(changes).To(Equal([]Change{
+		{Name: "/if/names", Index: 2, Old: &Value{}, New: &Value{Name: "eth1"}},
+		{Name: "/if/drops", Index: 1, Old: &Value{Counter: 6}, New: &Value{Counter: 7}},
+		{Name: "/if/drops", Index: 2, Old: &Value{Counter: 0}, New: &Value{Counter: 1}},
+		{Name: "/latency", Index: 0, Old: &Value{Counter: 8}, New: &Value{Counter: 9}},
+		{Name: "/sys/heartbeat", Index: 0, Old: &Value{Scalar: 42}},
+	}))
+	Expect(changes[1].String()).To(Equal("/if/drops[1]: 6 -> 7"))
+	Expect(changes[4].String()).To(Equal("/sys/heartbeat[0]: 42 -> -"))
+
+	Expect(Diff(to, from)).To(ContainElement(Change{Name: "/sys/heartbeat", Index: 0, New: &Value{Scalar: 42}}))
+}
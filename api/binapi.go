@@ -52,6 +52,16 @@ type Message interface {
 	GetMessageType() MessageType
 }
 
+// MessageFactory is implemented by the messages without generated types,
+// e.g. defined at runtime from the API definitions, whose new instances
+// cannot be created from their Go type.
+type MessageFactory interface {
+	Message
+
+	// NewMessage returns a new instance of the message.
+	NewMessage() Message
+}
+
 // DataType is an interface that is implemented by all VPP Binary API data types by the binapi_generator.
 type DataType interface {
 	// GetTypeName returns the original VPP name of the data type, as defined in the VPP API.
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 )
 
 // CompatibilityError is the error type usually returned by CheckCompatibility
@@ -25,6 +27,57 @@ func RetvalToVPPApiError(retval int32) error {
 	return VPPApiError(retval)
 }
 
+// ReplyErrorStream is implemented by the streams that describe the replies
+// with non-zero retval by ReplyError carrying the context of the request.
+type ReplyErrorStream interface {
+	// ReplyError returns error for the retval of reply received from the
+	// stream. Retval 0 returns nil error.
+	ReplyError(reply Message) error
+}
+
+// StreamReplyError returns error for retval value of the reply received from
+// stream. The error is ReplyError if stream implements ReplyErrorStream, or
+// VPPApiError otherwise. Retval 0 returns nil error.
+func StreamReplyError(stream Stream, reply Message, retval int32) error {
+	if retval == 0 {
+		return nil
+	}
+	if s, ok := stream.(ReplyErrorStream); ok {
+		return s.ReplyError(reply)
+	}
+	return VPPApiError(retval)
+}
+
+// ReplyError is the error returned for a reply with non-zero retval. It carries
+// the context of the failed request and wraps the VPPApiError, which means that
+// errors.Is(err, NO_SUCH_ENTRY) works the same as for the bare retval error.
+type ReplyError struct {
+	// Request is the name of the request message.
+	Request string
+	// RequestCRC is the CRC of the request message.
+	RequestCRC string
+	// Reply is the name of the reply message.
+	Reply string
+	// Retval is the error returned in the reply.
+	Retval VPPApiError
+	// ChannelID is the ID of the channel the request was sent on.
+	ChannelID uint16
+	// SeqNum is the sequence number of the request.
+	SeqNum uint16
+	// ConnID is the ID of the connection the request was sent on.
+	ConnID uint64
+}
+
+func (e *ReplyError) Error() string {
+	return fmt.Sprintf("%s (CRC %s) failed: %s returned %v (conn %d, channel %d, seqNum %d)",
+		e.Request, e.RequestCRC, e.Reply, e.Retval, e.ConnID, e.ChannelID, e.SeqNum)
+}
+
+// Unwrap returns the VPPApiError of the reply.
+func (e *ReplyError) Unwrap() error {
+	return e.Retval
+}
+
 // VPPApiError represents VPP's vnet API error that is usually
 // returned as Retval field in replies from VPP binary API.
 type VPPApiError int32
@@ -32,7 +85,7 @@ type VPPApiError int32
 func (e VPPApiError) Error() string {
 	errid := int64(e)
 	var errstr string
-	if s, ok := vppApiErrors[e]; ok {
+	if s, ok := e.description(); ok {
 		errstr = fmt.Sprintf("%s (%d)", s, errid)
 	} else {
 		errstr = strconv.FormatInt(errid, 10)
@@ -40,6 +93,134 @@ func (e VPPApiError) Error() string {
 	return fmt.Sprintf("VPPApiError: %s", errstr)
 }
 
+var (
+	registeredErrorsMu sync.RWMutex
+	registeredErrors   map[VPPApiError]string
+)
+
+// RegisterVPPApiErrors registers descriptions of the VPP API errors of the
+// running VPP version. The registered descriptions take precedence over the
+// static definitions from vnet/error.h, which can differ between VPP versions.
+func RegisterVPPApiErrors(errs map[VPPApiError]string) {
+	registeredErrorsMu.Lock()
+	defer registeredErrorsMu.Unlock()
+
+	if registeredErrors == nil {
+		registeredErrors = make(map[VPPApiError]string, len(errs))
+	}
+	for e, s := range errs {
+		registeredErrors[e] = s
+	}
+}
+
+// ResetVPPApiErrors removes all descriptions registered by RegisterVPPApiErrors.
+func ResetVPPApiErrors() {
+	registeredErrorsMu.Lock()
+	defer registeredErrorsMu.Unlock()
+
+	registeredErrors = nil
+}
+
+// description returns the registered or the static description of the error.
+func (e VPPApiError) description() (string, bool) {
+	registeredErrorsMu.RLock()
+	s, ok := registeredErrors[e]
+	registeredErrorsMu.RUnlock()
+	if ok {
+		return s, true
+	}
+	s, ok = vppApiErrors[e]
+	return s, ok
+}
+
+// IsNotFound returns true if the error is a VPP API error reporting
+// that the requested object does not exist.
+func IsNotFound(err error) bool {
+	return isVPPApiError(err, notFoundErrors)
+}
+
+// IsAlreadyExists returns true if the error is a VPP API error reporting
+// that the object to be created already exists.
+func IsAlreadyExists(err error) bool {
+	return isVPPApiError(err, alreadyExistsErrors)
+}
+
+// IsInUse returns true if the error is a VPP API error reporting
+// that the object is still in use and cannot be removed or modified.
+func IsInUse(err error) bool {
+	return isVPPApiError(err, inUseErrors)
+}
+
+// IsInvalidArgument returns true if the error is a VPP API error reporting
+// that the request contains invalid value.
+func IsInvalidArgument(err error) bool {
+	return isVPPApiError(err, invalidArgumentErrors)
+}
+
+// IsUnimplemented returns true if the error is a VPP API error reporting
+// that the requested operation is not implemented or supported.
+func IsUnimplemented(err error) bool {
+	return isVPPApiError(err, unimplementedErrors)
+}
+
+var (
+	notFoundErrors = map[VPPApiError]bool{
+		NO_SUCH_FIB:                     true,
+		NO_SUCH_INNER_FIB:               true,
+		NO_SUCH_LABEL:                   true,
+		NO_SUCH_ENTRY:                   true,
+		NO_SUCH_NODE:                    true,
+		NO_SUCH_NODE2:                   true,
+		NO_SUCH_TABLE:                   true,
+		NO_SUCH_TABLE2:                  true,
+		NO_SUCH_TABLE3:                  true,
+		ADDRESS_NOT_FOUND_FOR_INTERFACE: true,
+		CLASSIFY_TABLE_NOT_FOUND:        true,
+		BFD_ENOENT:                      true,
+		SUBIF_DOESNT_EXIST:              true,
+		NAME_SERVER_NOT_FOUND:           true,
+		NAME_SERVER_NO_SUCH_NAME:        true,
+	}
+	alreadyExistsErrors = map[VPPApiError]bool{
+		VLAN_ALREADY_EXISTS:  true,
+		SUBIF_ALREADY_EXISTS: true,
+		TUNNEL_EXIST:         true,
+		IF_ALREADY_EXISTS:    true,
+		VALUE_EXIST:          true,
+		BFD_EEXIST:           true,
+		ENTRY_ALREADY_EXISTS: true,
+		BD_ALREADY_EXISTS:    true,
+		DUPLICATE_IF_ADDRESS: true,
+	}
+	inUseErrors = map[VPPApiError]bool{
+		BFD_EINUSE:                   true,
+		ADDRESS_IN_USE:               true,
+		BD_IN_USE:                    true,
+		ACL_IN_USE_INBOUND:           true,
+		ACL_IN_USE_OUTBOUND:          true,
+		INSTANCE_IN_USE:              true,
+		ACL_IN_USE_BY_LOOKUP_CONTEXT: true,
+		RSRC_IN_USE:                  true,
+		UDP_PORT_TAKEN:               true,
+	}
+	invalidArgumentErrors = map[VPPApiError]bool{
+		INVALID_VALUE:    true,
+		INVALID_VALUE_2:  true,
+		INVALID_VALUE_3:  true,
+		INVALID_VALUE_4:  true,
+		INVALID_ARGUMENT: true,
+	}
+	unimplementedErrors = map[VPPApiError]bool{
+		UNIMPLEMENTED: true,
+		UNSUPPORTED:   true,
+	}
+)
+
+func isVPPApiError(err error, category map[VPPApiError]bool) bool {
+	var e VPPApiError
+	return errors.As(err, &e) && category[e]
+}
+
 // definitions from: vpp/src/vnet/error.h
 const (
 	_                                  VPPApiError = 0
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -21,3 +23,111 @@ func TestUnknown(t *testing.T) {
 	errstr := err.Error()
 	Expect(errstr).Should(BeEquivalentTo("VPPApiError: -999"))
 }
+
+func TestRegisterVPPApiErrors(t *testing.T) {
+	RegisterTestingT(t)
+	defer ResetVPPApiErrors()
+
+	RegisterVPPApiErrors(map[VPPApiError]string{
+		UNSPECIFIED: "Unspecified error of the running VPP",
+		-999:        "Runtime error",
+	})
+	Expect(UNSPECIFIED.Error()).Should(BeEquivalentTo("VPPApiError: Unspecified error of the running VPP (-1)"))
+	Expect(VPPApiError(-999).Error()).Should(BeEquivalentTo("VPPApiError: Runtime error (-999)"))
+	Expect(INVALID_SW_IF_INDEX.Error()).Should(BeEquivalentTo("VPPApiError: Invalid sw_if_index (-2)"))
+
+	ResetVPPApiErrors()
+	Expect(UNSPECIFIED.Error()).Should(BeEquivalentTo("VPPApiError: Unspecified Error (-1)"))
+	Expect(VPPApiError(-999).Error()).Should(BeEquivalentTo("VPPApiError: -999"))
+}
+
+func TestReplyError(t *testing.T) {
+	RegisterTestingT(t)
+
+	var err error = &ReplyError{
+		Request:    "sw_interface_set_flags",
+		RequestCRC: "0xf5aec1b8",
+		Reply:      "sw_interface_set_flags_reply",
+		Retval:     INVALID_SW_IF_INDEX,
+		ChannelID:  3,
+		SeqNum:     12,
+		ConnID:     1,
+	}
+	Expect(err.Error()).Should(BeEquivalentTo("sw_interface_set_flags (CRC 0xf5aec1b8) failed: " +
+		"sw_interface_set_flags_reply returned VPPApiError: Invalid sw_if_index (-2) (conn 1, channel 3, seqNum 12)"))
+	Expect(errors.Is(err, INVALID_SW_IF_INDEX)).To(BeTrue())
+	Expect(errors.Is(err, NO_SUCH_ENTRY)).To(BeFalse())
+
+	var apiErr VPPApiError
+	Expect(errors.As(fmt.Errorf("wrapped: %w", err), &apiErr)).To(BeTrue())
+	Expect(apiErr).To(Equal(INVALID_SW_IF_INDEX))
+}
+
+func TestErrorCategories(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(IsNotFound(NO_SUCH_ENTRY)).To(BeTrue())
+	Expect(IsNotFound(&ReplyError{Retval: NO_SUCH_FIB})).To(BeTrue())
+	Expect(IsNotFound(VALUE_EXIST)).To(BeFalse())
+	Expect(IsNotFound(errors.New("no such entry"))).To(BeFalse())
+	Expect(IsNotFound(nil)).To(BeFalse())
+
+	Expect(IsAlreadyExists(fmt.Errorf("create: %w", &ReplyError{Retval: IF_ALREADY_EXISTS}))).To(BeTrue())
+	Expect(IsAlreadyExists(NO_SUCH_ENTRY)).To(BeFalse())
+
+	Expect(IsInUse(BD_IN_USE)).To(BeTrue())
+	Expect(IsInUse(ADDRESS_NOT_IN_USE)).To(BeFalse())
+
+	Expect(IsInvalidArgument(&ReplyError{Retval: INVALID_VALUE_2})).To(BeTrue())
+	Expect(IsInvalidArgument(INVALID_SW_IF_INDEX)).To(BeFalse())
+
+	Expect(IsUnimplemented(UNSUPPORTED)).To(BeTrue())
+	Expect(IsUnimplemented(UNSPECIFIED)).To(BeFalse())
+}
+
+type testMessage struct {
+	name, crc string
+}
+
+func (m *testMessage) GetMessageName() string      { return m.name }
+func (m *testMessage) GetCrcString() string        { return m.crc }
+func (m *testMessage) GetMessageType() MessageType { return RequestMessage }
+
+type testStream struct {
+	Stream
+}
+
+type testReplyErrorStream struct {
+	Stream
+	req Message
+}
+
+func (s *testReplyErrorStream) ReplyError(reply Message) error {
+	return &ReplyError{
+		Request:    s.req.GetMessageName(),
+		RequestCRC: s.req.GetCrcString(),
+		Reply:      reply.GetMessageName(),
+		Retval:     BD_IN_USE,
+		ChannelID:  2,
+		SeqNum:     5,
+		ConnID:     1,
+	}
+}
+
+func TestStreamReplyError(t *testing.T) {
+	RegisterTestingT(t)
+
+	req := &testMessage{name: "bridge_domain_add_del", crc: "c1ea2bd5"}
+	reply := &testMessage{name: "bridge_domain_add_del_reply"}
+	stream := &testReplyErrorStream{req: req}
+	Expect(StreamReplyError(stream, reply, 0)).To(BeNil())
+
+	err := StreamReplyError(stream, reply, int32(BD_IN_USE))
+	Expect(err.Error()).Should(BeEquivalentTo("bridge_domain_add_del (CRC c1ea2bd5) failed: " +
+		"bridge_domain_add_del_reply returned VPPApiError: Bridge domain has member interfaces (-120) (conn 1, channel 2, seqNum 5)"))
+	Expect(IsInUse(err)).To(BeTrue())
+
+	// streams without the context return the bare error
+	err = StreamReplyError(&testStream{}, reply, int32(BD_IN_USE))
+	Expect(err).To(Equal(BD_IN_USE))
+}
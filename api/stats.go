@@ -14,6 +14,11 @@
 
 package api
 
+import (
+	"context"
+	"time"
+)
+
 // StatsProvider provides methods for retrieving statistics.
 type StatsProvider interface {
 	GetSystemStats(*SystemStats) error
@@ -24,6 +29,19 @@ type StatsProvider interface {
 	GetMemoryStats(*MemoryStats) error
 }
 
+// StatsProviderContext extends StatsProvider with methods accepting context,
+// which can be used to cancel retrieving the statistics.
+type StatsProviderContext interface {
+	StatsProvider
+
+	GetSystemStatsContext(context.Context, *SystemStats) error
+	GetNodeStatsContext(context.Context, *NodeStats) error
+	GetInterfaceStatsContext(context.Context, *InterfaceStats) error
+	GetErrorStatsContext(context.Context, *ErrorStats) error
+	GetBufferStatsContext(context.Context, *BufferStats) error
+	GetMemoryStatsContext(context.Context, *MemoryStats) error
+}
+
 // SystemStats represents global system statistics.
 type SystemStats struct {
 	VectorRate          uint64
@@ -51,11 +69,37 @@ type NodeCounters struct {
 	Suspends uint64
 }
 
+// NodeThreadStats represents per node statistics for every thread.
+type NodeThreadStats struct {
+	Threads []NodeThreadCounters
+}
+
+// NodeThreadCounters represents node counters of a single thread.
+type NodeThreadCounters struct {
+	ThreadIndex uint32
+	ThreadName  string // empty unless the thread names are known, see core.GetThreadNames
+
+	Nodes []NodeCounters
+}
+
 // InterfaceStats represents per interface statistics.
 type InterfaceStats struct {
 	Interfaces []InterfaceCounters
 }
 
+// InterfaceThreadStats represents per interface statistics for every thread.
+type InterfaceThreadStats struct {
+	Threads []InterfaceThreadCounters
+}
+
+// InterfaceThreadCounters represents interface counters of a single thread.
+type InterfaceThreadCounters struct {
+	ThreadIndex uint32
+	ThreadName  string // empty unless the thread names are known, see core.GetThreadNames
+
+	Interfaces []InterfaceCounters
+}
+
 // InterfaceCounters represents interface counters.
 type InterfaceCounters struct {
 	InterfaceIndex uint32
@@ -98,6 +142,11 @@ type ErrorStats struct {
 type ErrorCounter struct {
 	CounterName string
 
+	// Node is the name of the node counting the error, e.g. ip4-input.
+	Node string
+	// Reason is the error reason, e.g. bad checksum.
+	Reason string
+
 	Values []uint64
 }
 
@@ -137,3 +186,98 @@ type MemoryCounters struct {
 	FreeChunks uint64
 	Releasable uint64
 }
+
+// StatsSnapshot represents single sample of the counters watched
+// by the stats connection.
+type StatsSnapshot struct {
+	// Timestamp is the time when the counters were read.
+	Timestamp time.Time
+	// Elapsed is the time since the previous snapshot, zero for the first one.
+	Elapsed time.Duration
+	// Epoch is the epoch of the stats directory the counters were read from.
+	Epoch int64
+	// Counters contains values of all watched counters.
+	Counters []CounterSample
+	// Err is set if reading the counters failed, the snapshot has no counters then.
+	Err error
+}
+
+// CounterSample represents a counter value reduced for all threads together
+// with its change since the previous snapshot.
+type CounterSample struct {
+	// Name is the stats entry name, e.g. /if/rx.
+	Name string
+	// Index is the index in the counter vector (interface index, node index..),
+	// always zero for the error counters.
+	Index uint32
+
+	// Value is the counter value, or the packet count for combined counters.
+	Value uint64
+	// Bytes is the byte count for combined counters.
+	Bytes uint64
+
+	// Delta is the change of Value since the previous snapshot.
+	Delta uint64
+	// BytesDelta is the change of Bytes since the previous snapshot.
+	BytesDelta uint64
+	// Rate is the per-second rate of Value.
+	Rate float64
+	// BytesRate is the per-second rate of Bytes.
+	BytesRate float64
+
+	// Combined is true for combined counters (packets and bytes).
+	Combined bool
+	// New is true if the counter was not present in the previous snapshot.
+	New bool
+	// Reset is true if the counter decreased since the previous snapshot,
+	// which happens when VPP restarts or the counters are cleared.
+	Reset bool
+}
+
+// StatsQueryResult represents stat entries returned by the stats query.
+type StatsQueryResult struct {
+	// Epoch is the epoch of the stats directory the entries were read from.
+	Epoch int64
+	// Entries contains all stat entries matching the query.
+	Entries []StatsQueryEntry
+}
+
+// StatsQueryEntry represents a single stat entry with its values reduced
+// for all threads.
+type StatsQueryEntry struct {
+	// Name is the stats entry name, e.g. /if/rx.
+	Name string
+	// Type is the type of the stat data, e.g. SimpleCounterVector.
+	Type string
+	// Symlink is true if the entry is a symlink to another entry.
+	Symlink bool
+
+	// ErrorNode is the name of the node for error counters, e.g. ip4-input.
+	ErrorNode string
+	// ErrorReason is the error reason for error counters, e.g. bad checksum.
+	ErrorReason string
+
+	// Values contains the values of the entry, one for each index
+	// of vector entries.
+	Values []StatsQueryValue
+}
+
+// StatsQueryValue represents a single value of the stat entry.
+type StatsQueryValue struct {
+	// Index is the index in the vector (interface index, node index..),
+	// zero for the entries with a single value.
+	Index uint32
+	// Label is the name the index refers to, e.g. the interface name for
+	// interface counters or the node name for node counters.
+	Label string
+
+	// Value is the counter value, the packet count for combined counters
+	// or the total count of histograms.
+	Value uint64
+	// Bytes is the byte count for combined counters.
+	Bytes uint64
+	// Scalar is the value of scalar and gauge stats.
+	Scalar float64
+	// Name is the value of name vector items.
+	Name string
+}
@@ -54,7 +54,7 @@ func (c *serviceClient_GraphNodeGetClient) Recv() (*GraphNodeDetails, *GraphNode
 	case *GraphNodeDetails:
 		return m, nil, nil
 	case *GraphNodeGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
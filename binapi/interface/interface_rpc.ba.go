@@ -460,7 +460,7 @@ func (c *serviceClient_SwInterfaceTxPlacementGetClient) Recv() (*SwInterfaceTxPl
 	case *SwInterfaceTxPlacementDetails:
 		return m, nil, nil
 	case *SwInterfaceTxPlacementGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -395,7 +395,7 @@ func (c *serviceClient_IPPathMtuGetClient) Recv() (*IPPathMtuDetails, *IPPathMtu
 	case *IPPathMtuDetails:
 		return m, nil, nil
 	case *IPPathMtuGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
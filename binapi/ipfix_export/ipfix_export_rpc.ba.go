@@ -63,7 +63,7 @@ func (c *serviceClient_IpfixAllExporterGetClient) Recv() (*IpfixAllExporterDetai
 	case *IpfixAllExporterDetails:
 		return m, nil, nil
 	case *IpfixAllExporterGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
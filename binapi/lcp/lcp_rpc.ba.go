@@ -127,7 +127,7 @@ func (c *serviceClient_LcpItfPairGetClient) Recv() (*LcpItfPairDetails, *LcpItfP
 	case *LcpItfPairDetails:
 		return m, nil, nil
 	case *LcpItfPairGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -173,7 +173,7 @@ func (c *serviceClient_LcpItfPairGetV2Client) Recv() (*LcpItfPairDetails, *LcpIt
 	case *LcpItfPairDetails:
 		return m, nil, nil
 	case *LcpItfPairGetV2Reply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -65,7 +65,7 @@ func (c *serviceClient_LldpDumpClient) Recv() (*LldpDetails, *LldpDumpReply, err
 	case *LldpDetails:
 		return m, nil, nil
 	case *LldpDumpReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
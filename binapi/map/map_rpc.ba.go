@@ -143,7 +143,7 @@ func (c *serviceClient_MapDomainsGetClient) Recv() (*MapDomainDetails, *MapDomai
 	case *MapDomainDetails:
 		return m, nil, nil
 	case *MapDomainsGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -64,7 +64,7 @@ func (c *serviceClient_MssClampGetClient) Recv() (*MssClampDetails, *MssClampGet
 	case *MssClampDetails:
 		return m, nil, nil
 	case *MssClampGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
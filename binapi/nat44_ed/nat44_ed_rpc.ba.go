@@ -227,7 +227,7 @@ func (c *serviceClient_Nat44EdOutputInterfaceGetClient) Recv() (*Nat44EdOutputIn
 	case *Nat44EdOutputInterfaceDetails:
 		return m, nil, nil
 	case *Nat44EdOutputInterfaceGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -494,7 +494,7 @@ func (c *serviceClient_Nat44EiOutputInterfaceGetClient) Recv() (*Nat44EiOutputIn
 	case *Nat44EiOutputInterfaceDetails:
 		return m, nil, nil
 	case *Nat44EiOutputInterfaceGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
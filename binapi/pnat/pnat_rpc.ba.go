@@ -106,7 +106,7 @@ func (c *serviceClient_PnatBindingsGetClient) Recv() (*PnatBindingsDetails, *Pna
 	case *PnatBindingsDetails:
 		return m, nil, nil
 	case *PnatBindingsGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
@@ -161,7 +161,7 @@ func (c *serviceClient_PnatInterfacesGetClient) Recv() (*PnatInterfacesDetails,
 	case *PnatInterfacesDetails:
 		return m, nil, nil
 	case *PnatInterfacesGetReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
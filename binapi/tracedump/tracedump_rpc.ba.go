@@ -89,7 +89,7 @@ func (c *serviceClient_TraceDumpClient) Recv() (*TraceDetails, *TraceDumpReply,
 	case *TraceDetails:
 		return m, nil, nil
 	case *TraceDumpReply:
-		if err := api.RetvalToVPPApiError(m.Retval); err != nil {
+		if err := api.StreamReplyError(c.Stream, m, m.Retval); err != nil {
 			c.Stream.Close()
 			return nil, m, err
 		}
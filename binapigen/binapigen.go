@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/internal/unionmember"
 )
 
 type File struct {
@@ -260,6 +261,56 @@ func (m *Union) resolveDependencies(gen *Generator) (err error) {
 	return nil
 }
 
+// UnionDiscriminator resolves the enum field selecting the active member of
+// a union field. The union field is given by the path of field indexes from
+// the outermost struct, structs[i] being the fields of the struct at level i.
+// The enum field is the nearest enum field preceding the path whose entry
+// names end with the member names, e.g. af (ADDRESS_IP4) for the un field of
+// address, or proto (FIB_API_PATH_NH_PROTO_IP4) for the nh field of fib_path.
+//
+// It returns the level of the struct containing the enum field, the enum
+// field and the member index selected by each enum value. The level is -1
+// if there is no such field.
+func UnionDiscriminator(structs [][]*Field, indexes []int) (level int, discr *Field, cases map[uint32]int) {
+	union := structs[len(indexes)-1][indexes[len(indexes)-1]]
+	var fields []*Field
+	switch {
+	case union.TypeUnion != nil:
+		fields = union.TypeUnion.Fields
+	case union.TypeAlias != nil && union.TypeAlias.TypeUnion != nil:
+		fields = union.TypeAlias.TypeUnion.Fields
+	}
+	if len(fields) == 0 || union.Array {
+		return -1, nil, nil
+	}
+	members := make([]string, len(fields))
+	for i, field := range fields {
+		members[i] = field.Name
+	}
+	level, index, entryCases := unionmember.Resolve(indexes, func(level, i int) []string {
+		field := structs[level][i]
+		if field.TypeEnum == nil || field.Array {
+			return nil
+		}
+		names := make([]string, len(field.TypeEnum.Entries))
+		for j, entry := range field.TypeEnum.Entries {
+			names[j] = entry.Name
+		}
+		return names
+	}, members)
+	if level < 0 {
+		return -1, nil, nil
+	}
+	discr = structs[level][index]
+	cases = make(map[uint32]int)
+	for j, entry := range discr.TypeEnum.Entries {
+		if _, ok := cases[entry.Value]; !ok && entryCases[j] >= 0 {
+			cases[entry.Value] = entryCases[j]
+		}
+	}
+	return level, discr, cases
+}
+
 // msgType determines message header fields
 type msgType int
 
@@ -561,6 +612,7 @@ type RPC struct {
 	MsgRequest *Message
 	MsgReply   *Message
 	MsgStream  *Message
+	MsgEvents  []*Message
 }
 
 func newRpc(file *File, service *Service, apitype vppapi.RPC) *RPC {
@@ -593,6 +645,14 @@ func (rpc *RPC) resolveMessages(gen *Generator) error {
 		}
 		rpc.MsgStream = msg
 	}
+	for _, event := range rpc.VPP.Events {
+		msg, ok := gen.messagesByName[event]
+		if !ok {
+			logf("rpc %v: no message for event type %v", rpc.GoName, event)
+			continue
+		}
+		rpc.MsgEvents = append(rpc.MsgEvents, msg)
+	}
 	return nil
 }
 
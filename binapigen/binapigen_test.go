@@ -13,3 +13,53 @@
 //  limitations under the License.
 
 package binapigen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func TestUnionDiscriminator(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	gen, err := New(Options{ImportPrefix: "example.com/binapi"}, &vppapi.VppInput{Schema: vppapi.Schema{Files: files}})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	address := gen.structsByName["address"]
+	fibPath := gen.structsByName["fib_path"]
+	fibPathNh := gen.structsByName["fib_path_nh"]
+	Expect(address).ToNot(BeNil())
+	Expect(fibPath).ToNot(BeNil())
+	Expect(fibPathNh).ToNot(BeNil())
+	fieldIndex := func(fields []*Field, name string) int {
+		for i, field := range fields {
+			if field.Name == name {
+				return i
+			}
+		}
+		t.Fatalf("field %s not found", name)
+		return -1
+	}
+
+	// af selects member of un in address
+	level, discr, cases := UnionDiscriminator([][]*Field{address.Fields}, []int{fieldIndex(address.Fields, "un")})
+	Expect(level).To(Equal(0))
+	Expect(discr.Name).To(Equal("af"))
+	Expect(cases).To(Equal(map[uint32]int{0: 0, 1: 1}))
+
+	// nh has no enum field, the address is selected by proto of fib_path
+	nh := []int{fieldIndex(fibPathNh.Fields, "address")}
+	level, _, _ = UnionDiscriminator([][]*Field{fibPathNh.Fields}, nh)
+	Expect(level).To(Equal(-1))
+
+	path := []int{fieldIndex(fibPath.Fields, "nh"), nh[0]}
+	level, discr, cases = UnionDiscriminator([][]*Field{fibPath.Fields, fibPathNh.Fields}, path)
+	Expect(level).To(Equal(0))
+	Expect(discr.Name).To(Equal("proto"))
+	Expect(cases).To(Equal(map[uint32]int{0: 0, 1: 1}))
+}
@@ -390,8 +390,17 @@ func fieldTagBinapi(field *Field) string {
 	}
 
 	// limit
-	if limit, ok := field.Meta[optFieldLimit]; ok && limit.(int) > 0 {
-		tag = append(tag, fmt.Sprintf("limit=%s", limit))
+	if limit, ok := field.Meta[optFieldLimit]; ok {
+		var n int
+		switch limit := limit.(type) {
+		case int:
+			n = limit
+		case float64: // numbers parsed from JSON
+			n = int(limit)
+		}
+		if n > 0 {
+			tag = append(tag, fmt.Sprintf("limit=%d", n))
+		}
 	}
 
 	// default value
@@ -474,3 +474,14 @@ func TestCommonInitialisms(t *testing.T) {
 	}
 }
 */
+
+func TestFieldTagBinapiLimit(t *testing.T) {
+	RegisterTestingT(t)
+
+	field := &Field{Field: vppapi.Field{
+		Name: "tag",
+		Type: "string",
+		Meta: map[string]interface{}{"limit": float64(64)},
+	}}
+	Expect(fieldTagBinapi(field)).To(Equal("string,name=tag,limit=64"))
+}
@@ -0,0 +1,401 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+	"strconv"
+)
+
+func init() {
+	RegisterPlugin("fake", GenerateFake)
+}
+
+// library dependencies
+const (
+	syncPkg = GoImportPath("sync")
+)
+
+// generated names
+const (
+	fakeGoPackageSuffix = "fake"           // suffix for the package with fakes
+	fakeServiceName     = "FakeRPCService" // name for the fake RPC service
+	fakeCallName        = "Call"           // name for the recorded call
+	fakeRPCPrefix       = "Fake"           // prefix for the programmable RPCs
+	fakeProgramPrefix   = "On"             // prefix for the methods returning programmable RPCs
+	fakeStreamSuffix    = "Client"         // suffix for the fake stream clients
+	fakeStreamPrefix    = fakeServiceName + "_"
+)
+
+// GenerateFake generates programmable fake of RPCService generated by the rpc
+// plugin, for unit testing the code using the service without VPP.
+//
+// The fake is generated into the <name>fake sub-package. It records the calls
+// and returns the replies and dump sequences scripted for each RPC, using the
+// fake stream clients generated for the dump RPCs.
+func GenerateFake(gen *Generator, file *File) *GenFile {
+	if file.Service == nil || len(file.Service.RPCs) == 0 {
+		return nil
+	}
+
+	logf("----------------------------")
+	logf(" Generate Fake - %s", file.Desc.Name)
+	logf("----------------------------")
+
+	fakeFile := fakeGoFile(file)
+	filename := path.Join(fakeFile.FilenamePrefix, file.Desc.Name+"_rpc_fake"+generatedFilenameSuffix)
+	g := gen.NewGenFile(filename, fakeFile)
+
+	// file header
+	genCodeGeneratedComment(g)
+	g.P()
+	g.P("// Package ", fakeFile.PackageName, " contains programmable fake of ", serviceApiName, " defined")
+	g.P("// by binapi package ", file.PackageName, ".")
+	g.P("package ", fakeFile.PackageName)
+	g.P()
+
+	genFakeService(g, file)
+	for _, rpc := range file.Service.RPCs {
+		logf(" gen fake RPC: %v (%s)", rpc.GoName, rpc.VPP.Request)
+
+		genFakeRPC(g, file, rpc)
+		if rpc.VPP.Stream {
+			genFakeStream(g, file, rpc)
+		}
+	}
+
+	return g
+}
+
+// fakeGoFile returns file describing the Go package with fakes of file.
+func fakeGoFile(file *File) *File {
+	pkg := cleanPackageName(baseName(string(file.GoImportPath)))
+	fakePath := GoImportPath(path.Join(string(file.GoImportPath), string(pkg)+fakeGoPackageSuffix))
+	return &File{
+		Desc:           file.Desc,
+		Generate:       file.Generate,
+		FilenamePrefix: path.Join(file.FilenamePrefix, baseName(string(fakePath))),
+		PackageName:    GoPackageName(baseName(string(fakePath))),
+		GoImportPath:   fakePath,
+		Version:        file.Version,
+		Imports:        file.Imports,
+	}
+}
+
+func genFakeService(g *GenFile, file *File) {
+	svcApi := file.GoImportPath.Ident(serviceApiName)
+
+	g.P("// ", fakeCallName, " is a call recorded by ", fakeServiceName, ".")
+	g.P("type ", fakeCallName, " struct {")
+	g.P("// Method is the name of the called method, e.g. ", file.Service.RPCs[0].GoName, ".")
+	g.P("Method string")
+	g.P("// Request is the request message passed to the method.")
+	g.P("Request ", govppApiPkg.Ident("Message"))
+	g.P("}")
+	g.P()
+
+	g.P("// ", fakeServiceName, " is programmable fake of ", g.GoIdent(svcApi), ". It records")
+	g.P("// the calls and returns the results scripted for each RPC by methods of")
+	g.P("// ", fakeRPCPrefix, "<RPC> returned by ", fakeProgramPrefix, "<RPC>, e.g. ", fakeProgramPrefix, file.Service.RPCs[0].GoName, ".")
+	g.P("// The calls without scripted result return zero reply or empty stream.")
+	g.P("//")
+	g.P("// The zero value is ready to use and it is safe for concurrent use.")
+	g.P("type ", fakeServiceName, " struct {")
+	g.P("mu ", syncPkg.Ident("Mutex"))
+	g.P("calls []", fakeCallName)
+	g.P()
+	for _, rpc := range file.Service.RPCs {
+		g.P(fakeFieldName(rpc), " ", fakeRPCPrefix+rpc.GoName)
+	}
+	g.P("}")
+	g.P()
+
+	g.P("var _ ", svcApi, " = (*", fakeServiceName, ")(nil)")
+	g.P()
+
+	g.P("// New", fakeServiceName, " returns new ", fakeServiceName, ".")
+	g.P("func New", fakeServiceName, "() *", fakeServiceName, " {")
+	g.P("return new(", fakeServiceName, ")")
+	g.P("}")
+	g.P()
+
+	g.P("// Calls returns all recorded calls in the order they were made.")
+	g.P("func (f *", fakeServiceName, ") Calls() []", fakeCallName, " {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("return append([]", fakeCallName, "(nil), f.calls...)")
+	g.P("}")
+	g.P()
+
+	g.P("func (f *", fakeServiceName, ") record(method string, in ", govppApiPkg.Ident("Message"), ") {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("f.calls = append(f.calls, ", fakeCallName, "{Method: method, Request: in})")
+	g.P("}")
+	g.P()
+}
+
+func genFakeRPC(g *GenFile, file *File, rpc *RPC) {
+	fakeRPC := fakeRPCPrefix + rpc.GoName
+	field := fakeFieldName(rpc)
+	req := g.GoIdent(rpc.MsgRequest.GoIdent)
+	fnType := "func(ctx " + g.GoIdent(contextPkg.Ident("Context")) + ", in *" + req + ") " + fakeRPCResults(g, file, rpc)
+
+	// programmable RPC
+	g.P("// ", fakeRPC, " programs the method ", rpc.GoName, " of ", fakeServiceName, ".")
+	g.P("type ", fakeRPC, " struct {")
+	g.P("mu ", syncPkg.Ident("Mutex"))
+	g.P("calls []*", req)
+	g.P("results []", fnType)
+	g.P("stub ", fnType)
+	g.P("}")
+	g.P()
+
+	g.P("// ", fakeProgramPrefix, rpc.GoName, " returns the programmable method ", rpc.GoName, ".")
+	g.P("func (f *", fakeServiceName, ") ", fakeProgramPrefix, rpc.GoName, "() *", fakeRPC, " {")
+	g.P("return &f.", field)
+	g.P("}")
+	g.P()
+
+	switch {
+	case rpc.VPP.Stream && rpc.MsgStream != nil:
+		details := g.GoIdent(rpc.MsgStream.GoIdent)
+		reply := g.GoIdent(rpc.MsgReply.GoIdent)
+		g.P("// Returns scripts the result of one call to be stream receiving details")
+		g.P("// followed by reply and err, or ", ioPkg.Ident("EOF"), " if err is nil. The scripted")
+		g.P("// results are returned by the calls in the order they were scripted.")
+		g.P("func (f *", fakeRPC, ") Returns(details []*", details, ", reply *", reply, ", err error) {")
+		g.P("f.push(func(ctx ", contextPkg.Ident("Context"), ", in *", req, ") (", file.GoImportPath.Ident(serviceApiName+"_"+rpc.GoName+"Client"), ", error) {")
+		g.P("return New", fakeStreamPrefix, rpc.GoName, fakeStreamSuffix, "(ctx, details, reply, err), nil")
+		g.P("})")
+		g.P("}")
+	case rpc.VPP.Stream:
+		details := g.GoIdent(rpc.MsgReply.GoIdent)
+		g.P("// Returns scripts the result of one call to be stream receiving details")
+		g.P("// followed by err, or ", ioPkg.Ident("EOF"), " if err is nil. The scripted results")
+		g.P("// are returned by the calls in the order they were scripted.")
+		g.P("func (f *", fakeRPC, ") Returns(details []*", details, ", err error) {")
+		g.P("f.push(func(ctx ", contextPkg.Ident("Context"), ", in *", req, ") (", file.GoImportPath.Ident(serviceApiName+"_"+rpc.GoName+"Client"), ", error) {")
+		g.P("return New", fakeStreamPrefix, rpc.GoName, fakeStreamSuffix, "(ctx, details, err), nil")
+		g.P("})")
+		g.P("}")
+	case rpc.MsgReply != nil:
+		reply := g.GoIdent(rpc.MsgReply.GoIdent)
+		g.P("// Returns scripts the result of one call to be reply and err. The scripted")
+		g.P("// results are returned by the calls in the order they were scripted.")
+		g.P("func (f *", fakeRPC, ") Returns(reply *", reply, ", err error) {")
+		g.P("f.push(func(", contextPkg.Ident("Context"), ", *", req, ") (*", reply, ", error) {")
+		g.P("return reply, err")
+		g.P("})")
+		g.P("}")
+	default:
+		g.P("// Returns scripts the result of one call to be err. The scripted results")
+		g.P("// are returned by the calls in the order they were scripted.")
+		g.P("func (f *", fakeRPC, ") Returns(err error) {")
+		g.P("f.push(func(", contextPkg.Ident("Context"), ", *", req, ") error {")
+		g.P("return err")
+		g.P("})")
+		g.P("}")
+	}
+	g.P()
+
+	g.P("// Stub sets fn called by the calls after all scripted results were returned.")
+	g.P("func (f *", fakeRPC, ") Stub(fn ", fnType, ") {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("f.stub = fn")
+	g.P("}")
+	g.P()
+
+	g.P("// Calls returns the requests of recorded calls.")
+	g.P("func (f *", fakeRPC, ") Calls() []*", req, " {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("return append([]*", req, "(nil), f.calls...)")
+	g.P("}")
+	g.P()
+
+	g.P("func (f *", fakeRPC, ") push(fn ", fnType, ") {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("f.results = append(f.results, fn)")
+	g.P("}")
+	g.P()
+
+	g.P("// next records the call and returns the function returning its result.")
+	g.P("func (f *", fakeRPC, ") next(in *", req, ") ", fnType, " {")
+	g.P("f.mu.Lock()")
+	g.P("defer f.mu.Unlock()")
+	g.P("f.calls = append(f.calls, in)")
+	g.P("if len(f.results) > 0 {")
+	g.P("fn := f.results[0]")
+	g.P("f.results = f.results[1:]")
+	g.P("return fn")
+	g.P("}")
+	g.P("return f.stub")
+	g.P("}")
+	g.P()
+
+	// method of the service
+	g.P("func (f *", fakeServiceName, ") ", rpcMethodSignatureOf(g, file, rpc), " {")
+	g.P("f.record(", strconv.Quote(rpc.GoName), ", in)")
+	g.P("if fn := f.", field, ".next(in); fn != nil {")
+	g.P("return fn(ctx, in)")
+	g.P("}")
+	switch {
+	case rpc.VPP.Stream && rpc.MsgStream != nil:
+		g.P("return New", fakeStreamPrefix, rpc.GoName, fakeStreamSuffix, "(ctx, nil, new(", rpc.MsgReply.GoIdent, "), nil), nil")
+	case rpc.VPP.Stream:
+		g.P("return New", fakeStreamPrefix, rpc.GoName, fakeStreamSuffix, "(ctx, nil, nil), nil")
+	case rpc.MsgReply != nil:
+		g.P("return new(", rpc.MsgReply.GoIdent, "), nil")
+	default:
+		g.P("return nil")
+	}
+	g.P("}")
+	g.P()
+}
+
+// fakeFieldName returns name of the field of fake service for the RPC.
+func fakeFieldName(rpc *RPC) string {
+	return "rpc" + rpc.GoName
+}
+
+// fakeRPCResults returns the results of the RPC method.
+func fakeRPCResults(g *GenFile, file *File, rpc *RPC) string {
+	switch {
+	case rpc.VPP.Stream:
+		return "(" + g.GoIdent(file.GoImportPath.Ident(serviceApiName+"_"+rpc.GoName+"Client")) + ", error)"
+	case rpc.MsgReply != nil:
+		return "(*" + g.GoIdent(rpc.MsgReply.GoIdent) + ", error)"
+	}
+	return "error"
+}
+
+// rpcMethodSignatureOf returns signature of the RPC method of RPCService
+// defined in the binapi package of file.
+func rpcMethodSignatureOf(g *GenFile, file *File, rpc *RPC) string {
+	s := rpc.GoName + "(ctx " + g.GoIdent(contextPkg.Ident("Context"))
+	s += ", in *" + g.GoIdent(rpc.MsgRequest.GoIdent) + ") "
+	return s + fakeRPCResults(g, file, rpc)
+}
+
+func genFakeStream(g *GenFile, file *File, rpc *RPC) {
+	streamApi := g.GoIdent(file.GoImportPath.Ident(serviceApiName + "_" + rpc.GoName + "Client"))
+	streamFake := fakeStreamPrefix + rpc.GoName + fakeStreamSuffix
+	withReply := rpc.MsgStream != nil
+
+	var details, reply string
+	if withReply {
+		details = g.GoIdent(rpc.MsgStream.GoIdent)
+		reply = g.GoIdent(rpc.MsgReply.GoIdent)
+	} else {
+		details = g.GoIdent(rpc.MsgReply.GoIdent)
+	}
+
+	g.P("// ", streamFake, " is fake ", streamApi, " receiving scripted messages.")
+	g.P("type ", streamFake, " struct {")
+	g.P("ctx ", contextPkg.Ident("Context"))
+	g.P("details []*", details)
+	if withReply {
+		g.P("reply *", reply)
+	}
+	g.P("err error")
+	g.P("}")
+	g.P()
+
+	g.P("var _ ", streamApi, " = (*", streamFake, ")(nil)")
+	g.P()
+
+	if withReply {
+		g.P("// New", streamFake, " returns stream receiving details followed by")
+		g.P("// reply and err, or ", ioPkg.Ident("EOF"), " if err is nil.")
+		g.P("func New", streamFake, "(ctx ", contextPkg.Ident("Context"), ", details []*", details, ", reply *", reply, ", err error) *", streamFake, " {")
+		g.P("return &", streamFake, "{ctx: ctx, details: details, reply: reply, err: err}")
+	} else {
+		g.P("// New", streamFake, " returns stream receiving details followed by")
+		g.P("// err, or ", ioPkg.Ident("EOF"), " if err is nil.")
+		g.P("func New", streamFake, "(ctx ", contextPkg.Ident("Context"), ", details []*", details, ", err error) *", streamFake, " {")
+		g.P("return &", streamFake, "{ctx: ctx, details: details, err: err}")
+	}
+	g.P("}")
+	g.P()
+
+	if withReply {
+		g.P("func (c *", streamFake, ") Recv() (*", details, ", *", reply, ", error) {")
+		g.P("if len(c.details) > 0 {")
+		g.P("m := c.details[0]")
+		g.P("c.details = c.details[1:]")
+		g.P("return m, nil, nil")
+		g.P("}")
+		g.P("if c.err != nil {")
+		g.P("return nil, c.reply, c.err")
+		g.P("}")
+		g.P("return nil, c.reply, ", ioPkg.Ident("EOF"))
+		g.P("}")
+	} else {
+		g.P("func (c *", streamFake, ") Recv() (*", details, ", error) {")
+		g.P("if len(c.details) > 0 {")
+		g.P("m := c.details[0]")
+		g.P("c.details = c.details[1:]")
+		g.P("return m, nil")
+		g.P("}")
+		g.P("if c.err != nil {")
+		g.P("return nil, c.err")
+		g.P("}")
+		g.P("return nil, ", ioPkg.Ident("EOF"))
+		g.P("}")
+	}
+	g.P()
+
+	g.P("func (c *", streamFake, ") Context() ", contextPkg.Ident("Context"), " {")
+	g.P("return c.ctx")
+	g.P("}")
+	g.P()
+
+	g.P("func (c *", streamFake, ") SendMsg(msg ", govppApiPkg.Ident("Message"), ") error {")
+	g.P("return ", fmtPkg.Ident("Errorf"), "(\"fake stream cannot send message %s\", msg.GetMessageName())")
+	g.P("}")
+	g.P()
+
+	g.P("func (c *", streamFake, ") RecvMsg() (", govppApiPkg.Ident("Message"), ", error) {")
+	g.P("if len(c.details) > 0 {")
+	g.P("m := c.details[0]")
+	g.P("c.details = c.details[1:]")
+	g.P("return m, nil")
+	g.P("}")
+	if withReply {
+		g.P("if c.reply != nil {")
+		g.P("m := c.reply")
+		g.P("c.reply = nil")
+		g.P("return m, nil")
+		g.P("}")
+	}
+	g.P("if c.err != nil {")
+	g.P("return nil, c.err")
+	g.P("}")
+	g.P("return nil, ", ioPkg.Ident("EOF"))
+	g.P("}")
+	g.P()
+
+	g.P("func (c *", streamFake, ") Close() error {")
+	g.P("c.details = nil")
+	if withReply {
+		g.P("c.reply = nil")
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,74 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func TestGenerateFake(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	input := &vppapi.VppInput{Schema: vppapi.Schema{Files: files}}
+
+	outDir := t.TempDir()
+	opts := Options{OutputDir: outDir, ImportPrefix: "example.com/binapi", NoVersionInfo: true}
+	gen, err := New(opts, input)
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, file := range gen.Files {
+		Expect(RunPlugin("fake", gen, file)).To(Succeed())
+	}
+	Expect(gen.Generate()).To(Succeed())
+
+	example := readGenerated(t, outDir, "example", "examplefake", "example_rpc_fake.ba.go")
+	Expect(example).To(ContainSubstring("package examplefake\n"))
+	Expect(example).To(ContainSubstring(`	example "example.com/binapi/example"`))
+	Expect(example).To(ContainSubstring("var _ example.RPCService = (*FakeRPCService)(nil)\n"))
+	Expect(example).To(ContainSubstring(`func (f *FakeRPCService) ExampleSet(ctx context.Context, in *example.ExampleSet) (*example.ExampleSetReply, error) {
+	f.record("ExampleSet", in)
+	if fn := f.rpcExampleSet.next(in); fn != nil {
+		return fn(ctx, in)
+	}
+	return new(example.ExampleSetReply), nil
+}`))
+	Expect(example).To(ContainSubstring("func (f *FakeRPCService) OnExampleGet() *FakeExampleGet {\n"))
+	Expect(example).To(ContainSubstring("func (f *FakeExampleGet) Returns(details []*example.ExampleDetails, reply *example.ExampleGetReply, err error) {\n"))
+	Expect(example).To(ContainSubstring("func (f *FakeExampleSet) Returns(reply *example.ExampleSetReply, err error) {\n"))
+	Expect(example).To(ContainSubstring("var _ example.RPCService_ExampleGetClient = (*FakeRPCService_ExampleGetClient)(nil)\n"))
+	Expect(example).To(ContainSubstring(`func (c *FakeRPCService_ExampleGetClient) Recv() (*example.ExampleDetails, *example.ExampleGetReply, error) {
+	if len(c.details) > 0 {
+		m := c.details[0]
+		c.details = c.details[1:]
+		return m, nil, nil
+	}
+	if c.err != nil {
+		return nil, c.reply, c.err
+	}
+	return nil, c.reply, io.EOF
+}`))
+
+	// no fake for API files without service
+	Expect(filepath.Glob(filepath.Join(outDir, "*", "*", "*.go"))).To(ConsistOf(
+		filepath.Join(outDir, "example", "examplefake", "example_rpc_fake.ba.go"),
+		filepath.Join(outDir, "ip", "ipfake", "ip_rpc_fake.ba.go"),
+	))
+}
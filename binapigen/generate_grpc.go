@@ -0,0 +1,351 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+	"strconv"
+)
+
+func init() {
+	RegisterPlugin("grpc", GenerateGRPC)
+}
+
+// library dependencies
+const (
+	grpcPkg       = GoImportPath("google.golang.org/grpc")
+	grpcbridgePkg = GoImportPath("go.fd.io/govpp/grpcbridge")
+)
+
+// generated names
+const (
+	grpcBridgeSuffix   = "Bridge"        // suffix for the gRPC server interface
+	grpcBridgeImplName = "serviceBridge" // name for the gRPC server implementation
+	grpcReplyTrailer   = "vpp-reply-bin" // trailer metadata with the reply of dump, same as grpcbridge.ReplyTrailer
+)
+
+// GenerateGRPC generates gRPC server for the service of the API file, which
+// implements the service of schema generated by the proto plugin by forwarding
+// the calls to VPP using RPCService generated by the rpc plugin.
+//
+// The server is generated into the <name>pb sub-package next to the converter
+// functions, and besides them it only needs the protobuf messages generated
+// by protoc-gen-go. Unary RPCs are forwarded as they are, dump RPCs stream
+// the details messages followed by the reply in trailer metadata, and the
+// RPCs watching events enable them, stream the events and disable them when
+// the last client watching them goes away.
+func GenerateGRPC(gen *Generator, file *File) *GenFile {
+	if file.Service == nil || len(file.Service.RPCs) == 0 {
+		return nil
+	}
+
+	logf("----------------------------")
+	logf(" Generate GRPC - %s", file.Desc.Name)
+	logf("----------------------------")
+
+	pbFile := protoGoFile(file)
+	filename := path.Join(pbFile.FilenamePrefix, file.Desc.Name+"_grpc"+generatedFilenameSuffix)
+	g := gen.NewGenFile(filename, pbFile)
+
+	// file header
+	genCodeGeneratedComment(g)
+	g.P()
+	g.P("package ", pbFile.PackageName)
+	g.P()
+
+	genGRPCBridge(g, file)
+
+	return g
+}
+
+func genGRPCBridge(g *GenFile, file *File) {
+	svcName := protoServiceName(file)
+	bridgeName := svcName + grpcBridgeSuffix
+	fullName := protoPackage(file.GoImportPath) + "." + svcName
+
+	var rpcs []*RPC
+	for _, rpc := range file.Service.RPCs {
+		if rpc.MsgReply != nil {
+			rpcs = append(rpcs, rpc)
+		}
+	}
+	watches := ProtoWatchRPCs(file.Service)
+
+	// generate server interface
+	g.P("// ", bridgeName, " is the server API for gRPC service ", fullName, ".")
+	g.P("type ", bridgeName, " interface {")
+	for _, rpc := range rpcs {
+		g.P(grpcMethodSignature(g, rpc.GoName, rpc.MsgRequest, grpcStreamMsg(rpc), rpc.VPP.Stream))
+	}
+	for _, watch := range watches {
+		g.P(grpcMethodSignature(g, watch.Name, watch.RPC.MsgRequest, watch.Event, true))
+	}
+	g.P("}")
+	g.P()
+
+	// generate server implementation
+	g.P("type ", grpcBridgeImplName, " struct {")
+	g.P("conn ", govppApiPkg.Ident("Connection"))
+	g.P("rpc ", file.GoImportPath.Ident(serviceApiName))
+	g.P("subs ", grpcbridgePkg.Ident("Subscriptions"))
+	g.P("}")
+	g.P()
+
+	g.P("// New", bridgeName, " returns ", bridgeName, " forwarding the calls to VPP")
+	g.P("// using connection conn.")
+	g.P("func New", bridgeName, "(conn ", govppApiPkg.Ident("Connection"), ") ", bridgeName, " {")
+	g.P("return &", grpcBridgeImplName, "{")
+	g.P("conn: conn,")
+	g.P("rpc: ", file.GoImportPath.Ident("New"+serviceClientName), "(conn),")
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("// Register", bridgeName, " registers srv as implementation of gRPC service")
+	g.P("// ", fullName, " in server s.")
+	g.P("func Register", bridgeName, "(s ", grpcPkg.Ident("ServiceRegistrar"), ", srv ", bridgeName, ") {")
+	g.P("s.RegisterService(&", bridgeName, "_ServiceDesc, srv)")
+	g.P("}")
+	g.P()
+
+	for _, rpc := range rpcs {
+		logf(" gen gRPC: %v (%s)", rpc.GoName, rpc.VPP.Request)
+		if rpc.VPP.Stream {
+			genGRPCStreamMethod(g, rpc)
+		} else {
+			genGRPCUnaryMethod(g, rpc)
+		}
+	}
+	for _, watch := range watches {
+		logf(" gen gRPC: %v (%s)", watch.Name, watch.Event.Name)
+		genGRPCWatchMethod(g, watch)
+	}
+
+	// generate handlers
+	for _, rpc := range rpcs {
+		if rpc.VPP.Stream {
+			genGRPCStreamHandler(g, bridgeName, rpc.GoName, rpc.MsgRequest, grpcStreamMsg(rpc))
+		} else {
+			genGRPCUnaryHandler(g, bridgeName, fullName, rpc)
+		}
+	}
+	for _, watch := range watches {
+		genGRPCStreamHandler(g, bridgeName, watch.Name, watch.RPC.MsgRequest, watch.Event)
+	}
+
+	// generate service descriptor
+	g.P("// ", bridgeName, "_ServiceDesc is the ", grpcPkg.Ident("ServiceDesc"), " for service ", fullName, ".")
+	g.P("var ", bridgeName, "_ServiceDesc = ", grpcPkg.Ident("ServiceDesc"), "{")
+	g.P("ServiceName: ", strconv.Quote(fullName), ",")
+	g.P("HandlerType: (*", bridgeName, ")(nil),")
+	g.P("Methods: []", grpcPkg.Ident("MethodDesc"), "{")
+	for _, rpc := range rpcs {
+		if !rpc.VPP.Stream {
+			g.P("{")
+			g.P("MethodName: ", strconv.Quote(rpc.GoName), ",")
+			g.P("Handler: ", grpcHandlerName(bridgeName, rpc.GoName), ",")
+			g.P("},")
+		}
+	}
+	g.P("},")
+	g.P("Streams: []", grpcPkg.Ident("StreamDesc"), "{")
+	streamDesc := func(name string) {
+		g.P("{")
+		g.P("StreamName: ", strconv.Quote(name), ",")
+		g.P("Handler: ", grpcHandlerName(bridgeName, name), ",")
+		g.P("ServerStreams: true,")
+		g.P("},")
+	}
+	for _, rpc := range rpcs {
+		if rpc.VPP.Stream {
+			streamDesc(rpc.GoName)
+		}
+	}
+	for _, watch := range watches {
+		streamDesc(watch.Name)
+	}
+	g.P("},")
+	g.P("Metadata: ", strconv.Quote(path.Join(file.Desc.Name, file.Desc.Name+protoFilenameSuffix)), ",")
+	g.P("}")
+}
+
+func genGRPCUnaryMethod(g *GenFile, rpc *RPC) {
+	g.P("func (s *", grpcBridgeImplName, ") ", grpcMethodSignature(g, rpc.GoName, rpc.MsgRequest, rpc.MsgReply, false), " {")
+	g.P("req, err := ", protoConvIdent(rpc.MsgRequest.GoIdent, protoFromSuffix), "(in)")
+	g.P("if err := ", grpcbridgePkg.Ident("CheckRequest"), "(req, err); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("out, err := s.rpc.", rpc.GoName, "(ctx, req)")
+	g.P("if err != nil {")
+	g.P("return nil, ", grpcbridgePkg.Ident("Status"), "(err)")
+	g.P("}")
+	g.P("return ", protoConvIdent(rpc.MsgReply.GoIdent, protoToSuffix), "(out), nil")
+	g.P("}")
+	g.P()
+}
+
+func genGRPCStreamMethod(g *GenFile, rpc *RPC) {
+	msg := grpcStreamMsg(rpc)
+	g.P("func (s *", grpcBridgeImplName, ") ", grpcMethodSignature(g, rpc.GoName, rpc.MsgRequest, msg, true), " {")
+	g.P("req, err := ", protoConvIdent(rpc.MsgRequest.GoIdent, protoFromSuffix), "(in)")
+	g.P("if err := ", grpcbridgePkg.Ident("CheckRequest"), "(req, err); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("client, err := s.rpc.", rpc.GoName, "(stream.Context(), req)")
+	g.P("if err != nil {")
+	g.P("return ", grpcbridgePkg.Ident("Status"), "(err)")
+	g.P("}")
+	g.P("defer client.Close()")
+	g.P("for {")
+	if rpc.MsgStream != nil {
+		g.P("details, reply, err := client.Recv()")
+		g.P("if reply != nil {")
+		g.P("if err := ", grpcbridgePkg.Ident("SetReplyTrailer"), "(stream, ", protoConvIdent(rpc.MsgReply.GoIdent, protoToSuffix), "(reply)); err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("}")
+	} else {
+		g.P("details, err := client.Recv()")
+	}
+	g.P("if err == ", ioPkg.Ident("EOF"), " {")
+	g.P("return nil")
+	g.P("}")
+	g.P("if err != nil {")
+	g.P("return ", grpcbridgePkg.Ident("Status"), "(err)")
+	g.P("}")
+	g.P("if err := stream.Send(", protoConvIdent(msg.GoIdent, protoToSuffix), "(details)); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+func genGRPCWatchMethod(g *GenFile, watch *ProtoWatchRPC) {
+	rpc := watch.RPC
+	g.P("func (s *", grpcBridgeImplName, ") ", grpcMethodSignature(g, watch.Name, rpc.MsgRequest, watch.Event, true), " {")
+	g.P("req, err := ", protoConvIdent(rpc.MsgRequest.GoIdent, protoFromSuffix), "(in)")
+	g.P("if err := ", grpcbridgePkg.Ident("CheckRequest"), "(req, err); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("ctx := stream.Context()")
+	g.P("watcher, err := s.conn.WatchEvent(ctx, &", watch.Event.GoIdent, "{})")
+	g.P("if err != nil {")
+	g.P("return ", grpcbridgePkg.Ident("Status"), "(err)")
+	g.P("}")
+	g.P("defer watcher.Close()")
+	if enable := watch.Enable; enable != nil {
+		g.P("// the events are shared by the watchers of the same request")
+		g.P("unsubscribe, err := s.subs.Subscribe(req, func() error {")
+		g.P("_, err := s.rpc.", rpc.GoName, "(ctx, req)")
+		g.P("return err")
+		g.P("}, func() {")
+		g.P("// disable the events, the stream context is already done")
+		g.P("disable := *req")
+		if enable.Type == BOOL {
+			g.P("disable.", enable.GoName, " = false")
+		} else {
+			g.P("disable.", enable.GoName, " = 0")
+		}
+		g.P("_, _ = s.rpc.", rpc.GoName, "(", contextPkg.Ident("Background"), "(), &disable)")
+		g.P("})")
+		g.P("if err != nil {")
+		g.P("return ", grpcbridgePkg.Ident("Status"), "(err)")
+		g.P("}")
+		g.P("defer unsubscribe()")
+	} else {
+		g.P("if _, err := s.rpc.", rpc.GoName, "(ctx, req); err != nil {")
+		g.P("return ", grpcbridgePkg.Ident("Status"), "(err)")
+		g.P("}")
+	}
+	g.P("for {")
+	g.P("select {")
+	g.P("case <-ctx.Done():")
+	g.P("return nil")
+	g.P("case msg, ok := <-watcher.Events():")
+	g.P("if !ok {")
+	g.P("return nil")
+	g.P("}")
+	g.P("event, ok := msg.(*", watch.Event.GoIdent, ")")
+	g.P("if !ok {")
+	g.P("continue")
+	g.P("}")
+	g.P("if err := stream.Send(", protoConvIdent(watch.Event.GoIdent, protoToSuffix), "(event)); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+func genGRPCUnaryHandler(g *GenFile, bridgeName, fullName string, rpc *RPC) {
+	in := g.GoIdent(protoGoIdent(rpc.MsgRequest.GoIdent))
+	g.P("func ", grpcHandlerName(bridgeName, rpc.GoName), "(srv any, ctx ", contextPkg.Ident("Context"), ", dec func(any) error, interceptor ", grpcPkg.Ident("UnaryServerInterceptor"), ") (any, error) {")
+	g.P("in := new(", in, ")")
+	g.P("if err := dec(in); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("if interceptor == nil {")
+	g.P("return srv.(", bridgeName, ").", rpc.GoName, "(ctx, in)")
+	g.P("}")
+	g.P("info := &", grpcPkg.Ident("UnaryServerInfo"), "{")
+	g.P("Server: srv,")
+	g.P("FullMethod: ", strconv.Quote("/"+fullName+"/"+rpc.GoName), ",")
+	g.P("}")
+	g.P("handler := func(ctx ", contextPkg.Ident("Context"), ", req any) (any, error) {")
+	g.P("return srv.(", bridgeName, ").", rpc.GoName, "(ctx, req.(*", in, "))")
+	g.P("}")
+	g.P("return interceptor(ctx, in, info, handler)")
+	g.P("}")
+	g.P()
+}
+
+func genGRPCStreamHandler(g *GenFile, bridgeName, name string, req, msg *Message) {
+	in := g.GoIdent(protoGoIdent(req.GoIdent))
+	out := g.GoIdent(protoGoIdent(msg.GoIdent))
+	g.P("func ", grpcHandlerName(bridgeName, name), "(srv any, stream ", grpcPkg.Ident("ServerStream"), ") error {")
+	g.P("in := new(", in, ")")
+	g.P("if err := stream.RecvMsg(in); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return srv.(", bridgeName, ").", name, "(in, &", grpcPkg.Ident("GenericServerStream"), "[", in, ", ", out, "]{ServerStream: stream})")
+	g.P("}")
+	g.P()
+}
+
+// grpcMethodSignature returns signature of gRPC server method, streaming
+// methods send messages of type msg to the stream.
+func grpcMethodSignature(g *GenFile, name string, req, msg *Message, stream bool) string {
+	in := g.GoIdent(protoGoIdent(req.GoIdent))
+	out := g.GoIdent(protoGoIdent(msg.GoIdent))
+	if stream {
+		return name + "(in *" + in + ", stream " + g.GoIdent(grpcPkg.Ident("ServerStreamingServer")) + "[" + out + "]) error"
+	}
+	return name + "(ctx " + g.GoIdent(contextPkg.Ident("Context")) + ", in *" + in + ") (*" + out + ", error)"
+}
+
+// grpcStreamMsg returns message sent by the RPC, which are the details
+// messages for streaming RPCs.
+func grpcStreamMsg(rpc *RPC) *Message {
+	if rpc.VPP.Stream && rpc.MsgStream != nil {
+		return rpc.MsgStream
+	}
+	return rpc.MsgReply
+}
+
+func grpcHandlerName(bridgeName, method string) string {
+	return "_" + bridgeName + "_" + method + "_Handler"
+}
@@ -0,0 +1,78 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func TestGenerateGRPC(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	input := &vppapi.VppInput{Schema: vppapi.Schema{Files: files}}
+
+	outDir := t.TempDir()
+	opts := Options{OutputDir: outDir, ImportPrefix: "example.com/binapi", NoVersionInfo: true}
+	gen, err := New(opts, input)
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, file := range gen.Files {
+		Expect(RunPlugin("grpc", gen, file)).To(Succeed())
+	}
+	Expect(gen.Generate()).To(Succeed())
+
+	example := readGenerated(t, outDir, "example", "examplepb", "example_grpc.ba.go")
+	Expect(example).To(ContainSubstring("package examplepb\n"))
+	Expect(example).To(ContainSubstring("type ExampleServiceBridge interface {\n" +
+		"\tExampleGet(in *ExampleGet, stream grpc.ServerStreamingServer[ExampleDetails]) error\n" +
+		"\tExampleSet(ctx context.Context, in *ExampleSet) (*ExampleSetReply, error)\n" +
+		"\tWantExampleEvents(ctx context.Context, in *WantExampleEvents) (*WantExampleEventsReply, error)\n" +
+		"\tWatchExampleEvent(in *WantExampleEvents, stream grpc.ServerStreamingServer[ExampleEvent]) error\n" +
+		"}\n"))
+	Expect(example).To(ContainSubstring(`	req, err := ExampleSetFromProto(in)
+	if err := grpcbridge.CheckRequest(req, err); err != nil {
+		return nil, err
+	}
+	out, err := s.rpc.ExampleSet(ctx, req)
+	if err != nil {
+		return nil, grpcbridge.Status(err)
+	}
+	return ExampleSetReplyToProto(out), nil`))
+	Expect(example).To(ContainSubstring(`		details, reply, err := client.Recv()
+		if reply != nil {
+			if err := grpcbridge.SetReplyTrailer(stream, ExampleGetReplyToProto(reply)); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}`))
+	Expect(example).To(ContainSubstring(`	unsubscribe, err := s.subs.Subscribe(req, func() error {
+		_, err := s.rpc.WantExampleEvents(ctx, req)
+		return err
+	}, func() {
+		// disable the events, the stream context is already done
+		disable := *req
+		disable.Enable = false
+		_, _ = s.rpc.WantExampleEvents(context.Background(), &disable)
+	})`))
+	Expect(example).To(ContainSubstring(`	ServiceName: "vpp.example.ExampleService",`))
+	Expect(example).To(ContainSubstring(`	Metadata: "example/example.proto",`))
+}
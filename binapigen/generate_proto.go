@@ -0,0 +1,540 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"go.fd.io/govpp/internal/version"
+)
+
+func init() {
+	RegisterPlugin("proto", GenerateProto)
+}
+
+const (
+	protoFilenameSuffix  = ".proto"
+	protoPackagePrefix   = "vpp."
+	protoGoPackageSuffix = "pb"
+	protoServiceSuffix   = "Service"
+	protoWatchPrefix     = "Watch"
+)
+
+// protoScalarTypes maps VPP base types to protobuf scalar types.
+var protoScalarTypes = map[string]string{
+	U8:     "uint32",
+	I8:     "int32",
+	U16:    "uint32",
+	I16:    "int32",
+	U32:    "uint32",
+	I32:    "int32",
+	U64:    "uint64",
+	I64:    "int64",
+	F64:    "double",
+	BOOL:   "bool",
+	STRING: "string",
+}
+
+// protoScalarGoTypes maps protobuf scalar types to Go types used by protoc-gen-go.
+var protoScalarGoTypes = map[string]string{
+	"uint32": "uint32",
+	"int32":  "int32",
+	"uint64": "uint64",
+	"int64":  "int64",
+	"double": "float64",
+	"bool":   "bool",
+	"string": "string",
+	"bytes":  "[]byte",
+}
+
+// GenerateProto generates Protocol Buffers schema for the API file and Go
+// package with functions converting between binapi types and protobuf messages.
+//
+// The schema is generated into <name>/<name>.proto, relative to the output
+// directory, which is also the root for imports between the schemas. Its
+// go_package option points to the <name>pb sub-package, where the converter
+// functions are generated and where protoc-gen-go is expected to generate
+// the protobuf messages.
+func GenerateProto(gen *Generator, file *File) *GenFile {
+	logf("----------------------------")
+	logf(" Generate PROTO - %s", file.Desc.Name)
+	logf("----------------------------")
+
+	g := genProtoSchema(gen, file)
+	genProtoConv(gen, file)
+
+	return g
+}
+
+func genProtoSchema(gen *Generator, file *File) *GenFile {
+	filename := path.Join(file.FilenamePrefix, file.Desc.Name+protoFilenameSuffix)
+	g := gen.NewGenFile(filename, file)
+
+	// file header
+	genCodeGeneratedComment(g)
+	if !gen.opts.NoVersionInfo {
+		g.P("// versions:")
+		g.P("//  binapi-generator: ", version.Version())
+		g.P("//  VPP:              ", gen.vppapiSchema.Version)
+		if !gen.opts.NoSourcePathInfo {
+			g.P("// source: ", file.Desc.Path)
+		}
+	}
+	g.P()
+	g.P("syntax = \"proto3\";")
+	g.P()
+	g.P("package ", protoPackage(file.GoImportPath), ";")
+	g.P()
+	pbPath := protoGoImportPath(file.GoImportPath)
+	g.P("option go_package = ", strconv.Quote(string(pbPath)+";"+baseName(string(pbPath))), ";")
+	g.P()
+
+	// schema imports
+	if imports := protoImports(file); len(imports) > 0 {
+		for _, imp := range imports {
+			g.P("import ", strconv.Quote(imp), ";")
+		}
+		g.P()
+	}
+
+	// API types
+	for _, enum := range file.Enums {
+		genProtoEnum(g, enum)
+	}
+	for _, typ := range file.Structs {
+		genGenericDefinesComment(g, typ.GoName, typ.Name, "type")
+		genProtoMessage(g, typ.GoName, typ.Fields, false)
+	}
+	for _, union := range file.Unions {
+		genProtoUnion(g, union)
+	}
+
+	// API messages
+	for _, msg := range file.Messages {
+		genGenericDefinesComment(g, msg.GoName, msg.Name, "message")
+		genMessageStatusInfoComment(g, msg)
+		status, _ := getMessageStatus(msg)
+		genProtoMessage(g, msg.GoName, msg.Fields, status == msgStatusDeprecated)
+	}
+
+	// API service
+	if file.Service != nil && len(file.Service.RPCs) > 0 {
+		genProtoService(g, file.Service)
+	}
+
+	return g
+}
+
+func genProtoEnum(g *GenFile, enum *Enum) {
+	genGenericDefinesComment(g, enum.GoName, enum.Name, "enum")
+
+	values, hasAlias := protoEnumValues(enum)
+	g.P("enum ", enum.GoName, " {")
+	if hasAlias {
+		g.P("  option allow_alias = true;")
+	}
+	for _, v := range values {
+		g.P("  ", v.name, " = ", int(v.value), ";")
+	}
+	g.P("}")
+	g.P()
+}
+
+type protoEnumValue struct {
+	name  string
+	value int32
+}
+
+// protoEnumValues returns values of protobuf enum for the enum, with the zero
+// value first as required by proto3, and reports whether some values repeat.
+func protoEnumValues(enum *Enum) (values []protoEnumValue, hasAlias bool) {
+	values = make([]protoEnumValue, 0, len(enum.Entries)+1)
+	var hasZero bool
+	seen := make(map[int32]bool)
+	for _, entry := range enum.Entries {
+		v := protoEnumValue{name: entry.Name, value: int32(entry.Value)}
+		if seen[v.value] {
+			hasAlias = true
+		}
+		seen[v.value] = true
+		if v.value == 0 && !hasZero {
+			hasZero = true
+			values = append([]protoEnumValue{v}, values...)
+		} else {
+			values = append(values, v)
+		}
+	}
+	if !hasZero {
+		zero := protoEnumValue{name: strings.ToUpper(enum.Name) + "_UNSPECIFIED"}
+		values = append([]protoEnumValue{zero}, values...)
+	}
+	return values, hasAlias
+}
+
+func genProtoMessage(g *GenFile, name string, fields []*Field, deprecated bool) {
+	g.P("message ", name, " {")
+	if deprecated {
+		g.P("  option deprecated = true;")
+	}
+	for i, field := range fields {
+		g.P("  ", protoFieldType(g, field), " ", field.Name, " = ", i+1, ";")
+	}
+	g.P("}")
+	g.P()
+}
+
+func genProtoUnion(g *GenFile, union *Union) {
+	genGenericDefinesComment(g, union.GoName, union.Name, "union")
+
+	g.P("message ", union.GoName, " {")
+	g.P("  oneof ", union.Name, " {")
+	for i, field := range union.Fields {
+		g.P("    ", protoFieldType(g, unionMemberField(field)), " ", field.Name, " = ", i+1, ";")
+	}
+	g.P("  }")
+	g.P("}")
+	g.P()
+}
+
+func genProtoService(g *GenFile, svc *Service) {
+	// RPC names are same as request names, so the messages are referenced
+	// by fully qualified names to avoid resolving them to the RPC methods
+	msgName := func(msg *Message) string {
+		return protoFullName(msg.GoIdent)
+	}
+
+	g.P("// ", protoServiceName(g.file), " defines RPC service ", g.file.Desc.Name, ".")
+	g.P("service ", protoServiceName(g.file), " {")
+	for _, rpc := range svc.RPCs {
+		if rpc.MsgReply == nil {
+			continue
+		}
+		if rpc.VPP.Stream {
+			reply := rpc.MsgReply
+			if rpc.MsgStream != nil {
+				reply = rpc.MsgStream
+				g.P("  // ", rpc.GoName, " ends with ", rpc.MsgReply.GoName, " sent in trailer metadata ", grpcReplyTrailer, ".")
+			}
+			g.P("  rpc ", rpc.GoName, "(", msgName(rpc.MsgRequest), ") returns (stream ", msgName(reply), ");")
+		} else {
+			g.P("  rpc ", rpc.GoName, "(", msgName(rpc.MsgRequest), ") returns (", msgName(rpc.MsgReply), ");")
+		}
+	}
+	for _, watch := range ProtoWatchRPCs(svc) {
+		g.P("  rpc ", watch.Name, "(", msgName(watch.RPC.MsgRequest), ") returns (stream ", msgName(watch.Event), ");")
+	}
+	g.P("}")
+}
+
+// ProtoWatchRPC is a server-streaming RPC of protobuf service, which streams
+// the event messages enabled by the RPC of VPP service.
+type ProtoWatchRPC struct {
+	Name  string
+	RPC   *RPC
+	Event *Message
+
+	// Enable is the request field enabling the events, which is cleared
+	// to disable them, or nil if the request has no such field.
+	Enable *Field
+}
+
+// ProtoWatchRPCs returns RPCs of protobuf service watching events of VPP
+// service. The RPCs are named by the event messages, which are enabled by
+// the first RPC listing them.
+func ProtoWatchRPCs(svc *Service) []*ProtoWatchRPC {
+	used := make(map[string]bool)
+	for _, rpc := range svc.RPCs {
+		used[rpc.GoName] = true
+	}
+	var list []*ProtoWatchRPC
+	for _, rpc := range svc.RPCs {
+		if rpc.MsgReply == nil || rpc.VPP.Stream {
+			continue
+		}
+		for _, event := range rpc.MsgEvents {
+			name := protoWatchPrefix + event.GoName
+			if used[name] {
+				continue
+			}
+			used[name] = true
+			list = append(list, &ProtoWatchRPC{
+				Name:   name,
+				RPC:    rpc,
+				Event:  event,
+				Enable: protoEnableField(rpc.MsgRequest),
+			})
+		}
+	}
+	return list
+}
+
+// protoEnableField returns field of the request enabling events.
+func protoEnableField(msg *Message) *Field {
+	for _, field := range msg.Fields {
+		if field.Name != "enable_disable" && field.Name != "enable" {
+			continue
+		}
+		if field.Array || field.TypeEnum != nil || field.TypeAlias != nil || field.TypeStruct != nil || field.TypeUnion != nil {
+			continue
+		}
+		switch field.Type {
+		case BOOL, U8, U16, U32:
+			return field
+		}
+	}
+	return nil
+}
+
+// protoType describes protobuf type of a field.
+type protoType struct {
+	scalar   string  // scalar type, empty for enums and messages
+	ident    GoIdent // binapi type mapped to protobuf enum or message
+	enum     bool
+	repeated bool
+}
+
+// protoTypeOf returns protobuf type for the field.
+func protoTypeOf(field *Field) protoType {
+	var typ protoType
+	switch {
+	case field.TypeEnum != nil:
+		typ = protoType{ident: field.TypeEnum.GoIdent, enum: true}
+	case field.TypeAlias != nil:
+		typ = protoAliasTypeOf(field.TypeAlias)
+		if field.Array && typ.repeated {
+			logrus.Fatalf("field %s: arrays of array alias %s are not supported", field.Name, field.TypeAlias.Name)
+		}
+	case field.TypeStruct != nil:
+		typ = protoType{ident: field.TypeStruct.GoIdent}
+	case field.TypeUnion != nil:
+		typ = protoType{ident: field.TypeUnion.GoIdent}
+	case isProtoBytesOrString(field):
+		if field.Type == U8 {
+			return protoType{scalar: "bytes"}
+		}
+		return protoType{scalar: "string"}
+	default:
+		typ = protoType{scalar: protoScalarTypes[field.Type]}
+	}
+	if field.Array {
+		typ.repeated = true
+	}
+	return typ
+}
+
+// protoAliasTypeOf returns protobuf type for the alias, which is mapped
+// to its underlying type.
+func protoAliasTypeOf(alias *Alias) protoType {
+	switch {
+	case alias.TypeStruct != nil:
+		return protoType{ident: alias.TypeStruct.GoIdent}
+	case alias.TypeUnion != nil:
+		return protoType{ident: alias.TypeUnion.GoIdent}
+	case alias.Length > 0 && alias.Type == U8:
+		return protoType{scalar: "bytes"}
+	case alias.Length > 0:
+		return protoType{scalar: protoScalarTypes[alias.Type], repeated: true}
+	}
+	return protoType{scalar: protoScalarTypes[alias.Type]}
+}
+
+// protoFieldType returns protobuf type for the field.
+func protoFieldType(g *GenFile, field *Field) string {
+	return protoTypeString(g, protoTypeOf(field))
+}
+
+// protoAliasType returns protobuf type for the alias.
+func protoAliasType(g *GenFile, alias *Alias) string {
+	return protoTypeString(g, protoAliasTypeOf(alias))
+}
+
+func protoTypeString(g *GenFile, typ protoType) string {
+	name := typ.scalar
+	if name == "" {
+		name = protoTypeName(g, typ.ident)
+	}
+	if typ.repeated {
+		return "repeated " + name
+	}
+	return name
+}
+
+// protoTypeName returns protobuf name for the type referenced from file g,
+// qualified with package name for types from other files.
+func protoTypeName(g *GenFile, ident GoIdent) string {
+	if ident.GoImportPath == g.file.GoImportPath {
+		return ident.GoName
+	}
+	return protoPackage(ident.GoImportPath) + "." + ident.GoName
+}
+
+// protoFullName returns fully qualified protobuf name for the type.
+func protoFullName(ident GoIdent) string {
+	return "." + protoPackage(ident.GoImportPath) + "." + ident.GoName
+}
+
+// protoImports returns schema imports for all types from other files
+// referenced by the file.
+func protoImports(file *File) []string {
+	imports := make(map[string]bool)
+	addImport := func(p GoImportPath) {
+		if p != file.GoImportPath {
+			name := baseName(string(p))
+			imports[path.Join(name, name+protoFilenameSuffix)] = true
+		}
+	}
+	addFields := func(fields []*Field) {
+		for _, field := range fields {
+			switch {
+			case field.TypeEnum != nil:
+				addImport(field.TypeEnum.GoImportPath)
+			case field.TypeAlias != nil:
+				if alias := field.TypeAlias; alias.TypeStruct != nil {
+					addImport(alias.TypeStruct.GoImportPath)
+				} else if alias.TypeUnion != nil {
+					addImport(alias.TypeUnion.GoImportPath)
+				}
+			case field.TypeStruct != nil:
+				addImport(field.TypeStruct.GoImportPath)
+			case field.TypeUnion != nil:
+				addImport(field.TypeUnion.GoImportPath)
+			}
+		}
+	}
+	for _, typ := range file.Structs {
+		addFields(typ.Fields)
+	}
+	for _, union := range file.Unions {
+		addFields(union.Fields)
+	}
+	for _, msg := range file.Messages {
+		addFields(msg.Fields)
+	}
+	var list []string
+	for imp := range imports {
+		list = append(list, imp)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// protoPackage returns protobuf package name for the binapi package.
+func protoPackage(importPath GoImportPath) string {
+	return protoPackagePrefix + baseName(string(importPath))
+}
+
+// protoGoImportPath returns import path of the Go package for protobuf
+// messages of the binapi package.
+func protoGoImportPath(importPath GoImportPath) GoImportPath {
+	pkg := cleanPackageName(baseName(string(importPath)))
+	return GoImportPath(path.Join(string(importPath), string(pkg)+protoGoPackageSuffix))
+}
+
+func protoServiceName(file *File) string {
+	return camelCaseName(file.Desc.Name) + protoServiceSuffix
+}
+
+// isProtoBytesOrString returns true for arrays of u8 or string fields,
+// which are mapped to protobuf bytes and string.
+func isProtoBytesOrString(field *Field) bool {
+	if field.TypeEnum != nil || field.TypeAlias != nil || field.TypeStruct != nil || field.TypeUnion != nil {
+		return false
+	}
+	return field.Type == STRING || (field.Array && field.Type == U8)
+}
+
+// unionMemberField returns field for the union member, which is always
+// accessed as single value.
+func unionMemberField(field *Field) *Field {
+	if !field.Array {
+		return field
+	}
+	member := *field
+	member.Array = false
+	return &member
+}
+
+// protoGoCamelCase returns Go name for the protobuf name, as generated by protoc-gen-go.
+func protoGoCamelCase(s string) string {
+	isLower := func(c byte) bool { return 'a' <= c && c <= 'z' }
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.' && i+1 < len(s) && isLower(s[i+1]):
+			// skip over '.' in ".{{lowercase}}"
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || s[i-1] == '.'):
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// skip over '_' in "_{{lowercase}}"
+		case isDigit(c):
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+// protoGoFieldNames returns Go names of message fields with conflicts
+// resolved the same way as protoc-gen-go does. If oneof is not empty, all
+// fields are members of oneof with that name and its Go name is returned
+// as the last element.
+func protoGoFieldNames(fields []*Field, oneof string) []string {
+	used := map[string]bool{
+		"Reset":               true,
+		"String":              true,
+		"ProtoMessage":        true,
+		"Marshal":             true,
+		"Unmarshal":           true,
+		"ExtensionRangeArray": true,
+		"ExtensionMap":        true,
+		"Descriptor":          true,
+	}
+	unique := func(name string, hasGetter bool) string {
+		for used[name] || (hasGetter && used["Get"+name]) {
+			name += "_"
+		}
+		used[name] = true
+		used["Get"+name] = hasGetter
+		return name
+	}
+	var names []string
+	var oneofName string
+	for i, field := range fields {
+		names = append(names, unique(protoGoCamelCase(field.Name), true))
+		if oneof != "" && i == 0 {
+			oneofName = unique(protoGoCamelCase(oneof), false)
+		}
+	}
+	if oneof != "" {
+		names = append(names, oneofName)
+	}
+	return names
+}
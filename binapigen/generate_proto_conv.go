@@ -0,0 +1,471 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+// generated names
+const (
+	protoToSuffix   = "ToProto"
+	protoFromSuffix = "FromProto"
+)
+
+// protoGoFile returns file describing Go package for protobuf messages of the file.
+func protoGoFile(file *File) *File {
+	pbPath := protoGoImportPath(file.GoImportPath)
+	return &File{
+		Desc:           file.Desc,
+		Generate:       file.Generate,
+		FilenamePrefix: path.Join(file.FilenamePrefix, baseName(string(pbPath))),
+		PackageName:    GoPackageName(baseName(string(pbPath))),
+		GoImportPath:   pbPath,
+		Version:        file.Version,
+		Imports:        file.Imports,
+	}
+}
+
+func genProtoConv(gen *Generator, file *File) *GenFile {
+	pbFile := protoGoFile(file)
+	filename := path.Join(pbFile.FilenamePrefix, file.Desc.Name+"_conv"+generatedFilenameSuffix)
+	g := gen.NewGenFile(filename, pbFile)
+
+	// file header
+	genCodeGeneratedComment(g)
+	g.P()
+	g.P("// Package ", pbFile.PackageName, " contains Protocol Buffers messages for API file ", file.Desc.Name, ".api")
+	g.P("// and functions converting them from and to binapi package ", file.PackageName, ".")
+	g.P("package ", pbFile.PackageName)
+	g.P()
+
+	for _, typ := range file.Structs {
+		genProtoConvStruct(g, typ)
+	}
+	for _, union := range file.Unions {
+		genProtoConvUnion(g, union)
+	}
+	for _, msg := range file.Messages {
+		genProtoConvMessage(g, msg)
+	}
+
+	return g
+}
+
+func genProtoConvStruct(g *GenFile, typ *Struct) {
+	binapiType := g.GoIdent(typ.GoIdent)
+	names := protoGoFieldNames(typ.Fields, "")
+
+	g.P("// ", typ.GoName, protoToSuffix, " converts ", binapiType, " to ", typ.GoName, ".")
+	g.P("func ", typ.GoName, protoToSuffix, "(in ", binapiType, ") *", typ.GoName, " {")
+	g.P("out := new(", typ.GoName, ")")
+	for i, field := range typ.Fields {
+		genProtoConvFieldTo(g, typ.Fields, i, "out."+names[i], "in."+field.GoName)
+	}
+	genProtoConvNestedUnionsTo(g, typ.Fields)
+	g.P("return out")
+	g.P("}")
+	g.P()
+
+	g.P("// ", typ.GoName, protoFromSuffix, " converts ", typ.GoName, " to ", binapiType, ",")
+	g.P("// the values that do not fit the binapi types fail the conversion.")
+	g.P("func ", typ.GoName, protoFromSuffix, "(in *", typ.GoName, ") (out ", binapiType, ", err error) {")
+	g.P("if in == nil {")
+	g.P("return out, nil")
+	g.P("}")
+	genProtoConvFieldsFrom(g, typ.Fields, names, binapiType+"{}")
+	g.P("return out, nil")
+	g.P("}")
+	g.P()
+}
+
+func genProtoConvMessage(g *GenFile, msg *Message) {
+	binapiType := g.GoIdent(msg.GoIdent)
+	names := protoGoFieldNames(msg.Fields, "")
+
+	g.P("// ", msg.GoName, protoToSuffix, " converts message ", binapiType, " to ", msg.GoName, ".")
+	g.P("func ", msg.GoName, protoToSuffix, "(in *", binapiType, ") *", msg.GoName, " {")
+	g.P("if in == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("out := new(", msg.GoName, ")")
+	for i, field := range msg.Fields {
+		genProtoConvFieldTo(g, msg.Fields, i, "out."+names[i], "in."+field.GoName)
+	}
+	genProtoConvNestedUnionsTo(g, msg.Fields)
+	g.P("return out")
+	g.P("}")
+	g.P()
+
+	g.P("// ", msg.GoName, protoFromSuffix, " converts ", msg.GoName, " to message ", binapiType, ",")
+	g.P("// the values that do not fit the binapi types fail the conversion.")
+	g.P("func ", msg.GoName, protoFromSuffix, "(in *", msg.GoName, ") (out *", binapiType, ", err error) {")
+	g.P("if in == nil {")
+	g.P("return nil, nil")
+	g.P("}")
+	g.P("out = new(", binapiType, ")")
+	genProtoConvFieldsFrom(g, msg.Fields, names, "nil")
+	g.P("return out, nil")
+	g.P("}")
+	g.P()
+}
+
+func genProtoConvUnion(g *GenFile, union *Union) {
+	binapiType := g.GoIdent(union.GoIdent)
+	names := protoGoFieldNames(union.Fields, union.Name)
+	oneof := names[len(names)-1]
+
+	g.P("// ", union.GoName, protoToSuffix, " converts ", binapiType, " to ", union.GoName, ",")
+	g.P("// the member selects index of the union member that is set.")
+	g.P("func ", union.GoName, protoToSuffix, "(in ", binapiType, ", member int) *", union.GoName, " {")
+	g.P("out := new(", union.GoName, ")")
+	g.P("switch member {")
+	for i, field := range union.Fields {
+		wrapper := union.GoName + "_" + names[i]
+		g.P("case ", i, ":")
+		g.P("a := in.Get", field.GoName, "()")
+		g.P("v := new(", wrapper, ")")
+		genProtoTo(g, unionMemberField(field), false, "v."+names[i], "a")
+		g.P("out.", oneof, " = v")
+	}
+	g.P("}")
+	g.P("return out")
+	g.P("}")
+	g.P()
+
+	g.P("// ", union.GoName, protoFromSuffix, " converts ", union.GoName, " to ", binapiType, ",")
+	g.P("// the values that do not fit the binapi types fail the conversion.")
+	g.P("func ", union.GoName, protoFromSuffix, "(in *", union.GoName, ") (out ", binapiType, ", err error) {")
+	g.P("if in == nil {")
+	g.P("return out, nil")
+	g.P("}")
+	g.P("switch v := in.", oneof, ".(type) {")
+	for i, field := range union.Fields {
+		wrapper := union.GoName + "_" + names[i]
+		g.P("case *", wrapper, ":")
+		g.P("var a ", fieldGoType(g, field))
+		genProtoFrom(g, unionMemberField(field), false, "a", "v."+names[i], binapiType+"{}")
+		g.P("out.Set", field.GoName, "(a)")
+	}
+	g.P("}")
+	g.P("return out, nil")
+	g.P("}")
+	g.P()
+}
+
+// genProtoConvFieldsFrom generates conversion of fields from protobuf, the
+// count fields are set to the length of arrays they count.
+func genProtoConvFieldsFrom(g *GenFile, fields []*Field, names []string, zero string) {
+	for i, field := range fields {
+		if field.FieldSizeOf == nil {
+			genProtoFrom(g, field, field.Array, "out."+field.GoName, "in."+names[i], zero)
+		}
+	}
+	for _, field := range fields {
+		if field.FieldSizeOf != nil {
+			genProtoFromCheck(g, field, zero, "err = "+g.GoIdent(govppCodecPkg.Ident("ConvertInt"))+"(&out."+field.GoName+", len(out."+field.FieldSizeOf.GoName+"))")
+		}
+	}
+}
+
+// genProtoConvFieldTo generates conversion of field with index i to protobuf,
+// union fields use the enum field preceding them as discriminator of their
+// member.
+func genProtoConvFieldTo(g *GenFile, fields []*Field, i int, dst, src string) {
+	field := fields[i]
+	if protoFieldUnion(field) != nil {
+		structs, indexes := [][]*Field{fields}, []int{i}
+		if level, discr, cases := UnionDiscriminator(structs, indexes); level >= 0 {
+			genProtoConvUnionTo(g, structs, indexes, discr, cases, true)
+			return
+		}
+	}
+	genProtoTo(g, field, field.Array, dst, src)
+}
+
+// genProtoConvNestedUnionsTo generates conversion of the unions in nested
+// structs with enum field selecting their member in the fields, replacing
+// the largest member used by the converters of the nested structs.
+func genProtoConvNestedUnionsTo(g *GenFile, fields []*Field) {
+	var walk func(structs [][]*Field, indexes []int)
+	walk = func(structs [][]*Field, indexes []int) {
+		for i, field := range structs[len(structs)-1] {
+			indexes := append(indexes[:len(indexes):len(indexes)], i)
+			if protoFieldUnion(field) != nil && len(indexes) > 1 {
+				if level, discr, cases := UnionDiscriminator(structs, indexes); level == 0 {
+					genProtoConvUnionTo(g, structs, indexes, discr, cases, false)
+				}
+			}
+			if nested := protoFieldStruct(field); nested != nil {
+				walk(append(structs[:len(structs):len(structs)], nested.Fields), indexes)
+			}
+		}
+	}
+	walk([][]*Field{fields}, nil)
+}
+
+// genProtoConvUnionTo generates conversion of union field on the path given
+// by indexes in structs to protobuf with the member selected by value of enum
+// field discr in the outermost struct. The default case uses the largest
+// member, otherwise the union is left as it is for unknown members.
+func genProtoConvUnionTo(g *GenFile, structs [][]*Field, indexes []int, discr *Field, cases map[uint32]int, withDefault bool) {
+	dst, src := "out", "in"
+	loops := 0
+	for level, i := range indexes {
+		field := structs[level][i]
+		dst += "." + protoGoFieldNames(structs[level], "")[i]
+		src += "." + field.GoName
+		if field.Array && level < len(indexes)-1 {
+			idx := string(rune('i' + loops))
+			g.P("for ", idx, " := range ", src, " {")
+			dst += "[" + idx + "]"
+			src += "[" + idx + "]"
+			loops++
+		}
+	}
+	field := structs[len(indexes)-1][indexes[len(indexes)-1]]
+	g.P("switch in.", discr.GoName, " {")
+	done := make(map[uint32]bool)
+	for _, entry := range discr.TypeEnum.Entries {
+		member, ok := cases[entry.Value]
+		if !ok || done[entry.Value] {
+			continue
+		}
+		done[entry.Value] = true
+		g.P("case ", g.GoIdent(GoIdent{GoName: entry.Name, GoImportPath: discr.TypeEnum.GoImportPath}), ":")
+		g.P(dst, " = ", protoUnionTo(g, field, src, member))
+	}
+	if withDefault {
+		g.P("default:")
+		g.P(dst, " = ", protoUnionTo(g, field, src, largestUnionMember(protoFieldUnion(field))))
+	}
+	g.P("}")
+	for ; loops > 0; loops-- {
+		g.P("}")
+	}
+}
+
+// protoUnionTo returns expression converting union value src of the field
+// to protobuf with the member.
+func protoUnionTo(g *GenFile, field *Field, src string, member int) string {
+	union := protoFieldUnion(field)
+	if field.TypeAlias != nil {
+		src = g.GoIdent(union.GoIdent) + "(" + src + ")"
+	}
+	return g.GoIdent(protoConvIdent(union.GoIdent, protoToSuffix)) + "(" + src + ", " + strconv.Itoa(member) + ")"
+}
+
+// protoFieldUnion returns union type of the field, or nil for other types.
+func protoFieldUnion(field *Field) *Union {
+	if field.TypeAlias != nil {
+		return field.TypeAlias.TypeUnion
+	}
+	return field.TypeUnion
+}
+
+// protoFieldStruct returns struct type of the single value field, or nil for
+// other fields.
+func protoFieldStruct(field *Field) *Struct {
+	if field.TypeAlias != nil {
+		return field.TypeAlias.TypeStruct
+	}
+	return field.TypeStruct
+}
+
+// largestUnionMember returns index of the first member with size of the union,
+// which is used when the member is unknown since it preserves all union data.
+func largestUnionMember(union *Union) int {
+	size := getUnionSize(union)
+	for i, field := range union.Fields {
+		if n, _ := getSizeOfField(field); n == size {
+			return i
+		}
+	}
+	return 0
+}
+
+// protoConvIdent returns identifier for converter function of the binapi type.
+func protoConvIdent(ident GoIdent, suffix string) GoIdent {
+	return GoIdent{
+		GoName:       ident.GoName + suffix,
+		GoImportPath: protoGoImportPath(ident.GoImportPath),
+	}
+}
+
+// protoGoIdent returns identifier for protobuf message or enum of the binapi type.
+func protoGoIdent(ident GoIdent) GoIdent {
+	return GoIdent{
+		GoName:       ident.GoName,
+		GoImportPath: protoGoImportPath(ident.GoImportPath),
+	}
+}
+
+// protoGoElemType returns Go type of protobuf value for the single element of field.
+func protoGoElemType(g *GenFile, field *Field) string {
+	switch {
+	case field.TypeEnum != nil:
+		return g.GoIdent(protoGoIdent(field.TypeEnum.GoIdent))
+	case field.TypeAlias != nil:
+		alias := field.TypeAlias
+		switch {
+		case alias.TypeStruct != nil:
+			return "*" + g.GoIdent(protoGoIdent(alias.TypeStruct.GoIdent))
+		case alias.TypeUnion != nil:
+			return "*" + g.GoIdent(protoGoIdent(alias.TypeUnion.GoIdent))
+		}
+		return protoScalarGoTypes[protoAliasType(g, alias)]
+	case field.TypeStruct != nil:
+		return "*" + g.GoIdent(protoGoIdent(field.TypeStruct.GoIdent))
+	case field.TypeUnion != nil:
+		return "*" + g.GoIdent(protoGoIdent(field.TypeUnion.GoIdent))
+	}
+	return protoScalarGoTypes[protoScalarTypes[field.Type]]
+}
+
+// genProtoTo generates assignment of binapi value src converted to protobuf to dst.
+func genProtoTo(g *GenFile, field *Field, array bool, dst, src string) {
+	if array && !isProtoBytesOrString(field) {
+		g.P(dst, " = make([]", protoGoElemType(g, field), ", len(", src, "))")
+		g.P("for i := range ", src, " {")
+		genProtoTo(g, field, false, dst+"[i]", src+"[i]")
+		g.P("}")
+		return
+	}
+	switch {
+	case field.TypeEnum != nil:
+		g.P(dst, " = ", g.GoIdent(protoGoIdent(field.TypeEnum.GoIdent)), "(", src, ")")
+	case field.TypeAlias != nil:
+		alias := field.TypeAlias
+		switch {
+		case alias.TypeStruct != nil:
+			g.P(dst, " = ", g.GoIdent(protoConvIdent(alias.TypeStruct.GoIdent, protoToSuffix)), "(", g.GoIdent(alias.TypeStruct.GoIdent), "(", src, "))")
+		case alias.TypeUnion != nil:
+			g.P(dst, " = ", protoUnionTo(g, field, src, largestUnionMember(alias.TypeUnion)))
+		case alias.Length > 0 && alias.Type == U8:
+			g.P(dst, " = ", src, "[:]")
+		case alias.Length > 0:
+			elemType := protoScalarGoTypes[protoScalarTypes[alias.Type]]
+			g.P(dst, " = make([]", elemType, ", len(", src, "))")
+			g.P("for j := range ", src, " {")
+			g.P(dst, "[j] = ", elemType, "(", src, "[j])")
+			g.P("}")
+		default:
+			g.P(dst, " = ", protoScalarGoTypes[protoScalarTypes[alias.Type]], "(", src, ")")
+		}
+	case field.TypeStruct != nil:
+		g.P(dst, " = ", g.GoIdent(protoConvIdent(field.TypeStruct.GoIdent, protoToSuffix)), "(", src, ")")
+	case field.TypeUnion != nil:
+		g.P(dst, " = ", protoUnionTo(g, field, src, largestUnionMember(field.TypeUnion)))
+	case isProtoBytesOrString(field):
+		g.P(dst, " = ", src)
+	default:
+		g.P(dst, " = ", protoScalarConv(protoScalarGoTypes[protoScalarTypes[field.Type]], BaseTypesGo[field.Type], src))
+	}
+}
+
+// genProtoFrom generates assignment of protobuf value src converted to binapi
+// to dst, the values that do not fit the binapi type return zero with error.
+func genProtoFrom(g *GenFile, field *Field, array bool, dst, src, zero string) {
+	// the codec package is imported only if used
+	convertInt := func() string { return g.GoIdent(govppCodecPkg.Ident("ConvertInt")) }
+	if array && !isProtoBytesOrString(field) {
+		if fieldType := getFieldType(g, field); strings.HasPrefix(fieldType, "[]") {
+			g.P(dst, " = make(", fieldType, ", len(", src, "))")
+		} else {
+			genProtoFromCheck(g, field, zero, "err = "+g.GoIdent(govppCodecPkg.Ident("CheckLength"))+"(len("+src+"), len("+dst+"))")
+		}
+		g.P("for i := range ", src, " {")
+		genProtoFrom(g, field, false, dst+"[i]", src+"[i]", zero)
+		g.P("}")
+		return
+	}
+	switch {
+	case field.TypeEnum != nil:
+		genProtoFromCheck(g, field, zero, "err = "+convertInt()+"(&"+dst+", "+src+")")
+	case field.TypeAlias != nil:
+		alias := field.TypeAlias
+		switch {
+		case alias.TypeStruct != nil, alias.TypeUnion != nil:
+			var ident GoIdent
+			if alias.TypeStruct != nil {
+				ident = alias.TypeStruct.GoIdent
+			} else {
+				ident = alias.TypeUnion.GoIdent
+			}
+			g.P("{")
+			g.P("t, err := ", g.GoIdent(protoConvIdent(ident, protoFromSuffix)), "(", src, ")")
+			genProtoFromCheck(g, field, zero, "")
+			g.P(dst, " = ", g.GoIdent(alias.GoIdent), "(t)")
+			g.P("}")
+		case alias.Length > 0 && alias.Type == U8:
+			genProtoFromCheck(g, field, zero, "err = "+g.GoIdent(govppCodecPkg.Ident("CopyBytes"))+"("+dst+"[:], "+src+")")
+		case alias.Length > 0:
+			genProtoFromCheck(g, field, zero, "err = "+g.GoIdent(govppCodecPkg.Ident("CheckLength"))+"(len("+src+"), len("+dst+"))")
+			g.P("for j := range ", src, " {")
+			if protoNarrowsType(alias.Type) {
+				genProtoFromCheck(g, field, zero, "err = "+convertInt()+"(&"+dst+"[j], "+src+"[j])")
+			} else {
+				g.P(dst, "[j] = ", protoScalarConv(BaseTypesGo[alias.Type], protoScalarGoTypes[protoScalarTypes[alias.Type]], src+"[j]"))
+			}
+			g.P("}")
+		case protoNarrowsType(alias.Type):
+			genProtoFromCheck(g, field, zero, "err = "+convertInt()+"(&"+dst+", "+src+")")
+		default:
+			g.P(dst, " = ", g.GoIdent(alias.GoIdent), "(", src, ")")
+		}
+	case field.TypeStruct != nil:
+		genProtoFromCheck(g, field, zero, dst+", err = "+g.GoIdent(protoConvIdent(field.TypeStruct.GoIdent, protoFromSuffix))+"("+src+")")
+	case field.TypeUnion != nil:
+		genProtoFromCheck(g, field, zero, dst+", err = "+g.GoIdent(protoConvIdent(field.TypeUnion.GoIdent, protoFromSuffix))+"("+src+")")
+	case isProtoBytesOrString(field):
+		g.P(dst, " = ", src)
+	case protoNarrowsType(field.Type):
+		genProtoFromCheck(g, field, zero, "err = "+convertInt()+"(&"+dst+", "+src+")")
+	default:
+		g.P(dst, " = ", protoScalarConv(BaseTypesGo[field.Type], protoScalarGoTypes[protoScalarTypes[field.Type]], src))
+	}
+}
+
+// genProtoFromCheck generates check of the error set by statement stmt, which
+// returns zero with the error prefixed by name of the field.
+func genProtoFromCheck(g *GenFile, field *Field, zero, stmt string) {
+	if stmt != "" {
+		g.P("if ", stmt, "; err != nil {")
+	} else {
+		g.P("if err != nil {")
+	}
+	g.P("return ", zero, ", ", g.GoIdent(fmtPkg.Ident("Errorf")), "(", strconv.Quote(field.Name+": %w"), ", err)")
+	g.P("}")
+}
+
+// protoNarrowsType reports whether the protobuf scalar of the base type is
+// wider than the binapi type.
+func protoNarrowsType(typ string) bool {
+	switch typ {
+	case U8, I8, U16, I16:
+		return true
+	}
+	return false
+}
+
+// protoScalarConv returns expression converting src of type from to type to.
+func protoScalarConv(to, from, src string) string {
+	if to == from {
+		return src
+	}
+	return to + "(" + src + ")"
+}
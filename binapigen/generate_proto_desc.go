@@ -0,0 +1,168 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"path"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protoScalarKinds maps protobuf scalar types to their descriptor types.
+var protoScalarKinds = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"uint32": descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"int32":  descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"uint64": descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int64":  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"double": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"bool":   descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":  descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// ProtoFileDescriptor returns descriptor of the Protocol Buffers schema
+// generated by the proto plugin for the API file. It allows using the schema
+// at runtime, e.g. with dynamicpb, without compiling the generated file.
+func ProtoFileDescriptor(file *File) *descriptorpb.FileDescriptorProto {
+	pbPath := string(protoGoImportPath(file.GoImportPath))
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String(path.Join(file.Desc.Name, file.Desc.Name+protoFilenameSuffix)),
+		Package:    proto.String(protoPackage(file.GoImportPath)),
+		Dependency: protoImports(file),
+		Syntax:     proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String(pbPath + ";" + baseName(pbPath)),
+		},
+	}
+
+	for _, enum := range file.Enums {
+		fd.EnumType = append(fd.EnumType, protoEnumDescriptor(enum))
+	}
+	for _, typ := range file.Structs {
+		fd.MessageType = append(fd.MessageType, protoMessageDescriptor(typ.GoName, typ.Fields, false))
+	}
+	for _, union := range file.Unions {
+		fd.MessageType = append(fd.MessageType, protoUnionDescriptor(union))
+	}
+	for _, msg := range file.Messages {
+		status, _ := getMessageStatus(msg)
+		fd.MessageType = append(fd.MessageType, protoMessageDescriptor(msg.GoName, msg.Fields, status == msgStatusDeprecated))
+	}
+	if file.Service != nil && len(file.Service.RPCs) > 0 {
+		fd.Service = append(fd.Service, protoServiceDescriptor(file))
+	}
+
+	return fd
+}
+
+func protoEnumDescriptor(enum *Enum) *descriptorpb.EnumDescriptorProto {
+	values, hasAlias := protoEnumValues(enum)
+	ed := &descriptorpb.EnumDescriptorProto{
+		Name: proto.String(enum.GoName),
+	}
+	if hasAlias {
+		ed.Options = &descriptorpb.EnumOptions{AllowAlias: proto.Bool(true)}
+	}
+	for _, v := range values {
+		ed.Value = append(ed.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   proto.String(v.name),
+			Number: proto.Int32(v.value),
+		})
+	}
+	return ed
+}
+
+func protoMessageDescriptor(name string, fields []*Field, deprecated bool) *descriptorpb.DescriptorProto {
+	md := &descriptorpb.DescriptorProto{
+		Name: proto.String(name),
+	}
+	if deprecated {
+		md.Options = &descriptorpb.MessageOptions{Deprecated: proto.Bool(true)}
+	}
+	for i, field := range fields {
+		md.Field = append(md.Field, protoFieldDescriptor(field, i+1))
+	}
+	return md
+}
+
+func protoUnionDescriptor(union *Union) *descriptorpb.DescriptorProto {
+	md := &descriptorpb.DescriptorProto{
+		Name: proto.String(union.GoName),
+		OneofDecl: []*descriptorpb.OneofDescriptorProto{
+			{Name: proto.String(union.Name)},
+		},
+	}
+	for i, field := range union.Fields {
+		fd := protoFieldDescriptor(unionMemberField(field), i+1)
+		fd.OneofIndex = proto.Int32(0)
+		md.Field = append(md.Field, fd)
+	}
+	return md
+}
+
+func protoFieldDescriptor(field *Field, number int) *descriptorpb.FieldDescriptorProto {
+	typ := protoTypeOf(field)
+	fd := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(field.Name),
+		Number: proto.Int32(int32(number)),
+		Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+	if typ.repeated {
+		fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	}
+	switch {
+	case typ.scalar != "":
+		fd.Type = protoScalarKinds[typ.scalar].Enum()
+	case typ.enum:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum()
+		fd.TypeName = proto.String(protoFullName(typ.ident))
+	default:
+		fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+		fd.TypeName = proto.String(protoFullName(typ.ident))
+	}
+	return fd
+}
+
+func protoServiceDescriptor(file *File) *descriptorpb.ServiceDescriptorProto {
+	sd := &descriptorpb.ServiceDescriptorProto{
+		Name: proto.String(protoServiceName(file)),
+	}
+	method := func(name string, req, reply *Message, stream bool) {
+		md := &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(name),
+			InputType:  proto.String(protoFullName(req.GoIdent)),
+			OutputType: proto.String(protoFullName(reply.GoIdent)),
+		}
+		if stream {
+			md.ServerStreaming = proto.Bool(true)
+		}
+		sd.Method = append(sd.Method, md)
+	}
+	for _, rpc := range file.Service.RPCs {
+		switch {
+		case rpc.MsgReply == nil:
+			continue
+		case rpc.VPP.Stream && rpc.MsgStream != nil:
+			method(rpc.GoName, rpc.MsgRequest, rpc.MsgStream, true)
+		default:
+			method(rpc.GoName, rpc.MsgRequest, rpc.MsgReply, rpc.VPP.Stream)
+		}
+	}
+	for _, watch := range ProtoWatchRPCs(file.Service) {
+		method(watch.Name, watch.RPC.MsgRequest, watch.Event, true)
+	}
+	return sd
+}
@@ -0,0 +1,210 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func generateProtoFromDir(t *testing.T, dir string) string {
+	files, err := vppapi.ParseDir(dir)
+	Expect(err).ShouldNot(HaveOccurred())
+	input := &vppapi.VppInput{Schema: vppapi.Schema{Files: files}}
+
+	outDir := t.TempDir()
+	opts := Options{OutputDir: outDir, ImportPrefix: "example.com/binapi", NoVersionInfo: true}
+	gen, err := New(opts, input)
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, file := range gen.Files {
+		GenerateAPI(gen, file)
+		Expect(RunPlugin("proto", gen, file)).To(Succeed())
+	}
+	Expect(gen.Generate()).To(Succeed())
+	return outDir
+}
+
+func readGenerated(t *testing.T, path ...string) string {
+	b, err := os.ReadFile(filepath.Join(path...))
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(b)
+}
+
+func TestGenerateProtoSchema(t *testing.T) {
+	RegisterTestingT(t)
+
+	outDir := generateProtoFromDir(t, "vppapi/testdata/src")
+
+	ipTypes := readGenerated(t, outDir, "ip_types", "ip_types.proto")
+	Expect(ipTypes).To(ContainSubstring("package vpp.ip_types;\n"))
+	Expect(ipTypes).To(ContainSubstring(`option go_package = "example.com/binapi/ip_types/ip_typespb;ip_typespb";`))
+	Expect(ipTypes).To(ContainSubstring("message AddressUnion {\n" +
+		"  oneof address_union {\n" +
+		"    bytes ip4 = 1;\n" +
+		"    bytes ip6 = 2;\n" +
+		"  }\n" +
+		"}\n"))
+
+	ip := readGenerated(t, outDir, "ip", "ip.proto")
+	Expect(ip).To(ContainSubstring("import \"fib_types/fib_types.proto\";\n" +
+		"import \"ip_types/ip_types.proto\";\n"))
+	Expect(ip).To(ContainSubstring("message IPRoute {\n" +
+		"  uint32 table_id = 1;\n" +
+		"  uint32 stats_index = 2;\n" +
+		"  vpp.ip_types.Prefix prefix = 3;\n" +
+		"  uint32 n_paths = 4;\n" +
+		"  repeated vpp.fib_types.FibPath paths = 5;\n" +
+		"}\n"))
+	Expect(ip).To(ContainSubstring("enum IPFlowHashConfig {\n" +
+		"  IP_FLOW_HASH_CONFIG_UNSPECIFIED = 0;\n" +
+		"  IP_API_FLOW_HASH_SRC_IP = 1;\n"))
+	Expect(ip).To(ContainSubstring("message IPTableDump {\n}\n"))
+
+	example := readGenerated(t, outDir, "example", "example.proto")
+	Expect(example).To(ContainSubstring("message ExampleSet {\n" +
+		"  option deprecated = true;\n" +
+		"  uint32 sw_if_index = 1;\n" +
+		"  ExampleMode mode = 2;\n" +
+		"  uint32 limit = 3;\n" +
+		"  string tag = 4;\n" +
+		"}\n"))
+	Expect(example).To(ContainSubstring("service ExampleService {\n" +
+		"  // ExampleGet ends with ExampleGetReply sent in trailer metadata vpp-reply-bin.\n" +
+		"  rpc ExampleGet(.vpp.example.ExampleGet) returns (stream .vpp.example.ExampleDetails);\n" +
+		"  rpc ExampleSet(.vpp.example.ExampleSet) returns (.vpp.example.ExampleSetReply);\n"))
+	Expect(example).To(ContainSubstring("  rpc WatchExampleEvent(.vpp.example.WantExampleEvents) returns (stream .vpp.example.ExampleEvent);\n"))
+}
+
+func TestProtoFileDescriptor(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	gen, err := New(Options{ImportPrefix: "example.com/binapi"}, &vppapi.VppInput{Schema: vppapi.Schema{Files: files}})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	set := new(descriptorpb.FileDescriptorSet)
+	for _, file := range gen.Files {
+		set.File = append(set.File, ProtoFileDescriptor(file))
+	}
+	registry, err := protodesc.NewFiles(set)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	desc, err := registry.FindDescriptorByName("vpp.example.ExampleService")
+	Expect(err).ShouldNot(HaveOccurred())
+	methods := desc.(protoreflect.ServiceDescriptor).Methods()
+	get := methods.ByName("ExampleGet")
+	Expect(get).ToNot(BeNil())
+	Expect(get.IsStreamingServer()).To(BeTrue())
+	Expect(get.Output().FullName()).To(BeEquivalentTo("vpp.example.ExampleDetails"))
+	watch := methods.ByName("WatchExampleEvent")
+	Expect(watch).ToNot(BeNil())
+	Expect(watch.IsStreamingServer()).To(BeTrue())
+	Expect(watch.Output().FullName()).To(BeEquivalentTo("vpp.example.ExampleEvent"))
+
+	desc, err = registry.FindDescriptorByName("vpp.ip_types.AddressUnion")
+	Expect(err).ShouldNot(HaveOccurred())
+	oneofs := desc.(protoreflect.MessageDescriptor).Oneofs()
+	Expect(oneofs.Len()).To(Equal(1))
+	Expect(oneofs.Get(0).Fields().Len()).To(Equal(2))
+}
+
+func TestGenerateProtoConv(t *testing.T) {
+	RegisterTestingT(t)
+
+	outDir := generateProtoFromDir(t, "vppapi/testdata/src")
+
+	ipTypes := readGenerated(t, outDir, "ip_types", "ip_typespb", "ip_types_conv.ba.go")
+	Expect(ipTypes).To(ContainSubstring("package ip_typespb\n"))
+	Expect(ipTypes).To(ContainSubstring(`ip_types "example.com/binapi/ip_types"`))
+	Expect(ipTypes).To(ContainSubstring(`	switch in.Af {
+	case ip_types.ADDRESS_IP4:
+		out.Un = AddressUnionToProto(in.Un, 0)
+	case ip_types.ADDRESS_IP6:
+		out.Un = AddressUnionToProto(in.Un, 1)
+	default:
+		out.Un = AddressUnionToProto(in.Un, 1)
+	}`))
+	Expect(ipTypes).To(ContainSubstring(`	case *AddressUnion_Ip6:
+		var a ip_types.IP6Address
+		if err = codec.CopyBytes(a[:], v.Ip6); err != nil {
+			return ip_types.AddressUnion{}, fmt.Errorf("ip6: %w", err)
+		}
+		out.SetIP6(a)`))
+	Expect(ipTypes).To(ContainSubstring(`	if err = codec.ConvertInt(&out.Len, in.Len); err != nil {
+		return ip_types.Prefix{}, fmt.Errorf("len: %w", err)
+	}`))
+
+	// the member of next hop address is selected by proto of the path
+	fibTypes := readGenerated(t, outDir, "fib_types", "fib_typespb", "fib_types_conv.ba.go")
+	Expect(fibTypes).To(ContainSubstring(`	out.Nh = FibPathNhToProto(in.Nh)
+`))
+	Expect(fibTypes).To(ContainSubstring(`	switch in.Proto {
+	case fib_types.FIB_API_PATH_NH_PROTO_IP4:
+		out.Nh.Address = ip_typespb.AddressUnionToProto(in.Nh.Address, 0)
+	case fib_types.FIB_API_PATH_NH_PROTO_IP6:
+		out.Nh.Address = ip_typespb.AddressUnionToProto(in.Nh.Address, 1)
+	}
+	return out`))
+
+	ip := readGenerated(t, outDir, "ip", "ippb", "ip_conv.ba.go")
+	Expect(ip).To(ContainSubstring("func IPRouteAddDelToProto(in *ip.IPRouteAddDel) *IPRouteAddDel {"))
+	Expect(ip).To(ContainSubstring("func IPRouteAddDelFromProto(in *IPRouteAddDel) (out *ip.IPRouteAddDel, err error) {"))
+	Expect(ip).To(ContainSubstring(`	if out.Route, err = IPRouteFromProto(in.Route); err != nil {
+		return nil, fmt.Errorf("route: %w", err)
+	}`))
+	// the count of paths is set from their length
+	Expect(ip).To(ContainSubstring(`	if out.Prefix, err = ip_typespb.PrefixFromProto(in.Prefix); err != nil {
+		return ip.IPRoute{}, fmt.Errorf("prefix: %w", err)
+	}
+	out.Paths = make([]fib_types.FibPath, len(in.Paths))
+	for i := range in.Paths {
+		if out.Paths[i], err = fib_typespb.FibPathFromProto(in.Paths[i]); err != nil {
+			return ip.IPRoute{}, fmt.Errorf("paths: %w", err)
+		}
+	}
+	if err = codec.ConvertInt(&out.NPaths, len(out.Paths)); err != nil {
+		return ip.IPRoute{}, fmt.Errorf("n_paths: %w", err)
+	}`))
+}
+
+func TestProtoGoCamelCase(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"table_id", "TableId"},
+		{"is_ip6", "IsIp6"},
+		{"ip4", "Ip4"},
+		{"_vl_msg_id", "XVlMsgId"},
+		{"n_paths", "NPaths"},
+		{"IPRouteAddDel", "IPRouteAddDel"},
+		{"a_1", "A_1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(protoGoCamelCase(test.name)).To(Equal(test.want))
+		})
+	}
+}
@@ -171,7 +171,7 @@ func genService(g *GenFile, svc *Service) {
 			g.P("	case *", msgReply.GoIdent, ":")
 			if msgReply != msgControlPingReply {
 				if retvalField := getRetvalField(msgReply); retvalField != nil {
-					g.P("if err := ", retvalFieldToErr(g, "m", retvalField), "; err != nil {")
+					g.P("if err := ", retvalFieldToStreamErr(g, "m", retvalField), "; err != nil {")
 					g.P("	c.Stream.Close()")
 					if msgReply != msgControlPingReply {
 						g.P("	return nil, m, err")
@@ -249,6 +249,16 @@ func retvalFieldToErr(g *GenFile, varName string, retvalField *Field) string {
 	}
 }
 
+// retvalFieldToStreamErr returns expression of the error for retval of reply
+// received from the stream, which carries the context of the request.
+func retvalFieldToStreamErr(g *GenFile, varName string, retvalField *Field) string {
+	retval := varName + "." + retvalField.GoName
+	if getFieldType(g, retvalField) != "int32" {
+		retval = "int32(" + retval + ")"
+	}
+	return g.GoIdent(govppApiPkg.Ident("StreamReplyError")) + "(c.Stream, " + varName + ", " + retval + ")"
+}
+
 func rpcMethodSignature(g *GenFile, rpc *RPC) string {
 	s := rpc.GoName + "(ctx " + g.GoIdent(contextPkg.Ident("Context"))
 	s += ", in *" + g.GoIdent(rpc.MsgRequest.GoIdent) + ") ("
@@ -0,0 +1,144 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapigen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+const runModule = "example.com/gentest"
+
+// TestGeneratedCode builds the code generated by the rpc, proto, grpc and
+// fake plugins in a temporary module and runs testdata/run/generated_test.go
+// against it.
+func TestGeneratedCode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build of generated code in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	memclnt, err := vppapi.ParseFile("testdata/run/memclnt.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	memclnt.Path = "vlibmemory/memclnt.api"
+	files = append(files, *memclnt)
+	input := &vppapi.VppInput{Schema: vppapi.Schema{Files: files}}
+
+	modDir := t.TempDir()
+	opts := Options{
+		OutputDir:        filepath.Join(modDir, "binapi"),
+		ImportPrefix:     runModule + "/binapi",
+		NoVersionInfo:    true,
+		NoSourcePathInfo: true,
+	}
+	gen, err := New(opts, input)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(GeneratePlugins([]string{"rpc", "proto", "grpc", "fake"})(gen)).To(Succeed())
+	Expect(gen.Generate()).To(Succeed())
+	generateProtoGo(t, gen, modDir)
+
+	govppDir, err := filepath.Abs("..")
+	Expect(err).ShouldNot(HaveOccurred())
+	goMod := "module " + runModule + "\n\ngo 1.25.0\n\n" +
+		"require go.fd.io/govpp v0.0.0\n\n" +
+		"replace go.fd.io/govpp => " + govppDir + "\n"
+	Expect(os.WriteFile(filepath.Join(modDir, "go.mod"), []byte(goMod), 0o644)).To(Succeed())
+	copyFile(t, filepath.Join(govppDir, "go.sum"), filepath.Join(modDir, "go.sum"))
+	copyFile(t, "testdata/run/generated_test.go", filepath.Join(modDir, "generated_test.go"))
+
+	// resolve the dependencies from the module cache only
+	cmd := exec.Command(goBin, "test", "./...")
+	cmd.Dir = modDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off", "GOTOOLCHAIN=local")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test of generated code failed: %v\n%s", err, out)
+	}
+}
+
+// generateProtoGo generates Go code of the protobuf schema of gen files
+// the same way protoc-gen-go does from the generated .proto files.
+func generateProtoGo(t *testing.T, gen *Generator, modDir string) {
+	req := &pluginpb.CodeGeneratorRequest{}
+	for _, file := range gen.Files {
+		fd := ProtoFileDescriptor(file)
+		req.ProtoFile = append(req.ProtoFile, fd)
+		req.FileToGenerate = append(req.FileToGenerate, fd.GetName())
+	}
+	sortProtoFiles(req.ProtoFile)
+
+	plugin, err := protogen.Options{}.New(req)
+	Expect(err).ShouldNot(HaveOccurred())
+	for _, f := range plugin.Files {
+		if f.Generate {
+			gengo.GenerateFile(plugin, f)
+		}
+	}
+	resp := plugin.Response()
+	Expect(resp.Error).To(BeNil())
+	for _, f := range resp.File {
+		path := filepath.Join(modDir, strings.TrimPrefix(f.GetName(), runModule+"/"))
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(f.GetContent()), 0o644)).To(Succeed())
+	}
+}
+
+// sortProtoFiles orders files so that the dependencies precede the files
+// importing them, as protogen requires.
+func sortProtoFiles(files []*descriptorpb.FileDescriptorProto) {
+	byName := make(map[string]*descriptorpb.FileDescriptorProto)
+	for _, fd := range files {
+		byName[fd.GetName()] = fd
+	}
+	sorted := files[:0:0]
+	added := make(map[string]bool)
+	var add func(fd *descriptorpb.FileDescriptorProto)
+	add = func(fd *descriptorpb.FileDescriptorProto) {
+		if added[fd.GetName()] {
+			return
+		}
+		added[fd.GetName()] = true
+		for _, dep := range fd.Dependency {
+			add(byName[dep])
+		}
+		sorted = append(sorted, fd)
+	}
+	for _, fd := range files {
+		add(fd)
+	}
+	copy(files, sorted)
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	b, err := os.ReadFile(src)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(os.WriteFile(dst, b, 0o644)).To(Succeed())
+}
@@ -0,0 +1,200 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// This file is copied to the module with the generated binapi and run
+// by TestGeneratedCode in package binapigen.
+
+package gentest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+	"go.fd.io/govpp/grpcbridge"
+
+	"example.com/gentest/binapi/example"
+	"example.com/gentest/binapi/example/examplefake"
+	"example.com/gentest/binapi/example/examplepb"
+	"example.com/gentest/binapi/fib_types"
+	"example.com/gentest/binapi/ip"
+	"example.com/gentest/binapi/ip/ippb"
+	"example.com/gentest/binapi/ip_types"
+)
+
+func TestFakeRPCService(t *testing.T) {
+	ctx := context.Background()
+	fake := examplefake.NewFakeRPCService()
+	var svc example.RPCService = fake
+
+	fake.OnExampleSet().Returns(&example.ExampleSetReply{Retval: 1}, nil)
+	reply, err := svc.ExampleSet(ctx, &example.ExampleSet{Tag: "x"})
+	if err != nil || reply.Retval != 1 {
+		t.Fatalf("expected reply with retval 1, got %+v: %v", reply, err)
+	}
+
+	fake.OnExampleGet().Returns([]*example.ExampleDetails{{Name: "a"}, {Name: "b"}}, &example.ExampleGetReply{Cursor: 5}, nil)
+	client, err := svc.ExampleGet(ctx, &example.ExampleGet{Cursor: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var names []string
+	for {
+		details, getReply, err := client.Recv()
+		if err == io.EOF {
+			if getReply == nil || getReply.Cursor != 5 {
+				t.Fatalf("expected reply with cursor 5, got %+v", getReply)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		names = append(names, details.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("expected details a, b, got %v", names)
+	}
+
+	var methods []string
+	for _, call := range fake.Calls() {
+		methods = append(methods, call.Method)
+	}
+	if !reflect.DeepEqual(methods, []string{"ExampleSet", "ExampleGet"}) {
+		t.Fatalf("expected calls ExampleSet, ExampleGet, got %v", methods)
+	}
+}
+
+func TestProtoConversion(t *testing.T) {
+	route := &ip.IPRouteAddDel{
+		IsAdd: true,
+		Route: ip.IPRoute{
+			TableID: 1,
+			Prefix: ip_types.Prefix{
+				Address: ip_types.Address{Af: ip_types.ADDRESS_IP4, Un: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 0})},
+				Len:     24,
+			},
+			NPaths: 1,
+			Paths: []fib_types.FibPath{{
+				Weight: 1,
+				Proto:  fib_types.FIB_API_PATH_NH_PROTO_IP4,
+				Nh:     fib_types.FibPathNh{Address: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1})},
+			}},
+		},
+	}
+
+	pb := ippb.IPRouteAddDelToProto(route)
+	if nh := pb.Route.Paths[0].Nh.Address.GetIp4(); !reflect.DeepEqual(nh, []byte{10, 0, 0, 1}) {
+		t.Fatalf("expected next hop 10.0.0.1, got %v", nh)
+	}
+	out, err := ippb.IPRouteAddDelFromProto(pb)
+	if err != nil || !reflect.DeepEqual(out, route) {
+		t.Fatalf("expected %+v, got %+v: %v", route, out, err)
+	}
+
+	// the count of paths follows the paths
+	pb.Route.NPaths = 5
+	if out, err := ippb.IPRouteAddDelFromProto(pb); err != nil || out.Route.NPaths != 1 {
+		t.Fatalf("expected 1 path, got %+v: %v", out, err)
+	}
+
+	pb.Route.Paths[0].Weight = 256
+	if _, err := ippb.IPRouteAddDelFromProto(pb); !errors.Is(err, codec.ErrOutOfRange) {
+		t.Fatalf("expected out of range error, got: %v", err)
+	}
+}
+
+func TestServiceBridge(t *testing.T) {
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Disconnect()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	examplepb.RegisterExampleServiceBridge(srv, examplepb.NewExampleServiceBridge(conn))
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	client, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	mockVpp.MockReply(&example.ExampleSetReply{})
+	setReply := new(examplepb.ExampleSetReply)
+	if err := client.Invoke(ctx, "/vpp.example.ExampleService/ExampleSet", &examplepb.ExampleSet{Tag: "x"}, setReply); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = client.Invoke(ctx, "/vpp.example.ExampleService/ExampleSet", &examplepb.ExampleSet{Mode: 1000}, setReply)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got: %v", err)
+	}
+
+	mockVpp.MockReply(
+		&example.ExampleDetails{Name: "a"},
+		&example.ExampleDetails{Name: "b"},
+		&example.ExampleGetReply{Cursor: 7},
+	)
+	stream, err := client.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/vpp.example.ExampleService/ExampleGet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(&examplepb.ExampleGet{Cursor: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for {
+		details := new(examplepb.ExampleDetails)
+		err := stream.RecvMsg(details)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		names = append(names, details.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("expected details a, b, got %v", names)
+	}
+	getReply := new(examplepb.ExampleGetReply)
+	if ok, err := grpcbridge.ReplyFromTrailer(stream.Trailer(), getReply); !ok || err != nil || getReply.Cursor != 7 {
+		t.Fatalf("expected reply with cursor 7 in trailer, got %v: %v", getReply, err)
+	}
+}
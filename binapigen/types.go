@@ -123,6 +123,12 @@ func getFieldType(g *GenFile, field *Field) string {
 	return gotype
 }
 
+// UnionSize returns the size of the union data, which is the size of its
+// largest member.
+func UnionSize(union *Union) int {
+	return getUnionSize(union)
+}
+
 func getUnionSize(union *Union) (maxSize int) {
 	for _, field := range union.Fields {
 		size, _ := getSizeOfField(field)
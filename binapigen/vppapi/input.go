@@ -41,6 +41,7 @@ import (
 // * Directory: `dir`
 //   - absolute: `/usr/share/vpp/api`
 //   - relative: `path/to/apidir`
+//   - source:   `src` (.api files are parsed when no .api.json files are found)
 //
 // * Git repository: `git`
 //   - local repository: `.git`
@@ -0,0 +1,77 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppapi
+
+import (
+	"strings"
+)
+
+// FieldLimits returns the limits of message fields defined by the limit
+// option in the API files of schema, e.g. "string tag[] [limit=64]". The
+// limits are keyed by the message name and CRC as the binapi messages are
+// registered (e.g. "example_set_7b6e8e8b") and by the path of the field in
+// the message using field names (e.g. "route.paths"), the messages without
+// limits are omitted.
+//
+// The limits are used for validation of the binapi messages generated
+// without the limit tags, see codec.ValidateMsgLimits.
+func FieldLimits(schema *Schema) map[string]map[string]int {
+	structs := make(map[string][]Field)
+	for _, file := range schema.Files {
+		for _, typ := range file.StructTypes {
+			structs[typ.Name] = typ.Fields
+		}
+	}
+	var walk func(fields []Field, path string, limits map[string]int, visiting map[string]bool)
+	walk = func(fields []Field, path string, limits map[string]int, visiting map[string]bool) {
+		for _, field := range fields {
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			if limit := fieldLimit(field); limit > 0 {
+				limits[fieldPath] = limit
+			}
+			name := strings.TrimSuffix(strings.TrimPrefix(field.Type, "vl_api_"), "_t")
+			if nested, ok := structs[name]; ok && !visiting[name] {
+				visiting[name] = true
+				walk(nested, fieldPath, limits, visiting)
+				delete(visiting, name)
+			}
+		}
+	}
+	msgLimits := make(map[string]map[string]int)
+	for _, file := range schema.Files {
+		for _, msg := range file.Messages {
+			limits := make(map[string]int)
+			walk(msg.Fields, "", limits, make(map[string]bool))
+			if len(limits) > 0 {
+				msgLimits[msg.Name+"_"+strings.TrimPrefix(msg.CRC, "0x")] = limits
+			}
+		}
+	}
+	return msgLimits
+}
+
+// fieldLimit returns the limit option of the field, or zero if it has none.
+func fieldLimit(field Field) int {
+	switch limit := field.Meta["limit"].(type) {
+	case int:
+		return limit
+	case float64: // numbers parsed from JSON
+		return int(limit)
+	}
+	return 0
+}
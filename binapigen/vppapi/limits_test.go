@@ -0,0 +1,64 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppapi
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFieldLimits(t *testing.T) {
+	RegisterTestingT(t)
+
+	file, err := ParseFile("testdata/src/plugins/example/example.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	limits := FieldLimits(&Schema{Files: []File{*file}})
+	Expect(limits).To(HaveLen(1))
+	Expect(limits).To(HaveKeyWithValue(
+		"example_set_"+crcOf(file, "example_set"), map[string]int{"limit": 64}))
+
+	schema := &Schema{Files: []File{{
+		StructTypes: []StructType{
+			{Name: "path", Fields: []Field{
+				{Name: "label", Type: "string", Array: true, Meta: map[string]interface{}{"limit": 8}},
+			}},
+			{Name: "route", Fields: []Field{
+				{Name: "n_paths", Type: "u8"},
+				{Name: "paths", Type: "vl_api_path_t", Array: true, SizeFrom: "n_paths", Meta: map[string]interface{}{"limit": float64(16)}},
+			}},
+		},
+		Messages: []Message{
+			{Name: "route_add", CRC: "0x01234567", Fields: []Field{
+				{Name: "route", Type: "vl_api_route_t"},
+			}},
+			{Name: "route_add_reply", CRC: "0x89abcdef", Fields: []Field{
+				{Name: "retval", Type: "i32"},
+			}},
+		},
+	}}}
+	Expect(FieldLimits(schema)).To(Equal(map[string]map[string]int{
+		"route_add_01234567": {"route.paths": 16, "route.paths.label": 8},
+	}))
+}
+
+func crcOf(file *File, name string) string {
+	for _, msg := range file.Messages {
+		if msg.Name == name {
+			return msg.CRC[2:]
+		}
+	}
+	return ""
+}
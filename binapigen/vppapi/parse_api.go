@@ -0,0 +1,511 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppapi
+
+import (
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// This file implements parser of VPP API source files (.api) written in
+// the IDL processed by vppapigen. The parsed files are converted into the same
+// model as produced from the JSON files generated by vppapigen, including
+// the types of imported files. The CRCs are computed the same way as
+// vppapigen does, which is a CRC32 of the Python representation of the
+// definitions folded with the CRCs of all used types.
+
+// apiNode is a top-level object of API source file.
+type apiNode interface {
+	// crcData returns data used for computing CRC, or nil if the object is not included in the CRC.
+	crcData() []byte
+}
+
+type (
+	apiOption struct {
+		name  string
+		value interface{}
+	}
+
+	apiImport struct {
+		path string
+		// source is the imported file, set when resolving imports
+		source *apiSource
+	}
+
+	apiField struct {
+		typ         string
+		name        string
+		array       bool
+		length      int
+		lengthField string
+		options     map[string]interface{}
+	}
+
+	apiDefine struct {
+		name    string
+		flags   []string
+		fields  []apiField
+		options []apiOption
+		comment string
+	}
+
+	apiTypedef struct {
+		name   string
+		fields []apiField
+	}
+
+	apiUnion struct {
+		name   string
+		fields []apiField
+	}
+
+	apiUsing struct {
+		name  string
+		alias apiField
+	}
+
+	apiEnumEntry struct {
+		name  string
+		value int64
+		// backwards compatible entries are excluded from CRC
+		compat bool
+	}
+
+	apiEnum struct {
+		name    string
+		typ     string
+		flag    bool
+		entries []apiEnumEntry
+	}
+
+	apiService struct {
+		rpcs []RPC
+	}
+
+	apiCounters struct {
+		counter Counter
+	}
+
+	apiPaths struct {
+		paths []CounterPaths
+	}
+)
+
+func (o *apiOption) crcData() []byte  { return []byte(o.name) }
+func (*apiImport) crcData() []byte    { return nil }
+func (d *apiDefine) crcData() []byte  { return []byte(blockRepr(d.fields)) }
+func (t *apiTypedef) crcData() []byte { return []byte(blockRepr(t.fields)) }
+func (u *apiUnion) crcData() []byte   { return []byte(blockRepr(u.fields)) }
+func (*apiUsing) crcData() []byte     { return []byte("[]") }
+func (*apiService) crcData() []byte   { return nil }
+func (*apiCounters) crcData() []byte  { return nil }
+func (*apiPaths) crcData() []byte     { return nil }
+
+func (e *apiEnum) crcData() []byte {
+	var entries []string
+	for _, entry := range e.entries {
+		if !entry.compat {
+			entries = append(entries, fmt.Sprintf("['%s', %d]", entry.name, entry.value))
+		}
+	}
+	return []byte("[" + strings.Join(entries, ", ") + "]")
+}
+
+// repr returns Python representation of the field used by vppapigen for CRC.
+func (f *apiField) repr() string {
+	if !f.array {
+		return fmt.Sprintf("['%s', '%s']", f.typ, f.name)
+	}
+	lengthField := "None"
+	if f.lengthField != "" {
+		lengthField = "'" + f.lengthField + "'"
+	}
+	return fmt.Sprintf("['%s', '%s', %d, %s]", f.typ, f.name, f.length, lengthField)
+}
+
+func blockRepr(fields []apiField) string {
+	reprs := make([]string, len(fields))
+	for i := range fields {
+		reprs[i] = fields[i].repr()
+	}
+	return "[" + strings.Join(reprs, ", ") + "]"
+}
+
+func (f *apiField) toField() Field {
+	field := Field{
+		Name:     f.name,
+		Type:     f.typ,
+		Length:   f.length,
+		Array:    f.array,
+		SizeFrom: f.lengthField,
+	}
+	if !f.array && len(f.options) > 0 {
+		field.Meta = make(map[string]interface{}, len(f.options))
+		for k, v := range f.options {
+			field.Meta[k] = v
+		}
+	}
+	return field
+}
+
+// apiSource is a parsed API source file.
+type apiSource struct {
+	path  string
+	nodes []apiNode
+}
+
+// apiSourceParser parses API source files and the files they import.
+type apiSourceParser struct {
+	sources map[string]*apiSource
+	loading map[string]bool
+}
+
+func newApiSourceParser() *apiSourceParser {
+	return &apiSourceParser{
+		sources: make(map[string]*apiSource),
+		loading: make(map[string]bool),
+	}
+}
+
+// load parses the API source file and all the files it imports.
+func (sp *apiSourceParser) load(path string) (*apiSource, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if src, ok := sp.sources[path]; ok {
+		return src, nil
+	}
+	if sp.loading[path] {
+		return nil, fmt.Errorf("import cycle detected for %s", path)
+	}
+	sp.loading[path] = true
+	defer delete(sp.loading, path)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s error: %w", path, err)
+	}
+	nodes, err := parseApiSource(filepath.Base(path), string(content))
+	if err != nil {
+		return nil, err
+	}
+	src := &apiSource{path: path, nodes: nodes}
+	for _, node := range nodes {
+		imp, ok := node.(*apiImport)
+		if !ok {
+			continue
+		}
+		impPath, err := resolveImport(path, imp.path)
+		if err != nil {
+			return nil, err
+		}
+		if imp.source, err = sp.load(impPath); err != nil {
+			return nil, fmt.Errorf("import %q: %w", imp.path, err)
+		}
+	}
+	sp.sources[path] = src
+	return src, nil
+}
+
+// parseFile parses the API source file and returns it converted to File.
+func (sp *apiSourceParser) parseFile(apiFile string) (*File, error) {
+	base := filepath.Base(apiFile)
+
+	logf("Parsing source file: %q", base)
+
+	src, err := sp.load(apiFile)
+	if err != nil {
+		return nil, fmt.Errorf("parsing API file %q failed: %w", base, err)
+	}
+	file, err := buildFile(src)
+	if err != nil {
+		return nil, fmt.Errorf("parsing API file %q failed: %w", base, err)
+	}
+	file.Name = strings.TrimSuffix(base, APISourceFileExtension)
+	file.Path = apiFile
+	return file, nil
+}
+
+// resolveImport finds the imported file in the directory of the importing file
+// or any of its parent directories, since imports are relative to the source root.
+func resolveImport(from, imp string) (string, error) {
+	dir := filepath.Dir(from)
+	for {
+		path := filepath.Join(dir, imp)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	return "", fmt.Errorf("imported file %q not found for %s", imp, from)
+}
+
+// expandImports returns the objects of the source preceded by the types of
+// the imported files at the place of their import.
+func expandImports(nodes []apiNode, inImport bool, result []apiNode) []apiNode {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case *apiImport:
+			result = append(result, n)
+			if n.source != nil {
+				result = expandImports(n.source.nodes, true, result)
+			}
+		case *apiEnum, *apiTypedef, *apiUnion, *apiUsing:
+			result = append(result, n)
+		default:
+			// only types are allowed from imported files
+			if !inImport {
+				result = append(result, n)
+			}
+		}
+	}
+	return result
+}
+
+// buildFile converts parsed API source to File.
+func buildFile(src *apiSource) (*File, error) {
+	nodes := expandImports(src.nodes, false, nil)
+
+	file := new(File)
+	types := make(map[string]apiNode)
+	known := make(map[string]bool)
+	imported := make(map[string]bool)
+	var defines []*apiDefine
+	var services []RPC
+	var fileCrc uint32
+
+	for _, node := range nodes {
+		if data := node.crcData(); data != nil {
+			fileCrc = crc32.Update(fileCrc, crc32.IEEETable, data)
+		}
+		switch n := node.(type) {
+		case *apiOption:
+			if file.Options == nil {
+				file.Options = make(map[string]string)
+			}
+			file.Options[n.name] = optionString(n.value)
+		case *apiImport:
+			if !imported[n.path] {
+				imported[n.path] = true
+				file.Imports = append(file.Imports, n.path)
+			}
+		case *apiDefine:
+			defines = append(defines, n)
+			if hasFlag(n.flags, "autoreply") {
+				defines = append(defines, autoreplyDefine(n))
+			}
+		case *apiService:
+			services = append(services, n.rpcs...)
+		case *apiCounters:
+			file.Counters = append(file.Counters, n.counter)
+		case *apiPaths:
+			file.Paths = append(file.Paths, n.paths...)
+		}
+
+		var name string
+		switch n := node.(type) {
+		case *apiEnum:
+			name = n.name
+		case *apiTypedef:
+			name = n.name
+		case *apiUnion:
+			name = n.name
+		case *apiUsing:
+			name = n.name
+		default:
+			continue
+		}
+		if known[name] {
+			continue
+		}
+		known[name] = true
+		types[typeRef(name)] = node
+
+		switch n := node.(type) {
+		case *apiEnum:
+			enum := EnumType{Name: n.name, Type: n.typ}
+			for _, entry := range n.entries {
+				enum.Entries = append(enum.Entries, EnumEntry{Name: entry.name, Value: uint32(entry.value)})
+			}
+			if n.flag {
+				file.EnumflagTypes = append(file.EnumflagTypes, enum)
+			} else {
+				file.EnumTypes = append(file.EnumTypes, enum)
+			}
+		case *apiTypedef:
+			file.StructTypes = append(file.StructTypes, StructType{Name: n.name, Fields: toFields(n.fields)})
+		case *apiUnion:
+			file.UnionTypes = append(file.UnionTypes, UnionType{Name: n.name, Fields: toFields(n.fields)})
+		case *apiUsing:
+			file.AliasTypes = append(file.AliasTypes, AliasType{Name: n.name, Type: n.alias.typ, Length: n.alias.length})
+		}
+	}
+	file.CRC = fmt.Sprintf("%#x", fileCrc)
+
+	msgs := make(map[string]bool)
+	for _, d := range defines {
+		crc := crc32.ChecksumIEEE(d.crcData())
+		crc, err := foldCrc(d.fields, crc, types)
+		if err != nil {
+			return nil, fmt.Errorf("message %s: %w", d.name, err)
+		}
+		msg := Message{
+			Name:    d.name,
+			Fields:  append([]Field{{Name: "_vl_msg_id", Type: "u16"}}, toFields(d.fields)...),
+			CRC:     fmt.Sprintf("0x%08x", crc),
+			Comment: d.comment,
+		}
+		for _, opt := range d.options {
+			if msg.Options == nil {
+				msg.Options = make(map[string]string)
+			}
+			msg.Options[opt.name] = optionString(opt.value)
+		}
+		file.Messages = append(file.Messages, msg)
+		msgs[d.name] = true
+	}
+
+	rpcs, err := resolveServices(services, file.Messages, msgs)
+	if err != nil {
+		return nil, err
+	}
+	if len(rpcs) > 0 {
+		file.Service = &Service{RPCs: rpcs}
+	}
+	return file, nil
+}
+
+// foldCrc folds the CRCs of the types used by fields into crc.
+func foldCrc(fields []apiField, crc uint32, types map[string]apiNode) (uint32, error) {
+	for _, f := range fields {
+		if !strings.HasPrefix(f.typ, "vl_api_") {
+			continue
+		}
+		typ, ok := types[f.typ]
+		if !ok {
+			return 0, fmt.Errorf("undefined type %s of field %s", f.typ, f.name)
+		}
+		crc = crc32.Update(crc, crc32.IEEETable, typ.crcData())
+		var err error
+		switch t := typ.(type) {
+		case *apiTypedef:
+			crc, err = foldCrc(t.fields, crc, types)
+		case *apiUnion:
+			crc, err = foldCrc(t.fields, crc, types)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return crc, nil
+}
+
+// resolveServices returns the explicit services followed by the implicit
+// services of request/reply and dump/details messages.
+func resolveServices(services []RPC, messages []Message, msgs map[string]bool) ([]RPC, error) {
+	callers := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, rpc := range services {
+		if !msgs[rpc.Request] {
+			return nil, fmt.Errorf("service definition references unknown message: %s", rpc.Request)
+		}
+		if rpc.Reply != "null" && !msgs[rpc.Reply] {
+			return nil, fmt.Errorf("service definition references unknown message: %s", rpc.Reply)
+		}
+		for _, event := range rpc.Events {
+			if !msgs[event] {
+				return nil, fmt.Errorf("service definition references unknown message: %s", event)
+			}
+			seen[event] = true
+		}
+		callers[rpc.Request] = true
+		seen[rpc.Request] = true
+		seen[rpc.Reply] = true
+	}
+	rpcs := services
+	for _, msg := range messages {
+		name := msg.Name
+		switch {
+		case seen[name] || callers[name]:
+		case strings.HasSuffix(name, "_reply"):
+		case strings.HasSuffix(name, "_details"):
+		case strings.HasSuffix(name, "_dump"):
+			if details := strings.TrimSuffix(name, "_dump") + "_details"; msgs[details] {
+				rpcs = append(rpcs, RPC{Request: name, Reply: details, Stream: true})
+			} else {
+				logf("dump message %s has no details message", name)
+			}
+		case msgs[name+"_reply"]:
+			rpcs = append(rpcs, RPC{Request: name, Reply: name + "_reply"})
+		default:
+			logf("message %s has no reply message", name)
+		}
+	}
+	return rpcs, nil
+}
+
+// autoreplyDefine returns the reply message for define with autoreply flag.
+func autoreplyDefine(d *apiDefine) *apiDefine {
+	return &apiDefine{
+		name: d.name + "_reply",
+		fields: []apiField{
+			{typ: "u32", name: "context"},
+			{typ: "i32", name: "retval"},
+		},
+		options: d.options,
+	}
+}
+
+func toFields(fields []apiField) []Field {
+	list := make([]Field, len(fields))
+	for i := range fields {
+		list[i] = fields[i].toField()
+	}
+	return list
+}
+
+func typeRef(name string) string {
+	return "vl_api_" + name + "_t"
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+func optionString(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
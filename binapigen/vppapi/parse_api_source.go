@@ -0,0 +1,662 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokComment
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of file"
+	case tokString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lexApiSource splits the API source into tokens. The line comments are
+// skipped, the block comments are returned as tokens.
+func lexApiSource(name, src string) ([]token, error) {
+	var tokens []token
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("%s:%d: unterminated comment", name, line)
+			}
+			text := src[i : i+2+end+2]
+			tokens = append(tokens, token{kind: tokComment, text: text, line: line})
+			line += strings.Count(text, "\n")
+			i += len(text)
+		case c == '"':
+			j := i + 1
+			for j < len(src) && src[j] != '"' {
+				if src[j] == '\n' {
+					return nil, fmt.Errorf("%s:%d: unterminated string", name, line)
+				}
+				if src[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("%s:%d: unterminated string", name, line)
+			}
+			tokens = append(tokens, token{kind: tokString, text: src[i+1 : j], line: line})
+			i = j + 1
+		case isDigit(c) || (c == '-' && i+1 < len(src) && isDigit(src[i+1])):
+			j := i + 1
+			if c == '0' && j < len(src) && (src[j] == 'x' || src[j] == 'X') {
+				j++
+				for j < len(src) && isHexDigit(src[j]) {
+					j++
+				}
+			} else {
+				for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+					j++
+				}
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: src[i:j], line: line})
+			i = j
+		case isLetter(c):
+			j := i + 1
+			for j < len(src) && (isLetter(src[j]) || isDigit(src[j])) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: src[i:j], line: line})
+			i = j
+		case strings.IndexByte("{}[];=,:", c) >= 0:
+			tokens = append(tokens, token{kind: tokPunct, text: string(c), line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("%s:%d: unexpected character %q", name, line, c)
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, line: line})
+	return tokens, nil
+}
+
+func isDigit(c byte) bool    { return c >= '0' && c <= '9' }
+func isHexDigit(c byte) bool { return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F') }
+func isLetter(c byte) bool   { return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+
+// apiFlags are the flags which can precede define, typedef and union.
+var apiFlags = map[string]bool{
+	"manual_print":  true,
+	"manual_endian": true,
+	"dont_trace":    true,
+	"typeonly":      true,
+	"autoreply":     true,
+	"autoendian":    true,
+}
+
+// apiSyntaxParser parses tokens of single API source file.
+type apiSyntaxParser struct {
+	name   string
+	tokens []token
+	pos    int
+}
+
+// parseApiSource parses the API source into list of top-level objects.
+func parseApiSource(name, src string) ([]apiNode, error) {
+	tokens, err := lexApiSource(name, src)
+	if err != nil {
+		return nil, err
+	}
+	p := &apiSyntaxParser{name: name, tokens: tokens}
+	return p.parse()
+}
+
+func (p *apiSyntaxParser) errorf(tok token, format string, args ...interface{}) error {
+	return fmt.Errorf("%s:%d: %s", p.name, tok.line, fmt.Sprintf(format, args...))
+}
+
+// peek returns the next token skipping comments.
+func (p *apiSyntaxParser) peek() token {
+	for p.tokens[p.pos].kind == tokComment {
+		p.pos++
+	}
+	return p.tokens[p.pos]
+}
+
+// peekAt returns the n-th next token skipping comments.
+func (p *apiSyntaxParser) peekAt(n int) token {
+	p.peek()
+	for i := p.pos; i < len(p.tokens); i++ {
+		if p.tokens[i].kind == tokComment {
+			continue
+		}
+		if n == 0 {
+			return p.tokens[i]
+		}
+		n--
+	}
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *apiSyntaxParser) next() token {
+	tok := p.peek()
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *apiSyntaxParser) is(text string) bool {
+	tok := p.peek()
+	return (tok.kind == tokPunct || tok.kind == tokIdent) && tok.text == text
+}
+
+func (p *apiSyntaxParser) expect(text string) error {
+	if tok := p.next(); (tok.kind != tokPunct && tok.kind != tokIdent) || tok.text != text {
+		return p.errorf(tok, "expected %q, found %v", text, tok)
+	}
+	return nil
+}
+
+func (p *apiSyntaxParser) ident() (string, error) {
+	tok := p.next()
+	if tok.kind != tokIdent {
+		return "", p.errorf(tok, "expected identifier, found %v", tok)
+	}
+	return tok.text, nil
+}
+
+func (p *apiSyntaxParser) str() (string, error) {
+	tok := p.next()
+	if tok.kind != tokString {
+		return "", p.errorf(tok, "expected string, found %v", tok)
+	}
+	return tok.text, nil
+}
+
+func (p *apiSyntaxParser) integer() (int64, error) {
+	tok := p.next()
+	if tok.kind != tokNumber {
+		return 0, p.errorf(tok, "expected number, found %v", tok)
+	}
+	n, err := strconv.ParseInt(tok.text, 0, 64)
+	if err != nil {
+		return 0, p.errorf(tok, "invalid integer %s", tok.text)
+	}
+	return n, nil
+}
+
+func (p *apiSyntaxParser) parse() ([]apiNode, error) {
+	var nodes []apiNode
+	var comment string
+	for {
+		if tok := p.tokens[p.pos]; tok.kind == tokComment {
+			comment = tok.text
+			p.pos++
+			continue
+		} else if tok.kind == tokEOF {
+			return nodes, nil
+		}
+		node, err := p.statement(comment)
+		if err != nil {
+			return nil, err
+		}
+		comment = ""
+		nodes = append(nodes, node)
+	}
+}
+
+// statement parses a top-level statement, the comment is the comment preceding it.
+func (p *apiSyntaxParser) statement(comment string) (apiNode, error) {
+	tok := p.peek()
+	if tok.kind != tokIdent {
+		return nil, p.errorf(tok, "unexpected %v", tok)
+	}
+	switch tok.text {
+	case "option":
+		p.next()
+		opt, err := p.option()
+		if err != nil {
+			return nil, err
+		}
+		return opt, nil
+	case "import":
+		p.next()
+		path, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		return &apiImport{path: path}, p.expect(";")
+	case "enum", "enumflag":
+		return p.enum()
+	case "service":
+		return p.service()
+	case "counters":
+		return p.counters()
+	case "paths":
+		return p.paths()
+	}
+
+	var flags []string
+	for tok := p.peek(); tok.kind == tokIdent && apiFlags[tok.text]; tok = p.peek() {
+		flags = append(flags, p.next().text)
+	}
+	switch tok := p.next(); tok.text {
+	case "define":
+		if hasFlag(flags, "typeonly") {
+			return nil, p.errorf(tok, "legacy typeonly define is not supported")
+		}
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		fields, options, err := p.block(true)
+		if err != nil {
+			return nil, err
+		}
+		return &apiDefine{name: name, flags: flags, fields: fields, options: options, comment: comment}, nil
+	case "typedef":
+		if p.peekAt(1).text != "{" {
+			field, err := p.declaration()
+			if err != nil {
+				return nil, err
+			}
+			return &apiUsing{name: field.name, alias: field}, nil
+		}
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		fields, _, err := p.block(false)
+		if err != nil {
+			return nil, err
+		}
+		return &apiTypedef{name: name, fields: fields}, nil
+	case "union":
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		fields, _, err := p.block(false)
+		if err != nil {
+			return nil, err
+		}
+		return &apiUnion{name: name, fields: fields}, nil
+	default:
+		return nil, p.errorf(tok, "unexpected %v", tok)
+	}
+}
+
+// option parses option after the option keyword.
+func (p *apiSyntaxParser) option() (*apiOption, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	opt := &apiOption{name: name}
+	if p.is("=") {
+		p.next()
+		if opt.value, err = p.assignee(); err != nil {
+			return nil, err
+		}
+	}
+	return opt, p.expect(";")
+}
+
+// assignee parses value of option, numbers are returned as float64 as in JSON.
+func (p *apiSyntaxParser) assignee() (interface{}, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokString, tokIdent:
+		return tok.text, nil
+	case tokNumber:
+		if n, err := strconv.ParseInt(tok.text, 0, 64); err == nil {
+			return float64(n), nil
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %s", tok.text)
+		}
+		return f, nil
+	}
+	return nil, p.errorf(tok, "expected value, found %v", tok)
+}
+
+// block parses fields of define, typedef or union.
+func (p *apiSyntaxParser) block(allowOptions bool) ([]apiField, []apiOption, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, nil, err
+	}
+	var fields []apiField
+	var options []apiOption
+	for !p.is("}") {
+		if allowOptions && p.is("option") {
+			p.next()
+			opt, err := p.option()
+			if err != nil {
+				return nil, nil, err
+			}
+			options = append(options, *opt)
+			continue
+		}
+		start := p.peek()
+		field, err := p.declaration()
+		if err != nil {
+			return nil, nil, err
+		}
+		if field.lengthField != "" && !hasField(fields, field.lengthField) {
+			return nil, nil, p.errorf(start, "length field %s of %s is not defined", field.lengthField, field.name)
+		}
+		fields = append(fields, field)
+	}
+	p.next()
+	return fields, options, p.expect(";")
+}
+
+// declaration parses field declaration: type name[length] [options];
+func (p *apiSyntaxParser) declaration() (apiField, error) {
+	var field apiField
+	start := p.peek()
+	typ, err := p.ident()
+	if err != nil {
+		return field, err
+	}
+	name, err := p.ident()
+	if err != nil {
+		return field, err
+	}
+	field = apiField{typ: typ, name: name}
+	if p.is("[") && !(p.peekAt(1).kind == tokIdent && p.peekAt(2).text == "=") {
+		p.next()
+		field.array = true
+		switch tok := p.peek(); tok.kind {
+		case tokNumber:
+			n, err := p.integer()
+			if err != nil {
+				return field, err
+			}
+			field.length = int(n)
+		case tokIdent:
+			field.lengthField = p.next().text
+		}
+		if err := p.expect("]"); err != nil {
+			return field, err
+		}
+	}
+	if p.is("[") {
+		p.next()
+		if field.options, err = p.fieldOptions(); err != nil {
+			return field, err
+		}
+	}
+	if typ == "string" && !field.array {
+		return field, p.errorf(start, "string field %s must be an array", name)
+	}
+	return field, p.expect(";")
+}
+
+// fieldOptions parses options of field or enum entry after '['.
+func (p *apiSyntaxParser) fieldOptions() (map[string]interface{}, error) {
+	options := make(map[string]interface{})
+	for !p.is("]") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		options[name] = true
+		if p.is("=") {
+			p.next()
+			if options[name], err = p.assignee(); err != nil {
+				return nil, err
+			}
+		}
+		if p.is(",") {
+			p.next()
+		}
+	}
+	p.next()
+	return options, nil
+}
+
+// enum parses enum or enumflag definition.
+func (p *apiSyntaxParser) enum() (apiNode, error) {
+	enum := &apiEnum{flag: p.next().text == "enumflag", typ: "u32"}
+	var err error
+	if enum.name, err = p.ident(); err != nil {
+		return nil, err
+	}
+	if p.is(":") {
+		p.next()
+		if enum.typ, err = p.ident(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	value := int64(-1)
+	for !p.is("}") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		value++
+		if p.is("=") {
+			p.next()
+			if value, err = p.integer(); err != nil {
+				return nil, err
+			}
+		}
+		entry := apiEnumEntry{name: name, value: value}
+		if p.is("[") {
+			p.next()
+			options, err := p.fieldOptions()
+			if err != nil {
+				return nil, err
+			}
+			_, entry.compat = options["backwards_compatible"]
+		}
+		enum.entries = append(enum.entries, entry)
+		if !p.is("}") {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.next()
+	return enum, p.expect(";")
+}
+
+// service parses service block with RPC definitions.
+func (p *apiSyntaxParser) service() (apiNode, error) {
+	p.next()
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	svc := new(apiService)
+	for !p.is("}") {
+		if err := p.expect("rpc"); err != nil {
+			return nil, err
+		}
+		var rpc RPC
+		var err error
+		if rpc.Request, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("returns"); err != nil {
+			return nil, err
+		}
+		if p.is("stream") {
+			p.next()
+			rpc.Stream = true
+		}
+		if rpc.Reply, err = p.ident(); err != nil {
+			return nil, err
+		}
+		switch {
+		case p.is("stream"):
+			p.next()
+			rpc.Stream = true
+			if rpc.StreamMsg, err = p.ident(); err != nil {
+				return nil, err
+			}
+		case p.is("events"):
+			p.next()
+			for !p.is(";") {
+				event, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				rpc.Events = append(rpc.Events, event)
+				if p.is(",") {
+					p.next()
+				}
+			}
+		}
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		svc.rpcs = append(svc.rpcs, rpc)
+	}
+	p.next()
+	return svc, p.expect(";")
+}
+
+// counters parses counters block with counter elements.
+func (p *apiSyntaxParser) counters() (apiNode, error) {
+	p.next()
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	counters := &apiCounters{counter: Counter{Name: name}}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for !p.is("}") {
+		var elem Element
+		if elem.Name, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("{"); err != nil {
+			return nil, err
+		}
+		for !p.is("}") {
+			tok := p.next()
+			var value string
+			switch tok.text {
+			case "severity", "type":
+				value, err = p.ident()
+			case "units", "description":
+				value, err = p.str()
+			default:
+				return nil, p.errorf(tok, "unexpected counter statement %v", tok)
+			}
+			if err != nil {
+				return nil, err
+			}
+			switch tok.text {
+			case "severity":
+				elem.Severity = value
+			case "type":
+				elem.Type = value
+			case "units":
+				elem.Units = value
+			case "description":
+				elem.Description = value
+			}
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+		}
+		p.next()
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		counters.counter.Elements = append(counters.counter.Elements, elem)
+	}
+	p.next()
+	return counters, p.expect(";")
+}
+
+// paths parses paths block mapping paths to counters.
+func (p *apiSyntaxParser) paths() (apiNode, error) {
+	p.next()
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	paths := new(apiPaths)
+NextPath:
+	for !p.is("}") {
+		path, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		counter, err := p.str()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+		for i := range paths.paths {
+			if paths.paths[i].Name == counter {
+				paths.paths[i].Paths = append(paths.paths[i].Paths, path)
+				continue NextPath
+			}
+		}
+		paths.paths = append(paths.paths, CounterPaths{Name: counter, Paths: []string{path}})
+	}
+	p.next()
+	return paths, p.expect(";")
+}
+
+func hasField(fields []apiField, name string) bool {
+	for _, f := range fields {
+		if f.name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseSourceFileCRC(t *testing.T) {
+	RegisterTestingT(t)
+
+	file, err := ParseFile("testdata/src/vnet/ip/ip.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(file.Name).To(Equal("ip"))
+	Expect(file.Path).To(Equal("testdata/src/vnet/ip/ip.api"))
+	Expect(file.Options).To(Equal(map[string]string{"version": "3.2.0"}))
+	Expect(file.Imports).To(Equal([]string{
+		"vnet/interface_types.api",
+		"vnet/fib/fib_types.api",
+		"vnet/ip/ip_types.api",
+	}))
+
+	// CRCs of messages generated by vppapigen
+	crcs := map[string]string{
+		"ip_table_add_del":                   "0x0ffdaec0",
+		"ip_table_add_del_reply":             "0xe8d4e804",
+		"ip_table_dump":                      "0x51077d14",
+		"ip_table_details":                   "0xc79fca0f",
+		"ip_route_add_del":                   "0xb8ecfe0d",
+		"ip_route_add_del_reply":             "0x1992deab",
+		"ip_route_dump":                      "0xb9d2e09e",
+		"ip_route_details":                   "0xbda8f315",
+		"set_ip_flow_hash_v2":                "0x6d132100",
+		"set_ip_flow_hash_v2_reply":          "0xe8d4e804",
+		"ip_address_details":                 "0xee29b797",
+		"ip_address_dump":                    "0x2d033de4",
+		"ip_reassembly_enable_disable":       "0xeb77968d",
+		"ip_reassembly_enable_disable_reply": "0xe8d4e804",
+	}
+	Expect(file.Messages).To(HaveLen(len(crcs)))
+	for _, msg := range file.Messages {
+		Expect(msg.CRC).To(Equal(crcs[msg.Name]), "CRC of message %s", msg.Name)
+		Expect(msg.Fields[0]).To(Equal(Field{Name: "_vl_msg_id", Type: "u16"}))
+	}
+}
+
+func TestParseSourceFileVersionCRC(t *testing.T) {
+	RegisterTestingT(t)
+
+	file, err := ParseFile("testdata/src/vnet/interface_types.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(file.CRC).To(Equal("0x7f2ba79a"))
+	Expect(file.AliasTypes).To(Equal([]AliasType{{Name: "interface_index", Type: "u32"}}))
+	Expect(file.EnumTypes).To(HaveLen(7))
+	Expect(file.EnumTypes[4]).To(Equal(EnumType{
+		Name: "rx_mode",
+		Type: "u32",
+		Entries: []EnumEntry{
+			{Name: "RX_MODE_API_UNKNOWN", Value: 0},
+			{Name: "RX_MODE_API_POLLING", Value: 1},
+			{Name: "RX_MODE_API_INTERRUPT", Value: 2},
+			{Name: "RX_MODE_API_ADAPTIVE", Value: 3},
+			{Name: "RX_MODE_API_DEFAULT", Value: 4},
+		},
+	}))
+	Expect(file.Messages).To(BeEmpty())
+	Expect(file.Service).To(BeNil())
+}
+
+// TestParseSourceFileModel compares the parsed file with the JSON file
+// generated by vppapigen from the same definitions.
+func TestParseSourceFileModel(t *testing.T) {
+	RegisterTestingT(t)
+
+	file, err := ParseFile("testdata/src/vnet/ip/ip.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	jsonFile, err := ParseFile("testdata/ip.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// the JSON file is from older version, compare types defined in both
+	for _, typ := range file.StructTypes {
+		for _, jsonTyp := range jsonFile.StructTypes {
+			if typ.Name == jsonTyp.Name {
+				Expect(typ).To(Equal(jsonTyp))
+			}
+		}
+	}
+	for _, typ := range file.UnionTypes {
+		Expect(jsonFile.UnionTypes).To(ContainElement(typ))
+	}
+	for _, typ := range file.AliasTypes {
+		Expect(jsonFile.AliasTypes).To(ContainElement(typ))
+	}
+	for _, typ := range append(file.EnumTypes, file.EnumflagTypes...) {
+		for _, jsonTyp := range append(jsonFile.EnumTypes, jsonFile.EnumflagTypes...) {
+			if typ.Name == jsonTyp.Name {
+				Expect(typ).To(Equal(jsonTyp))
+			}
+		}
+	}
+	for _, msg := range file.Messages {
+		var jsonMsg *Message
+		for i := range jsonFile.Messages {
+			if jsonFile.Messages[i].Name == msg.Name {
+				jsonMsg = &jsonFile.Messages[i]
+			}
+		}
+		Expect(jsonMsg).ToNot(BeNil(), "message %s", msg.Name)
+		Expect(msg.Fields).To(Equal(jsonMsg.Fields), "fields of message %s", msg.Name)
+	}
+	for _, rpc := range file.Service.RPCs {
+		Expect(jsonFile.Service.RPCs).To(ContainElement(rpc))
+	}
+}
+
+func TestParseSourceFileSyntax(t *testing.T) {
+	RegisterTestingT(t)
+
+	file, err := ParseFile("testdata/src/plugins/example/example.api")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(file.Options).To(Equal(map[string]string{"version": "0.1.0", "status": "in_progress"}))
+	Expect(file.Imports).To(Equal([]string{"vnet/interface_types.api"}))
+	Expect(file.EnumTypes).To(ContainElement(EnumType{
+		Name: "example_mode",
+		Type: "u8",
+		Entries: []EnumEntry{
+			{Name: "EXAMPLE_MODE_NONE", Value: 0},
+			{Name: "EXAMPLE_MODE_FAST", Value: 1},
+			{Name: "EXAMPLE_MODE_SLOW", Value: 2},
+			{Name: "EXAMPLE_MODE_OLD", Value: 10},
+		},
+	}))
+
+	messages := make(map[string]Message)
+	for _, msg := range file.Messages {
+		messages[msg.Name] = msg
+	}
+	set := messages["example_set"]
+	Expect(set.Options).To(Equal(map[string]string{"deprecated": ""}))
+	Expect(set.Comment).To(HavePrefix("/** \\brief Set example mode"))
+	Expect(set.Fields).To(Equal([]Field{
+		{Name: "_vl_msg_id", Type: "u16"},
+		{Name: "client_index", Type: "u32"},
+		{Name: "context", Type: "u32"},
+		{Name: "sw_if_index", Type: "vl_api_interface_index_t"},
+		{Name: "mode", Type: "vl_api_example_mode_t", Meta: map[string]interface{}{"default": float64(1)}},
+		{Name: "limit", Type: "u32", Meta: map[string]interface{}{"limit": float64(64)}},
+		{Name: "tag", Type: "string", Array: true},
+	}))
+	Expect(messages["example_set_reply"].Options).To(Equal(map[string]string{"deprecated": ""}))
+	Expect(messages["example_details"].Fields[3]).To(Equal(Field{Name: "data", Type: "u8", Array: true, SizeFrom: "n_data"}))
+	Expect(messages["example_details"].Fields[4]).To(Equal(Field{Name: "name", Type: "string", Array: true, Length: 32}))
+
+	Expect(file.Service.RPCs).To(Equal([]RPC{
+		{Request: "example_get", Reply: "example_get_reply", Stream: true, StreamMsg: "example_details"},
+		{Request: "want_example_events", Reply: "want_example_events_reply", Events: []string{"example_event"}},
+		{Request: "example_set", Reply: "example_set_reply"},
+	}))
+	Expect(file.Counters).To(Equal([]Counter{{
+		Name: "example",
+		Elements: []Element{
+			{Name: "processed", Severity: "info", Type: "counter64", Units: "packets", Description: "packets processed"},
+			{Name: "dropped", Severity: "error", Type: "counter64", Units: "packets", Description: "packets dropped"},
+		},
+	}}))
+	Expect(file.Paths).To(Equal([]CounterPaths{{
+		Name:  "example",
+		Paths: []string{"/err/example-node", "/err/example-output"},
+	}}))
+}
+
+func TestParseSourceFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"syntax", "define foo {\n  u32 context\n};", "test.api:3: expected \";\", found \"}\""},
+		{"undefined type", "define foo_reply {\n  u32 context;\n  vl_api_bar_t bar;\n};", "undefined type vl_api_bar_t"},
+		{"length field", "define foo_reply {\n  u32 context;\n  u8 data[n];\n};", "test.api:3: length field n of data is not defined"},
+		{"string", "typedef foo {\n  string name;\n};", "test.api:2: string field name must be an array"},
+		{"comment", "/* unterminated", "test.api:1: unterminated comment"},
+		{"import", "import \"missing.api\";", "imported file \"missing.api\" not found"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			path := filepath.Join(t.TempDir(), "test.api")
+			Expect(os.WriteFile(path, []byte(test.content), 0644)).To(Succeed())
+			_, err := ParseFile(path)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(test.wantErr))
+		})
+	}
+}
+
+func TestParseDirSource(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := ParseDir("testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	Expect(paths).To(ConsistOf(
+		"plugins/example/example.api",
+		"vnet/fib/fib_types.api",
+		"vnet/interface_types.api",
+		"vnet/ip/ip.api",
+		"vnet/ip/ip_types.api",
+	))
+
+	input, err := ResolveVppInput("testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(input.Schema.Files).To(HaveLen(5))
+}
@@ -28,6 +28,13 @@ const (
 
 	// APIFileExtension is a VPP API file extension suffix.
 	APIFileExtension = ".api.json"
+
+	// APISourceFileExtension is a VPP API source file extension suffix.
+	APISourceFileExtension = ".api"
+
+	// sourceSearchDepth is the maximum depth of directories searched for API
+	// source files, which is enough to find all files in VPP repository.
+	sourceSearchDepth = 5
 )
 
 // FindFiles searches for API files in specified directory or in a subdirectory
@@ -42,20 +49,37 @@ func FindFiles(dir string) (files []string, err error) {
 // or a subdirectory that is not nested more than deep. The returned list of files
 // will contain paths relative to dir.
 func FindFilesRecursive(dir string, deep int) (files []string, err error) {
+	return findFiles(dir, deep, APIFileExtension, nil)
+}
+
+// FindSourceFiles searches for API source files inside specified directory
+// and its subdirectories, skipping hidden directories and build-root of VPP
+// repository. The returned list of files will contain paths relative to dir.
+func FindSourceFiles(dir string) (files []string, err error) {
+	skip := func(name string) bool {
+		return strings.HasPrefix(name, ".") || name == "build-root"
+	}
+	return findFiles(dir, sourceSearchDepth, APISourceFileExtension, skip)
+}
+
+func findFiles(dir string, deep int, suffix string, skipDir func(string) bool) (files []string, err error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil, fmt.Errorf("read dir %q error: %v", dir, err)
 	}
 	for _, e := range entries {
 		if e.IsDir() && deep > 0 {
-			nestedFiles, err := FindFilesRecursive(filepath.Join(dir, e.Name()), deep-1)
+			if skipDir != nil && skipDir(e.Name()) {
+				continue
+			}
+			nestedFiles, err := findFiles(filepath.Join(dir, e.Name()), deep-1, suffix, skipDir)
 			if err != nil {
 				return nil, err
 			}
 			for _, nestedFile := range nestedFiles {
 				files = append(files, filepath.Join(e.Name(), nestedFile))
 			}
-		} else if !e.IsDir() && strings.HasSuffix(e.Name(), APIFileExtension) {
+		} else if !e.IsDir() && strings.HasSuffix(e.Name(), suffix) {
 			files = append(files, e.Name())
 		}
 	}
@@ -79,13 +103,17 @@ func ParseDefault() ([]File, error) {
 // File or an error if any occurs during searching or parsing.
 // The returned files will have Path field set to a path relative to apiDir.
 //
-// API files must have suffix `.api.json` and must be formatted as JSON.
+// API files must have suffix `.api.json` and must be formatted as JSON. If there
+// are no such files, the API source files with suffix `.api` are parsed instead.
 func ParseDir(apiDir string) ([]File, error) {
 	// prepare list of files to parse
 	list, err := FindFiles(apiDir)
 	if err != nil {
 		return nil, err
 	}
+	if len(list) == 0 {
+		return parseSourceDir(apiDir)
+	}
 
 	logf("found %d files in API dir %q", len(list), apiDir)
 
@@ -106,10 +134,17 @@ func ParseDir(apiDir string) ([]File, error) {
 
 // ParseFile parses API file and returns File or an error if any error occurs
 // during parsing. The retrurned file will have Path field set to apiFile.
+//
+// The API file can be either JSON file with suffix `.api.json` or API source
+// file with suffix `.api`, in which case the imported files are searched in
+// the directory of apiFile and its parent directories.
 func ParseFile(apiFile string) (*File, error) {
+	if strings.HasSuffix(apiFile, APISourceFileExtension) {
+		return ParseSourceFile(apiFile)
+	}
 	// check API file extension
 	if !strings.HasSuffix(apiFile, APIFileExtension) {
-		return nil, fmt.Errorf("unsupported file: %q, file must have suffix %q", apiFile, APIFileExtension)
+		return nil, fmt.Errorf("unsupported file: %q, file must have suffix %q or %q", apiFile, APIFileExtension, APISourceFileExtension)
 	}
 
 	content, err := os.ReadFile(apiFile)
@@ -149,3 +184,32 @@ func ParseRaw(content []byte) (file *File, err error) {
 
 	return file, nil
 }
+
+// ParseSourceFile parses API source file written in the VPP API language and
+// returns File or an error if any error occurs during parsing. The returned file
+// will have Path field set to apiFile and will contain types of imported files
+// the same way as the JSON files generated by vppapigen.
+func ParseSourceFile(apiFile string) (*File, error) {
+	return newApiSourceParser().parseFile(apiFile)
+}
+
+func parseSourceDir(apiDir string) ([]File, error) {
+	list, err := FindSourceFiles(apiDir)
+	if err != nil {
+		return nil, err
+	}
+
+	logf("found %d source files in API dir %q", len(list), apiDir)
+
+	parser := newApiSourceParser()
+	var files []File
+	for _, f := range list {
+		file, err := parser.parseFile(filepath.Join(apiDir, f))
+		if err != nil {
+			return nil, err
+		}
+		file.Path = f
+		files = append(files, *file)
+	}
+	return files, nil
+}
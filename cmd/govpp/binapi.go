@@ -0,0 +1,138 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+// The binapi packages register their messages, which are used for sending
+// the messages defined by VPP API files loaded at runtime.
+import (
+	_ "go.fd.io/govpp/binapi/abf"
+	_ "go.fd.io/govpp/binapi/acl"
+	_ "go.fd.io/govpp/binapi/adl"
+	_ "go.fd.io/govpp/binapi/af_packet"
+	_ "go.fd.io/govpp/binapi/af_xdp"
+	_ "go.fd.io/govpp/binapi/arp"
+	_ "go.fd.io/govpp/binapi/arping"
+	_ "go.fd.io/govpp/binapi/auto_sdl"
+	_ "go.fd.io/govpp/binapi/avf"
+	_ "go.fd.io/govpp/binapi/bfd"
+	_ "go.fd.io/govpp/binapi/bier"
+	_ "go.fd.io/govpp/binapi/bond"
+	_ "go.fd.io/govpp/binapi/bpf_trace_filter"
+	_ "go.fd.io/govpp/binapi/cdp"
+	_ "go.fd.io/govpp/binapi/classify"
+	_ "go.fd.io/govpp/binapi/cnat"
+	_ "go.fd.io/govpp/binapi/crypto"
+	_ "go.fd.io/govpp/binapi/crypto_sw_scheduler"
+	_ "go.fd.io/govpp/binapi/ct6"
+	_ "go.fd.io/govpp/binapi/det44"
+	_ "go.fd.io/govpp/binapi/dev"
+	_ "go.fd.io/govpp/binapi/dhcp"
+	_ "go.fd.io/govpp/binapi/dhcp6_ia_na_client_cp"
+	_ "go.fd.io/govpp/binapi/dhcp6_pd_client_cp"
+	_ "go.fd.io/govpp/binapi/dns"
+	_ "go.fd.io/govpp/binapi/dslite"
+	_ "go.fd.io/govpp/binapi/feature"
+	_ "go.fd.io/govpp/binapi/fib"
+	_ "go.fd.io/govpp/binapi/flow"
+	_ "go.fd.io/govpp/binapi/flowprobe"
+	_ "go.fd.io/govpp/binapi/geneve"
+	_ "go.fd.io/govpp/binapi/graph"
+	_ "go.fd.io/govpp/binapi/gre"
+	_ "go.fd.io/govpp/binapi/gso"
+	_ "go.fd.io/govpp/binapi/gtpu"
+	_ "go.fd.io/govpp/binapi/http_static"
+	_ "go.fd.io/govpp/binapi/idpf"
+	_ "go.fd.io/govpp/binapi/igmp"
+	_ "go.fd.io/govpp/binapi/ikev2"
+	_ "go.fd.io/govpp/binapi/interface"
+	_ "go.fd.io/govpp/binapi/ioam_cache"
+	_ "go.fd.io/govpp/binapi/ioam_export"
+	_ "go.fd.io/govpp/binapi/ioam_vxlan_gpe"
+	_ "go.fd.io/govpp/binapi/ip"
+	_ "go.fd.io/govpp/binapi/ip6_nd"
+	_ "go.fd.io/govpp/binapi/ip_neighbor"
+	_ "go.fd.io/govpp/binapi/ip_session_redirect"
+	_ "go.fd.io/govpp/binapi/ipfix_export"
+	_ "go.fd.io/govpp/binapi/ipip"
+	_ "go.fd.io/govpp/binapi/ipsec"
+	_ "go.fd.io/govpp/binapi/l2"
+	_ "go.fd.io/govpp/binapi/l2tp"
+	_ "go.fd.io/govpp/binapi/l3xc"
+	_ "go.fd.io/govpp/binapi/lacp"
+	_ "go.fd.io/govpp/binapi/lb"
+	_ "go.fd.io/govpp/binapi/lcp"
+	_ "go.fd.io/govpp/binapi/lisp"
+	_ "go.fd.io/govpp/binapi/lisp_gpe"
+	_ "go.fd.io/govpp/binapi/lldp"
+	_ "go.fd.io/govpp/binapi/mactime"
+	_ "go.fd.io/govpp/binapi/map"
+	_ "go.fd.io/govpp/binapi/mdata"
+	_ "go.fd.io/govpp/binapi/memclnt"
+	_ "go.fd.io/govpp/binapi/memif"
+	_ "go.fd.io/govpp/binapi/mpls"
+	_ "go.fd.io/govpp/binapi/mss_clamp"
+	_ "go.fd.io/govpp/binapi/nat44_ed"
+	_ "go.fd.io/govpp/binapi/nat44_ei"
+	_ "go.fd.io/govpp/binapi/nat64"
+	_ "go.fd.io/govpp/binapi/nat66"
+	_ "go.fd.io/govpp/binapi/npt66"
+	_ "go.fd.io/govpp/binapi/nsh"
+	_ "go.fd.io/govpp/binapi/nsim"
+	_ "go.fd.io/govpp/binapi/oddbuf"
+	_ "go.fd.io/govpp/binapi/one"
+	_ "go.fd.io/govpp/binapi/p2p_ethernet"
+	_ "go.fd.io/govpp/binapi/pg"
+	_ "go.fd.io/govpp/binapi/ping"
+	_ "go.fd.io/govpp/binapi/pipe"
+	_ "go.fd.io/govpp/binapi/pnat"
+	_ "go.fd.io/govpp/binapi/policer"
+	_ "go.fd.io/govpp/binapi/pot"
+	_ "go.fd.io/govpp/binapi/pppoe"
+	_ "go.fd.io/govpp/binapi/punt"
+	_ "go.fd.io/govpp/binapi/pvti"
+	_ "go.fd.io/govpp/binapi/qos"
+	_ "go.fd.io/govpp/binapi/rd_cp"
+	_ "go.fd.io/govpp/binapi/rdma"
+	_ "go.fd.io/govpp/binapi/session"
+	_ "go.fd.io/govpp/binapi/sflow"
+	_ "go.fd.io/govpp/binapi/span"
+	_ "go.fd.io/govpp/binapi/sr"
+	_ "go.fd.io/govpp/binapi/sr_mobile"
+	_ "go.fd.io/govpp/binapi/sr_mpls"
+	_ "go.fd.io/govpp/binapi/sr_pt"
+	_ "go.fd.io/govpp/binapi/stn"
+	_ "go.fd.io/govpp/binapi/svs"
+	_ "go.fd.io/govpp/binapi/syslog"
+	_ "go.fd.io/govpp/binapi/tapv2"
+	_ "go.fd.io/govpp/binapi/tcp"
+	_ "go.fd.io/govpp/binapi/teib"
+	_ "go.fd.io/govpp/binapi/tls_openssl"
+	_ "go.fd.io/govpp/binapi/trace"
+	_ "go.fd.io/govpp/binapi/tracedump"
+	_ "go.fd.io/govpp/binapi/tracenode"
+	_ "go.fd.io/govpp/binapi/udp"
+	_ "go.fd.io/govpp/binapi/udp_ping"
+	_ "go.fd.io/govpp/binapi/urpf"
+	_ "go.fd.io/govpp/binapi/vhost_user"
+	_ "go.fd.io/govpp/binapi/virtio"
+	_ "go.fd.io/govpp/binapi/vlib"
+	_ "go.fd.io/govpp/binapi/vmxnet3"
+	_ "go.fd.io/govpp/binapi/vpe"
+	_ "go.fd.io/govpp/binapi/vrrp"
+	_ "go.fd.io/govpp/binapi/vxlan"
+	_ "go.fd.io/govpp/binapi/vxlan_gpe"
+	_ "go.fd.io/govpp/binapi/vxlan_gpe_ioam_export"
+	_ "go.fd.io/govpp/binapi/wireguard"
+)
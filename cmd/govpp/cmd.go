@@ -72,6 +72,8 @@ func newRootCmd(cli Cli) *cobra.Command {
 		newCliCommand(cli),
 		newGenerateCmd(cli),
 		newHttpCmd(cli),
+		newGrpcCmd(cli),
+		newStatsCmd(cli),
 		newVppapiCmd(cli),
 	)
 
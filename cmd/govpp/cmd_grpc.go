@@ -0,0 +1,137 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"go.fd.io/govpp"
+	"go.fd.io/govpp/adapter/socketclient"
+	"go.fd.io/govpp/grpcbridge"
+)
+
+const (
+	DefaultGrpcServiceAddress = ":9000"
+)
+
+type GrpcCmdOptions struct {
+	Input      string
+	ApiSocket  string
+	Address    string
+	Reflection bool
+}
+
+func newGrpcCmd(Cli) *cobra.Command {
+	var opts = GrpcCmdOptions{
+		ApiSocket:  socketclient.DefaultSocketName,
+		Address:    DefaultGrpcServiceAddress,
+		Reflection: true,
+	}
+	cmd := &cobra.Command{
+		Use:   "grpc",
+		Short: "VPP API as gRPC service",
+		Long: "Serves VPP API via gRPC services, one for each VPP API file.\n" +
+			"The services are defined by the protobuf schema generated by the proto plugin.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGrpcCmd(cmd.Context(), opts)
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&opts.Input, "input", opts.Input, "Input for VPP API (e.g. path to VPP API directory, local VPP repo)")
+	cmd.PersistentFlags().StringVar(&opts.ApiSocket, "apisock", opts.ApiSocket, "Path to VPP API socket")
+	cmd.PersistentFlags().StringVar(&opts.Address, "addr", opts.Address, "gRPC service address")
+	cmd.PersistentFlags().BoolVar(&opts.Reflection, "reflection", opts.Reflection, "Enable gRPC server reflection")
+
+	return cmd
+}
+
+func runGrpcCmd(ctx context.Context, opts GrpcCmdOptions) error {
+	vppInput, err := resolveVppInput(opts.Input)
+	if err != nil {
+		return err
+	}
+
+	logrus.Debugf("connecting to VPP socket %s", opts.ApiSocket)
+
+	conn, err := govpp.Connect(opts.ApiSocket)
+	if err != nil {
+		return fmt.Errorf("govpp.Connect: %w", err)
+	}
+	defer conn.Disconnect()
+
+	bridge, err := grpcbridge.NewServer(conn, &vppInput.Schema)
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer()
+	bridge.Register(server)
+	if opts.Reflection {
+		reflectionpb.RegisterServerReflectionServer(server, reflection.NewServerV1(reflection.ServerOptions{
+			Services:           server,
+			DescriptorResolver: &grpcDescResolver{files: bridge.Files()},
+		}))
+	}
+
+	lis, err := net.Listen("tcp", opts.Address)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		logrus.Debugf("stopping gRPC server")
+		server.GracefulStop()
+	}()
+
+	logrus.Infof("gRPC server listening on: %v (%d services)", lis.Addr(), len(server.GetServiceInfo()))
+
+	return server.Serve(lis)
+}
+
+// grpcDescResolver resolves descriptors of VPP API services, and descriptors
+// of other services (e.g. reflection) from the global registry.
+type grpcDescResolver struct {
+	files *protoregistry.Files
+}
+
+func (r *grpcDescResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (r *grpcDescResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if desc, err := r.files.FindDescriptorByName(name); err == nil {
+		return desc, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
@@ -17,7 +17,6 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -27,8 +26,8 @@ import (
 
 	"go.fd.io/govpp"
 	"go.fd.io/govpp/adapter/socketclient"
-	"go.fd.io/govpp/binapi/vpe"
 	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/restgateway"
 )
 
 // TODO:
@@ -55,7 +54,10 @@ func newHttpCmd(Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "http",
 		Short: "VPP API as HTTP service",
-		Long:  "Serves VPP API via HTTP service",
+		Long: "Serves VPP API via HTTP service.\n" +
+			"Each request message is sent by POST to /vpp/<api>/<message> and the service is described by /openapi.json.\n" +
+			"The messages are sent as the binapi messages compiled into govpp, the messages of the input API files\n" +
+			"with different name or CRC are not supported and their requests fail with 501 Not Implemented.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runHttpCmd(opts)
 		},
@@ -80,16 +82,21 @@ func runHttpCmd(opts HttpCmdOptions) error {
 	if err != nil {
 		return fmt.Errorf("govpp.Connect: %w", err)
 	}
+	defer conn.Disconnect()
 
-	serveMux := http.NewServeMux()
+	gateway, err := restgateway.NewGateway(conn, &vppInput.Schema)
+	if err != nil {
+		return err
+	}
 
-	setupHttpAPIHandlers(vppInput.Schema.Files, serveMux)
+	if paths := gateway.Unregistered(); len(paths) > 0 {
+		logrus.Warnf("%d operations are not supported by the connection", len(paths))
+		logrus.Debugf("unsupported operations: %v", paths)
+	}
 
-	// TODO: register all api files automatically (requires some regisry for handlers or apifiles
-	c := vpe.HTTPHandler(vpe.NewServiceClient(conn))
-	//c := memclnt.HTTPHandler(memclnt.NewServiceClient(conn))
+	serveMux := http.NewServeMux()
 
-	serveMux.Handle("/", c)
+	setupHttpAPIHandlers(vppInput.Schema.Files, serveMux, gateway)
 
 	logrus.Infof("HTTP server listening on: %v", opts.Address)
 
@@ -100,18 +107,19 @@ func runHttpCmd(opts HttpCmdOptions) error {
 	return nil
 }
 
-func setupHttpAPIHandlers(apifiles []vppapi.File, mux *http.ServeMux) {
+func setupHttpAPIHandlers(apifiles []vppapi.File, mux *http.ServeMux, gateway *restgateway.Gateway) {
 	for _, apifile := range apifiles {
 		file := apifile
 		name := file.Name
 		mux.HandleFunc("/api/"+name, apiFileHandler(&file))
 		mux.HandleFunc("/raw/"+name, apiRawHandler(&file))
-		mux.HandleFunc("/vpp/"+name, reqHandler(&file))
+		mux.HandleFunc("/vpp/"+name+"/", reqHandler(&file, gateway))
 	}
 	mux.HandleFunc("/api", apiFilesHandler(apifiles))
+	mux.Handle(restgateway.SpecPath, gateway)
 }
 
-func reqHandler(apifile *vppapi.File) func(http.ResponseWriter, *http.Request) {
+func reqHandler(apifile *vppapi.File, gateway *restgateway.Gateway) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
 		// extract message name
 		msgName := strings.TrimPrefix(req.URL.Path, "/vpp/"+apifile.Name+"/")
@@ -135,22 +143,7 @@ func reqHandler(apifile *vppapi.File) func(http.ResponseWriter, *http.Request) {
 
 		switch req.Method {
 		case http.MethodPost:
-			reqData := make(map[string]interface{})
-
-			// parse body data
-			body, err := io.ReadAll(req.Body)
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
-				return
-			}
-			err = json.Unmarshal(body, &reqData)
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-
-			// TODO: unmarshal body data into message, send request to VPP, marshal it and send as response
-			http.Error(w, "Sending requests is not implemented yet", http.StatusNotImplemented)
+			gateway.ServeHTTP(w, req)
 		case http.MethodGet:
 			b, err := json.MarshalIndent(msg, "", "  ")
 			if err != nil {
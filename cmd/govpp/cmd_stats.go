@@ -0,0 +1,56 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+import (
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+
+	"go.fd.io/govpp/adapter/statsclient"
+)
+
+const exampleStats = `
+  <cyan># Serve VPP stats as Prometheus metrics</>
+  govpp stats serve
+  govpp stats serve --addr :9482 --include "^/if/" --include "^/err/"
+  govpp stats serve --exclude "^/sys/node/" --per-thread=false
+`
+
+type StatsCmdOptions struct {
+	StatsSocket string
+}
+
+func newStatsCmd(cli Cli) *cobra.Command {
+	var (
+		opts = StatsCmdOptions{
+			StatsSocket: statsclient.DefaultSocketName,
+		}
+	)
+	cmd := &cobra.Command{
+		Use:              "stats",
+		Short:            "Access VPP stats",
+		Long:             "Access VPP stats segment",
+		Example:          color.Sprint(exampleStats),
+		TraverseChildren: true,
+	}
+
+	cmd.PersistentFlags().StringVar(&opts.StatsSocket, "statsock", opts.StatsSocket, "Path to VPP stats socket")
+
+	cmd.AddCommand(
+		newStatsServeCmd(cli, &opts),
+	)
+
+	return cmd
+}
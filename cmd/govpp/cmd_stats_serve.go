@@ -0,0 +1,106 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"go.fd.io/govpp/adapter/statsclient"
+	"go.fd.io/govpp/statsexporter"
+)
+
+const (
+	DefaultStatsServeAddress = ":9482"
+	DefaultStatsMetricsPath  = "/metrics"
+)
+
+type StatsServeCmdOptions struct {
+	*StatsCmdOptions
+
+	Address   string
+	Path      string
+	Namespace string
+	Include   []string
+	Exclude   []string
+	PerThread bool
+}
+
+func newStatsServeCmd(_ Cli, statsOpts *StatsCmdOptions) *cobra.Command {
+	var (
+		opts = StatsServeCmdOptions{
+			StatsCmdOptions: statsOpts,
+			Address:         DefaultStatsServeAddress,
+			Path:            DefaultStatsMetricsPath,
+			Namespace:       statsexporter.DefaultNamespace,
+			PerThread:       true,
+		}
+	)
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve VPP stats as Prometheus metrics",
+		Long:  "Serves VPP stats as Prometheus metrics via HTTP service",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatsServeCmd(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Address, "addr", opts.Address, "HTTP service address")
+	cmd.Flags().StringVar(&opts.Path, "path", opts.Path, "HTTP path of the metrics")
+	cmd.Flags().StringVar(&opts.Namespace, "namespace", opts.Namespace, "Prefix of the metric names")
+	cmd.Flags().StringSliceVar(&opts.Include, "include", nil, "Regex pattern of the exported stats (all stats by default)")
+	cmd.Flags().StringSliceVar(&opts.Exclude, "exclude", nil, "Regex pattern of the stats excluded from export")
+	cmd.Flags().BoolVar(&opts.PerThread, "per-thread", opts.PerThread, "Export counters per thread with thread label")
+
+	return cmd
+}
+
+func runStatsServeCmd(opts StatsServeCmdOptions) error {
+	logrus.Debugf("connecting to VPP stats socket %s", opts.StatsSocket)
+
+	stats := statsclient.NewStatsClient(opts.StatsSocket)
+	if err := stats.Connect(); err != nil {
+		return fmt.Errorf("connecting to stats failed: %w", err)
+	}
+	defer stats.Disconnect()
+
+	collector, err := statsexporter.NewCollector(stats,
+		statsexporter.WithNamespace(opts.Namespace),
+		statsexporter.WithInclude(opts.Include...),
+		statsexporter.WithExclude(opts.Exclude...),
+		statsexporter.WithPerThread(opts.PerThread),
+	)
+	if err != nil {
+		return err
+	}
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		return err
+	}
+
+	serveMux := http.NewServeMux()
+	serveMux.Handle(opts.Path, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	logrus.Infof("serving VPP stats metrics on: %v%v", opts.Address, opts.Path)
+
+	return http.ListenAndServe(opts.Address, serveMux)
+}
@@ -17,6 +17,7 @@ package main
 import (
 	"archive/tar"
 	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -28,6 +29,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/openapi"
 )
 
 const exampleVppApiExportCmd = `
@@ -37,24 +39,42 @@ const exampleVppApiExportCmd = `
 
   <cyan># Export to archive</>
   govpp vppapi export [INPUT] --output vppapi.tar.gz
+
+  <cyan># Export JSON Schema for each API file to directory</>
+  govpp vppapi export [INPUT] --format jsonschema --output schemas
+
+  <cyan># Export OpenAPI document of REST mapping served by govpp http</>
+  govpp vppapi export [INPUT] --format openapi --output openapi.json
 `
 
+// export formats
+const (
+	exportFormatApi        = "api"
+	exportFormatJSONSchema = "jsonschema"
+	exportFormatOpenAPI    = "openapi"
+)
+
 type VppApiExportCmdOptions struct {
 	*VppApiCmdOptions
 
 	Output string
+	Format string
 	Targz  bool
 	Flat   bool // TODO: use this
 }
 
 func newVppApiExportCmd(cli Cli, vppapiOpts *VppApiCmdOptions) *cobra.Command {
 	var (
-		opts = VppApiExportCmdOptions{VppApiCmdOptions: vppapiOpts}
+		opts = VppApiExportCmdOptions{
+			VppApiCmdOptions: vppapiOpts,
+			Format:           exportFormatApi,
+		}
 	)
 	cmd := &cobra.Command{
-		Use:     "export [INPUT] --output OUTPUT [--targz] [--flat]",
-		Short:   "Export VPP API files",
-		Long:    "Export VPP API files from an input location to an output location.",
+		Use:   "export [INPUT] --output OUTPUT [--format FORMAT] [--targz] [--flat]",
+		Short: "Export VPP API files",
+		Long: "Export VPP API files from an input location to an output location.\n" +
+			"The files are exported as they are, or as JSON Schema documents, or as OpenAPI document.",
 		Example: color.Sprint(exampleVppApiExportCmd),
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,6 +89,7 @@ func newVppApiExportCmd(cli Cli, vppapiOpts *VppApiCmdOptions) *cobra.Command {
 		},
 	}
 
+	cmd.PersistentFlags().StringVar(&opts.Format, "format", opts.Format, "Export format [api/jsonschema/openapi]")
 	cmd.PersistentFlags().BoolVar(&opts.Flat, "flat", false, "Export using flat structure")
 	cmd.PersistentFlags().BoolVar(&opts.Targz, "targz", false, "Export to gzipped tarball archive")
 	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "", "Output directory for the exported files")
@@ -83,6 +104,20 @@ func runExportCmd(opts VppApiExportCmdOptions) error {
 		return err
 	}
 
+	switch opts.Format {
+	case exportFormatApi:
+	case exportFormatJSONSchema, exportFormatOpenAPI:
+		if opts.Targz {
+			return fmt.Errorf("export to archive is not supported for format %s", opts.Format)
+		}
+		if opts.Format == exportFormatJSONSchema {
+			return exportJSONSchemas(opts.Output, &vppInput.Schema)
+		}
+		return exportOpenAPI(opts.Output, &vppInput.Schema)
+	default:
+		return fmt.Errorf("unknown export format: %q", opts.Format)
+	}
+
 	// collect files from input
 	logrus.Tracef("preparing export from API dir: %s", vppInput.ApiDirectory)
 
@@ -128,6 +163,54 @@ func runExportCmd(opts VppApiExportCmdOptions) error {
 
 const exportVPPVersionFile = "VPP_VERSION"
 
+func exportJSONSchemas(outputDir string, schema *vppapi.Schema) error {
+	docs, err := openapi.JSONSchemas(schema)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0750); err != nil {
+		return fmt.Errorf("creating target dir failed: %w", err)
+	}
+	for name, doc := range docs {
+		filename := filepath.Join(outputDir, name+openapi.SchemaFilenameSuffix)
+		if err := writeJSONFile(filename, doc); err != nil {
+			return err
+		}
+		logrus.Tracef("- exported JSON Schema of %s to %s", name, filename)
+	}
+
+	logrus.Debugf("exported %d JSON Schema documents to: %s", len(docs), outputDir)
+
+	return nil
+}
+
+func exportOpenAPI(outputFile string, schema *vppapi.Schema) error {
+	doc, err := openapi.NewDocument(schema)
+	if err != nil {
+		return err
+	}
+
+	if err := writeJSONFile(outputFile, doc); err != nil {
+		return err
+	}
+
+	logrus.Debugf("exported OpenAPI document with %d paths to: %s", len(doc.Paths), outputFile)
+
+	return nil
+}
+
+func writeJSONFile(filename string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filename, append(data, '\n'), 0660); err != nil {
+		return fmt.Errorf("writing file failed: %w", err)
+	}
+	return nil
+}
+
 func exportVppInputToDir(outputDir string, files []string, vppInput *vppapi.VppInput) error {
 	logrus.Tracef("exporting %d files into directory: %s", len(files), outputDir)
 
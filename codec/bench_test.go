@@ -14,62 +14,256 @@
 
 package codec_test
 
-/*
 import (
+	"reflect"
 	"testing"
 
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/vlib"
+	"go.fd.io/govpp/binapi/vpe"
 	"go.fd.io/govpp/codec"
 )
 
 var Data []byte
 
-func BenchmarkEncodeNew(b *testing.B) {
-	m := NewTestAllMsg()
-	c := codec.DefaultCodec
+func benchMessages() map[string]api.Message {
+	return map[string]api.Message{
+		"ShowVersion": &vpe.ShowVersion{},
+		"SwInterfaceSetFlags": &interfaces.SwInterfaceSetFlags{
+			SwIfIndex: 1,
+			Flags:     interface_types.IF_STATUS_API_FLAG_ADMIN_UP,
+		},
+		"IPRouteAddDel": &ip.IPRouteAddDel{
+			IsAdd: true,
+			Route: ip.IPRoute{
+				Prefix: ip_types.NewPrefix(mustParseIPNet("10.10.0.0/24")),
+				NPaths: 1,
+				Paths: []fib_types.FibPath{{
+					SwIfIndex: 1,
+					Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+					Nh: fib_types.FibPathNh{
+						Address: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 10, 0, 1}),
+					},
+				}},
+			},
+		},
+		"CliInband": &vlib.CliInband{Cmd: "show version verbose"},
+	}
+}
+
+func BenchmarkEncodeMsg(b *testing.B) {
+	for name, msg := range benchMessages() {
+		b.Run(name, func(b *testing.B) {
+			c := codec.DefaultCodec
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				data, err := c.EncodeMsg(msg, 100)
+				if err != nil {
+					b.Fatal(err)
+				}
+				Data = data
+			}
+		})
+	}
+}
+
+func BenchmarkEncodeMsgTo(b *testing.B) {
+	for name, msg := range benchMessages() {
+		b.Run(name, func(b *testing.B) {
+			c := codec.DefaultCodec
+			buf := make([]byte, 0, 1024)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				data, err := c.EncodeMsgTo(buf, msg, 100)
+				if err != nil {
+					b.Fatal(err)
+				}
+				Data = data
+			}
+		})
+	}
+}
+
+func BenchmarkEncodeMsgToBuffer(b *testing.B) {
+	for name, msg := range benchMessages() {
+		b.Run(name, func(b *testing.B) {
+			c := codec.DefaultCodec
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf := codec.AcquireBuffer()
+				data, err := c.EncodeMsgToBuffer(buf, msg, 100)
+				if err != nil {
+					b.Fatal(err)
+				}
+				Data = data
+				codec.ReleaseBuffer(buf)
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeMsg(b *testing.B) {
+	for name, msg := range benchMessages() {
+		b.Run(name, func(b *testing.B) {
+			c := codec.DefaultCodec
+			data, err := c.EncodeMsg(msg, 100)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+				if err := c.DecodeMsg(data, m); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
 
-	var err error
-	var data []byte
+func BenchmarkDecodeMsgReuse(b *testing.B) {
+	for name, msg := range benchMessages() {
+		b.Run(name, func(b *testing.B) {
+			c := codec.DefaultCodec
+			data, err := c.EncodeMsg(msg, 100)
+			if err != nil {
+				b.Fatal(err)
+			}
+			m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := c.DecodeMsgWith(data, m, codec.ReuseSlices); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		data, err = c.EncodeMsg(m, 100)
+// BenchmarkDecodeMsgOptions compares decoding into the same message by the
+// generated methods with decoding by the plans used for the options.
+func BenchmarkDecodeMsgOptions(b *testing.B) {
+	options := []struct {
+		name    string
+		options []codec.DecodeOption
+	}{
+		{"Generated", nil},
+		{"ReuseSlices", []codec.DecodeOption{codec.ReuseSlices}},
+		{"Strict", []codec.DecodeOption{codec.Strict}},
+	}
+	for name, msg := range benchMessages() {
+		c := codec.DefaultCodec
+		data, err := c.EncodeMsg(msg, 100)
 		if err != nil {
-			b.Fatalf("expected nil error, got: %v", err)
+			b.Fatal(err)
+		}
+		for _, opt := range options {
+			b.Run(name+"/"+opt.name, func(b *testing.B) {
+				m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if err := c.DecodeMsgWith(data, m, opt.options...); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
 		}
 	}
-	Data = data
 }
 
-func BenchmarkEncodeWrapper(b *testing.B) {
-	m := codec.Wrapper{NewTestAllMsg()}
+func TestEncodeMsgToZeroAlloc(t *testing.T) {
 	c := codec.DefaultCodec
+	for name, msg := range benchMessages() {
+		buf := make([]byte, 0, 1024)
+		allocs := testing.AllocsPerRun(100, func() {
+			if _, err := c.EncodeMsgTo(buf, msg, 100); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%s: expected 0 allocations, got %v", name, allocs)
+		}
+	}
+}
 
-	var err error
-	var data []byte
+func TestEncodeMsgTo(t *testing.T) {
+	c := codec.DefaultCodec
+	buf := make([]byte, 1024)
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	for name, msg := range benchMessages() {
+		expected, err := c.EncodeMsg(msg, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := c.EncodeMsgTo(buf, msg, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != string(expected) {
+			t.Fatalf("%s: expected data:\n% 0X, got:\n% 0X", name, expected, data)
+		}
+		if &data[0] != &buf[0] {
+			t.Fatalf("%s: expected data encoded into the passed slice", name)
+		}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		data, err = c.EncodeMsg(m, 100)
+		pooled := codec.AcquireBuffer()
+		data, err = c.EncodeMsgToBuffer(pooled, msg, 100)
 		if err != nil {
-			b.Fatalf("expected nil error, got: %v", err)
+			t.Fatal(err)
 		}
+		if string(data) != string(expected) || string(pooled.Bytes()) != string(expected) {
+			t.Fatalf("%s: expected buffer data:\n% 0X, got:\n% 0X", name, expected, data)
+		}
+		codec.ReleaseBuffer(pooled)
 	}
-	Data = data
 }
 
-func BenchmarkEncodeHard(b *testing.B) {
-	m := NewTestAllMsg()
-
-	var err error
-	var data []byte
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		data, err = m.Marshal(nil)
+func TestDecodeMsgReuse(t *testing.T) {
+	c := codec.DefaultCodec
+	for name, msg := range benchMessages() {
+		data, err := c.EncodeMsg(msg, 100)
 		if err != nil {
-			b.Fatalf("expected nil error, got: %v", err)
+			t.Fatal(err)
+		}
+		m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+		for i := 0; i < 2; i++ {
+			if err := c.DecodeMsgWith(data, m, codec.ReuseSlices); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(m, msg) {
+				t.Fatalf("%s: expected decoded message:\n%+v\ngot:\n%+v", name, msg, m)
+			}
 		}
+		allocs := testing.AllocsPerRun(100, func() {
+			if err := c.DecodeMsgWith(data, m, codec.ReuseSlices); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("%s: expected 0 allocations when reusing, got %v", name, allocs)
+		}
+	}
+
+	// the capacity of slices is reused
+	details := &ip.IPRouteDetails{Route: ip.IPRoute{NPaths: 1, Paths: make([]fib_types.FibPath, 1)}}
+	data, err := c.EncodeMsg(details, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var reply ip.IPRouteDetails
+	reply.Route.Paths = make([]fib_types.FibPath, 3)
+	paths := reply.Route.Paths
+	if err := c.DecodeMsgWith(data, &reply, codec.ReuseSlices); err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.Route.Paths) != 1 || &reply.Route.Paths[0] != &paths[0] {
+		t.Fatalf("expected reused paths slice, got %+v", reply.Route.Paths)
 	}
-	Data = data
 }
-*/
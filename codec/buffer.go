@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"math"
+	"sync"
 )
 
 // Buffer provides buffer for encoding and decoding data on wire.
@@ -33,6 +34,25 @@ func NewBuffer(b []byte) *Buffer {
 	}
 }
 
+var bufferPool = sync.Pool{
+	New: func() any {
+		return &Buffer{buf: make([]byte, 0, 256)}
+	},
+}
+
+// AcquireBuffer returns an empty buffer from the pool. The buffer should
+// be returned using ReleaseBuffer once its data are no longer used.
+func AcquireBuffer() *Buffer {
+	return bufferPool.Get().(*Buffer)
+}
+
+// ReleaseBuffer returns the buffer to the pool.
+func ReleaseBuffer(b *Buffer) {
+	b.buf = b.buf[:0]
+	b.pos = 0
+	bufferPool.Put(b)
+}
+
 // Bytes returns buffer data up to current position.
 func (b *Buffer) Bytes() []byte {
 	return b.buf[:b.pos]
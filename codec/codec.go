@@ -36,9 +36,27 @@ func DecodeMsgContext(data []byte, msgType api.MessageType) (context uint32, err
 
 // MsgCodec provides encoding and decoding functionality of `api.Message` structs into/from
 // binary format as accepted by VPP.
-type MsgCodec struct{}
+type MsgCodec struct {
+	options DecodeOption
+}
+
+// NewMsgCodec returns a codec decoding the messages using the options by default.
+func NewMsgCodec(options ...DecodeOption) *MsgCodec {
+	c := new(MsgCodec)
+	for _, option := range options {
+		c.options |= option
+	}
+	return c
+}
+
+func (c *MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err error) {
+	return c.EncodeMsgTo(nil, msg, msgID)
+}
 
-func (*MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err error) {
+// EncodeMsgTo encodes the message into dst, reusing its capacity if large enough,
+// and returns the encoded data. The generated messages are encoded without
+// allocations into dst with sufficient capacity.
+func (*MsgCodec) EncodeMsgTo(dst []byte, msg api.Message, msgID uint16) (data []byte, err error) {
 	if msg == nil {
 		return nil, errors.New("nil message passed in")
 	}
@@ -62,12 +80,20 @@ func (*MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err erro
 	size := marshaller.Size()
 	offset := getOffset(msg)
 
+	// the encoding expects zeroed data, e.g. for padding of fixed strings
+	var b []byte
+	if cap(dst) >= size+offset {
+		b = dst[:size+offset]
+		clear(b)
+	} else {
+		b = make([]byte, size+offset)
+	}
+
 	// encode msg ID
-	b := make([]byte, size+offset)
 	b[0] = byte(msgID >> 8)
 	b[1] = byte(msgID)
 
-	data, err = marshaller.Marshal(b[offset:])
+	_, err = marshaller.Marshal(b[offset:])
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +101,59 @@ func (*MsgCodec) EncodeMsg(msg api.Message, msgID uint16) (data []byte, err erro
 	return b[0:len(b):len(b)], nil
 }
 
-func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
+// EncodeMsgToBuffer encodes the message into the buffer, reusing its data
+// from the previous encoding. The buffer contains the encoded data afterwards.
+// Use AcquireBuffer to get the buffer from the pool.
+func (c *MsgCodec) EncodeMsgToBuffer(buf *Buffer, msg api.Message, msgID uint16) ([]byte, error) {
+	data, err := c.EncodeMsgTo(buf.buf[:cap(buf.buf)], msg, msgID)
+	if err != nil {
+		return nil, err
+	}
+	buf.buf = data
+	buf.pos = len(data)
+	return data, nil
+}
+
+func (c *MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
+	return c.DecodeMsgWith(data, msg, c.options)
+}
+
+// DecodeOption is a flag modifying decoding of messages by DecodeMsgWith.
+type DecodeOption uint
+
+const (
+	// ReuseSlices makes the decoding reuse the capacity of slices already present
+	// in the message and keep the strings that did not change. The message is
+	// decoded using the cached plans instead of the generated methods then,
+	// which avoids the allocations but takes several times more time. It pays
+	// off when the allocations matter more than the CPU time, e.g. for the
+	// details with large arrays decoded into the same message repeatedly.
+	ReuseSlices DecodeOption = 1 << iota
+	// Strict makes the decoding check every read against the length of data
+	// and the counts of variable arrays against the remaining bytes, and reject
+	// trailing bytes. The malformed data are reported by *DecodeError. Like
+	// ReuseSlices, it decodes the message using the cached plans, which is
+	// slower than the generated methods.
+	Strict
+)
+
+type decodeOptions struct {
+	reuse  bool
+	strict bool
+}
+
+func applyDecodeOptions(flags DecodeOption, options []DecodeOption) decodeOptions {
+	for _, option := range options {
+		flags |= option
+	}
+	return decodeOptions{
+		reuse:  flags&ReuseSlices != 0,
+		strict: flags&Strict != 0,
+	}
+}
+
+// DecodeMsgWith decodes the message using the options in addition to the codec options.
+func (c *MsgCodec) DecodeMsgWith(data []byte, msg api.Message, options ...DecodeOption) (err error) {
 	if msg == nil {
 		return errors.New("nil message passed in")
 	}
@@ -91,13 +169,19 @@ func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
 		}
 	}()
 
+	opts := applyDecodeOptions(c.options, options)
+
+	offset := getOffset(msg)
+
+	if opts != (decodeOptions{}) {
+		return decodePlan(data, offset, msg, opts)
+	}
+
 	marshaller, ok := msg.(Unmarshaler)
 	if !ok {
 		marshaller = Wrapper{msg}
 	}
 
-	offset := getOffset(msg)
-
 	err = marshaller.Unmarshal(data[offset:])
 	if err != nil {
 		return err
@@ -106,6 +190,35 @@ func (*MsgCodec) DecodeMsg(data []byte, msg api.Message) (err error) {
 	return nil
 }
 
+// decodePlan decodes the message data after the header offset using the cached plan.
+func decodePlan(data []byte, offset int, msg api.Message, opts decodeOptions) error {
+	rv := messageValue(msg)
+	p, err := typePlan(rv.Type())
+	if err != nil {
+		return err
+	}
+	if opts.strict && len(data) < offset {
+		return &DecodeError{
+			Message: msg.GetMessageName(),
+			Offset:  len(data),
+			Err:     fmt.Errorf("%w: need %d bytes of header, got %d", ErrTruncated, offset, len(data)),
+		}
+	}
+	b := NewBuffer(data[offset:])
+	err = p.decode(b, rv, 0, opts)
+	if err == nil && opts.strict && b.pos < len(b.buf) {
+		err = &DecodeError{
+			Offset: b.pos,
+			Err:    fmt.Errorf("%w: %d bytes after the message", ErrTrailingBytes, len(b.buf)-b.pos),
+		}
+	}
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.Message = msg.GetMessageName()
+		decodeErr.Offset += offset
+	}
+	return err
+}
+
 func (*MsgCodec) DecodeMsgContext(data []byte, msgType api.MessageType) (context uint32, err error) {
 	switch msgType {
 	case api.RequestMessage:
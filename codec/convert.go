@@ -0,0 +1,59 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrOutOfRange is the error of value that does not fit the binapi type it
+// is converted to, e.g. from the wider types of other encodings.
+var ErrOutOfRange = errors.New("value out of range")
+
+// Integer is the constraint of the integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// ConvertInt sets dst to v converted to the integer type of dst. It fails
+// with ErrOutOfRange if v does not fit the type, dst is left unchanged.
+func ConvertInt[T, V Integer](dst *T, v V) error {
+	t := T(v)
+	if V(t) != v || (t < 0) != (v < 0) {
+		return fmt.Errorf("%w: %d does not fit %T", ErrOutOfRange, v, t)
+	}
+	*dst = t
+	return nil
+}
+
+// CopyBytes copies src to the fixed length array dst. It fails with
+// ErrOutOfRange if src is longer than dst, dst is left unchanged.
+func CopyBytes(dst, src []byte) error {
+	if err := CheckLength(len(src), len(dst)); err != nil {
+		return err
+	}
+	copy(dst, src)
+	return nil
+}
+
+// CheckLength fails with ErrOutOfRange if length n exceeds fixed length max.
+func CheckLength(n, max int) error {
+	if n > max {
+		return fmt.Errorf("%w: length %d exceeds fixed length %d", ErrOutOfRange, n, max)
+	}
+	return nil
+}
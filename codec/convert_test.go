@@ -0,0 +1,75 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/codec"
+)
+
+func TestConvertInt(t *testing.T) {
+	var u8 uint8
+	if err := codec.ConvertInt(&u8, uint32(255)); err != nil || u8 != 255 {
+		t.Fatalf("expected 255, got %d: %v", u8, err)
+	}
+	if err := codec.ConvertInt(&u8, uint32(256)); !errors.Is(err, codec.ErrOutOfRange) || u8 != 255 {
+		t.Fatalf("expected out of range error and unchanged value, got %d: %v", u8, err)
+	}
+	if err := codec.ConvertInt(&u8, int32(-1)); !errors.Is(err, codec.ErrOutOfRange) {
+		t.Fatalf("expected out of range error, got: %v", err)
+	}
+
+	var i8 int8
+	if err := codec.ConvertInt(&i8, int32(-128)); err != nil || i8 != -128 {
+		t.Fatalf("expected -128, got %d: %v", i8, err)
+	}
+	if err := codec.ConvertInt(&i8, int32(128)); !errors.Is(err, codec.ErrOutOfRange) {
+		t.Fatalf("expected out of range error, got: %v", err)
+	}
+
+	var u32 uint32
+	if err := codec.ConvertInt(&u32, int32(-1)); !errors.Is(err, codec.ErrOutOfRange) {
+		t.Fatalf("expected out of range error, got: %v", err)
+	}
+	var i64 int64
+	if err := codec.ConvertInt(&i64, uint64(math.MaxUint64)); !errors.Is(err, codec.ErrOutOfRange) {
+		t.Fatalf("expected out of range error, got: %v", err)
+	}
+
+	var af ip_types.AddressFamily
+	if err := codec.ConvertInt(&af, int32(ip_types.ADDRESS_IP6)); err != nil || af != ip_types.ADDRESS_IP6 {
+		t.Fatalf("expected %v, got %v: %v", ip_types.ADDRESS_IP6, af, err)
+	}
+	if err := codec.ConvertInt(&af, int32(1000)); err == nil || err.Error() != "value out of range: 1000 does not fit ip_types.AddressFamily" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCopyBytes(t *testing.T) {
+	var addr ip_types.IP4Address
+	if err := codec.CopyBytes(addr[:], []byte{10, 0, 0, 1}); err != nil || addr != (ip_types.IP4Address{10, 0, 0, 1}) {
+		t.Fatalf("expected 10.0.0.1, got %v: %v", addr, err)
+	}
+	if err := codec.CopyBytes(addr[:], []byte{1, 2}); err != nil || addr != (ip_types.IP4Address{1, 2, 0, 1}) {
+		t.Fatalf("expected 1.2.0.1, got %v: %v", addr, err)
+	}
+	if err := codec.CopyBytes(addr[:], make([]byte, 5)); !errors.Is(err, codec.ErrOutOfRange) || addr != (ip_types.IP4Address{1, 2, 0, 1}) {
+		t.Fatalf("expected out of range error and unchanged value, got %v: %v", addr, err)
+	}
+}
@@ -0,0 +1,283 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.fd.io/govpp/api"
+)
+
+// DefaultFormatter is the formatter used by FormatMsg.
+var DefaultFormatter = new(Formatter)
+
+// FormatMsg renders the message as an indented tree using the DefaultFormatter.
+func FormatMsg(msg api.Message) string {
+	return DefaultFormatter.Format(msg)
+}
+
+// Formatter renders messages in human-readable form as indented trees of
+// fields using the VPP field names. The addresses, prefixes, enums and flags
+// are shown symbolically, the same way as by JSONCodec.
+//
+//	ip_route_add_del
+//	  is_add: true
+//	  route:
+//	    prefix: 10.10.0.0/24
+//	    paths:
+//	      [0]:
+//	        sw_if_index: 1
+//	        proto: FIB_API_PATH_NH_PROTO_IP4
+type Formatter struct {
+	// Indent is the indentation of the nested fields, two spaces if empty.
+	Indent string
+	// OmitZero omits the fields with zero values and the trailing zero items of arrays.
+	OmitZero bool
+}
+
+// Format returns the message rendered as an indented tree.
+func (f *Formatter) Format(msg api.Message) (s string) {
+	if msg == nil {
+		return "<nil>"
+	}
+	rv, ok := structValue(msg)
+	if !ok {
+		return fmt.Sprintf("%s <invalid %T>", msg.GetMessageName(), msg)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			s = fmt.Sprintf("%s <%v>", msg.GetMessageName(), r)
+		}
+	}()
+	var sb strings.Builder
+	sb.WriteString(msg.GetMessageName())
+	f.writeFields(&sb, f.value(rv, nil).(*object), 1)
+	return sb.String()
+}
+
+// value converts the value to the tree of values like toValue,
+// omitting the zero fields if configured.
+func (f *Formatter) value(rv reflect.Value, fields []field) any {
+	if !f.OmitZero || hasCustomValue(rv.Type()) {
+		return toValue(rv, fields)
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		if isUnion(rv.Type()) {
+			break
+		}
+		obj := new(object)
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, skip := fieldName(t.Field(i))
+			if skip || rv.Field(i).IsZero() {
+				continue
+			}
+			fields := append(fields[:len(fields):len(fields)], field{rv, i})
+			if isUnion(t.Field(i).Type) {
+				obj.add(name, unionToValue(rv.Field(i), fields))
+				continue
+			}
+			obj.add(name, f.value(rv.Field(i), fields))
+		}
+		return obj
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 && !hasCustomValue(rv.Type().Elem()) {
+			break
+		}
+		n := rv.Len()
+		for n > 0 && rv.Index(n-1).IsZero() {
+			n--
+		}
+		list := make([]any, n)
+		for i := range list {
+			list[i] = f.value(rv.Index(i), fields)
+		}
+		return list
+	}
+	return toValue(rv, fields)
+}
+
+func (f *Formatter) indent(sb *strings.Builder, depth int) {
+	indent := f.Indent
+	if indent == "" {
+		indent = "  "
+	}
+	sb.WriteByte('\n')
+	for i := 0; i < depth; i++ {
+		sb.WriteString(indent)
+	}
+}
+
+func (f *Formatter) writeFields(sb *strings.Builder, obj *object, depth int) {
+	for i, key := range obj.keys {
+		f.writeValue(sb, key, obj.values[i], depth)
+	}
+}
+
+func (f *Formatter) writeValue(sb *strings.Builder, key string, v any, depth int) {
+	f.indent(sb, depth)
+	sb.WriteString(key)
+	sb.WriteByte(':')
+	switch x := v.(type) {
+	case *object:
+		if len(x.keys) == 0 {
+			sb.WriteString(" {}")
+			return
+		}
+		f.writeFields(sb, x, depth+1)
+	case []any:
+		if isScalarList(x) {
+			sb.WriteByte(' ')
+			sb.WriteString(formatInline(x))
+			return
+		}
+		for i, item := range x {
+			f.writeValue(sb, "["+strconv.Itoa(i)+"]", item, depth+1)
+		}
+	default:
+		sb.WriteByte(' ')
+		sb.WriteString(formatInline(x))
+	}
+}
+
+func isScalarList(list []any) bool {
+	for _, item := range list {
+		switch item.(type) {
+		case *object, []any:
+			return false
+		}
+	}
+	return true
+}
+
+// formatInline renders the value on a single line.
+func formatInline(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "<nil>"
+	case *object:
+		parts := make([]string, len(x.keys))
+		for i, key := range x.keys {
+			parts[i] = key + ": " + formatInline(x.values[i])
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case []any:
+		parts := make([]string, len(x))
+		for i, item := range x {
+			parts[i] = formatInline(item)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case string:
+		if x == "" || strings.TrimSpace(x) != x || strings.ContainsAny(x, "\"{}[],") || strconv.Quote(x) != `"`+x+`"` {
+			return strconv.Quote(x)
+		}
+		return x
+	case json.Number:
+		return string(x)
+	}
+	return fmt.Sprint(v)
+}
+
+// FieldDiff describes the field which differs between two messages.
+type FieldDiff struct {
+	// Path is the path of the field using VPP field names, e.g. "route.paths[0].weight",
+	// it is empty if the messages are of different types.
+	Path string
+	// A is the value of the field in the first message, or "<none>" if missing.
+	A string
+	// B is the value of the field in the second message, or "<none>" if missing.
+	B string
+}
+
+func (d FieldDiff) String() string {
+	path := d.Path
+	if path == "" {
+		path = "message"
+	}
+	return fmt.Sprintf("%s: %s -> %s", path, d.A, d.B)
+}
+
+// Diffs is the list of fields differing between two messages.
+type Diffs []FieldDiff
+
+// String returns the differences, one per line.
+func (d Diffs) String() string {
+	lines := make([]string, len(d))
+	for i, diff := range d {
+		lines[i] = diff.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+const noValue = "<none>"
+
+// Diff compares the messages field by field and returns the paths of the fields
+// with different values in the order of the fields. Different messages differ
+// as a whole.
+func Diff(a, b api.Message) Diffs {
+	if a == nil && b == nil {
+		return nil
+	}
+	va, errA := messageToValue(a)
+	vb, errB := messageToValue(b)
+	if errA != nil || errB != nil || reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return Diffs{{A: messageSummary(a, va), B: messageSummary(b, vb)}}
+	}
+	var diffs Diffs
+	diffValues("", va, vb, &diffs)
+	return diffs
+}
+
+func messageSummary(msg api.Message, v any) string {
+	if msg == nil || v == nil {
+		return "<nil>"
+	}
+	return msg.GetMessageName() + " " + formatInline(v)
+}
+
+func diffValues(path string, a, b any, diffs *Diffs) {
+	switch x := a.(type) {
+	case *object:
+		if y, ok := b.(*object); ok && reflect.DeepEqual(x.keys, y.keys) {
+			for i, key := range x.keys {
+				diffValues(joinPath(path, key), x.values[i], y.values[i], diffs)
+			}
+			return
+		}
+	case []any:
+		if y, ok := b.([]any); ok {
+			for i := 0; i < len(x) || i < len(y); i++ {
+				itemPath := path + "[" + strconv.Itoa(i) + "]"
+				switch {
+				case i >= len(x):
+					*diffs = append(*diffs, FieldDiff{Path: itemPath, A: noValue, B: formatInline(y[i])})
+				case i >= len(y):
+					*diffs = append(*diffs, FieldDiff{Path: itemPath, A: formatInline(x[i]), B: noValue})
+				default:
+					diffValues(itemPath, x[i], y[i], diffs)
+				}
+			}
+			return
+		}
+	}
+	if sa, sb := formatInline(a), formatInline(b); sa != sb {
+		*diffs = append(*diffs, FieldDiff{Path: path, A: sa, B: sb})
+	}
+}
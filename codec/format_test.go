@@ -0,0 +1,112 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"testing"
+
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/vpe"
+	"go.fd.io/govpp/codec"
+)
+
+func formatTestRoute() *ip.IPRouteAddDel {
+	return &ip.IPRouteAddDel{
+		IsAdd: true,
+		Route: ip.IPRoute{
+			Prefix: ip_types.NewPrefix(mustParseIPNet("10.10.0.0/24")),
+			NPaths: 1,
+			Paths: []fib_types.FibPath{{
+				SwIfIndex: 1,
+				Weight:    1,
+				Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+				Nh: fib_types.FibPathNh{
+					Address: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 10, 0, 1}),
+				},
+			}},
+		},
+	}
+}
+
+func TestFormatMsg(t *testing.T) {
+	formatter := &codec.Formatter{OmitZero: true}
+	out := formatter.Format(formatTestRoute())
+	expected := `ip_route_add_del
+  is_add: true
+  route:
+    prefix: 10.10.0.0/24
+    paths:
+      [0]:
+        sw_if_index: 1
+        weight: 1
+        nh:
+          address:
+            ip4: 10.10.0.1`
+	if out != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+
+	out = codec.FormatMsg(&interfaces.SwInterfaceSetFlags{
+		SwIfIndex: 2,
+		Flags:     interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP,
+	})
+	expected = `sw_interface_set_flags
+  sw_if_index: 2
+  flags: IF_STATUS_API_FLAG_ADMIN_UP|IF_STATUS_API_FLAG_LINK_UP`
+	if out != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+
+	out = codec.FormatMsg(&vpe.ShowVersionReply{Version: "24.02", BuildDate: ""})
+	expected = `show_version_reply
+  retval: 0
+  program: ""
+  version: 24.02
+  build_date: ""
+  build_directory: ""`
+	if out != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	a := formatTestRoute()
+	if diffs := codec.Diff(a, formatTestRoute()); len(diffs) != 0 {
+		t.Fatalf("expected no differences, got:\n%v", diffs)
+	}
+
+	b := formatTestRoute()
+	b.IsAdd = false
+	b.Route.Paths[0].Nh.Address = ip_types.AddressUnionIP4(ip_types.IP4Address{10, 10, 0, 2})
+	b.Route.Paths = append(b.Route.Paths, fib_types.FibPath{SwIfIndex: 2})
+	b.Route.NPaths = 2
+
+	diffs := codec.Diff(a, b)
+	expected := `is_add: true -> false
+route.paths[0].nh.address.ip4: 10.10.0.1 -> 10.10.0.2
+route.paths[1]: <none> -> {sw_if_index: 2, table_id: 0, rpf_id: 0, weight: 0, preference: 0, type: FIB_API_PATH_TYPE_NORMAL, flags: FIB_API_PATH_FLAG_NONE, proto: FIB_API_PATH_NH_PROTO_IP4, nh: {address: {ip4: 0.0.0.0}, via_label: 0, obj_id: 0, classify_table_index: 0}, n_labels: 0, label_stack: [{is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}, {is_uniform: 0, label: 0, ttl: 0, exp: 0}]}`
+	if diffs.String() != expected {
+		t.Fatalf("unexpected differences:\n%v\nexpected:\n%s", diffs, expected)
+	}
+
+	diffs = codec.Diff(a, &vpe.ShowVersion{})
+	if len(diffs) != 1 || diffs[0].Path != "" || diffs[0].B != "show_version {}" {
+		t.Fatalf("unexpected differences of different messages:\n%v", diffs)
+	}
+}
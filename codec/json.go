@@ -0,0 +1,79 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"go.fd.io/govpp/api"
+)
+
+// DefaultJSONCodec is the JSON codec used by EncodeJSON and DecodeJSON.
+var DefaultJSONCodec = new(JSONCodec)
+
+// EncodeJSON encodes the message into JSON using the DefaultJSONCodec.
+func EncodeJSON(msg api.Message) ([]byte, error) {
+	return DefaultJSONCodec.EncodeMsg(msg)
+}
+
+// DecodeJSON decodes the message from JSON using the DefaultJSONCodec.
+func DecodeJSON(data []byte, msg api.Message) error {
+	return DefaultJSONCodec.DecodeMsg(data, msg)
+}
+
+// JSONCodec provides encoding and decoding of `api.Message` structs into/from
+// JSON. The fields use VPP field names in the order defined by the API, enums
+// and flags are encoded as symbolic names, addresses and prefixes as strings
+// and unions as objects with the active member. The decoded message encodes
+// into the same binary form as the original message.
+type JSONCodec struct {
+	// Indent is used to indent the nested fields, the JSON is compact if empty.
+	Indent string
+}
+
+func (c *JSONCodec) EncodeMsg(msg api.Message) ([]byte, error) {
+	v, err := messageToValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if c.Indent == "" {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", c.Indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *JSONCodec) DecodeMsg(data []byte, msg api.Message) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("unexpected data after JSON value")
+	}
+	return valueToMessage(v, msg)
+}
@@ -0,0 +1,222 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ethernet_types"
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/interface_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/lisp"
+	"go.fd.io/govpp/binapi/lisp_types"
+	"go.fd.io/govpp/binapi/punt"
+	"go.fd.io/govpp/codec"
+)
+
+func textTestMessages() map[string]api.Message {
+	return map[string]api.Message{
+		"route": &ip.IPRouteAddDel{
+			IsAdd: true,
+			Route: ip.IPRoute{
+				TableID: 2,
+				Prefix:  ip_types.NewPrefix(mustParseIPNet("10.1.0.0/16")),
+				Paths: []fib_types.FibPath{{
+					SwIfIndex: 1,
+					Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+					Flags:     fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_HOST | fib_types.FIB_API_PATH_FLAG_POP_PW_CW,
+					Nh: fib_types.FibPathNh{
+						Address: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1}),
+					},
+				}},
+			},
+		},
+		"interface": &interfaces.SwInterfaceDetails{
+			SwIfIndex:     3,
+			L2Address:     ethernet_types.MacAddress{0x02, 0xfe, 0, 0, 0, 1},
+			Flags:         interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP,
+			Type:          interface_types.IF_API_TYPE_HARDWARE,
+			Mtu:           []uint32{9000, 0, 0, 0},
+			InterfaceName: "eth0",
+		},
+		"punt": &punt.SetPunt{
+			IsAdd: true,
+			Punt: punt.Punt{
+				Type: punt.PUNT_API_TYPE_L4,
+				Punt: punt.PuntUnionL4(punt.PuntL4{
+					Af:       ip_types.ADDRESS_IP6,
+					Protocol: ip_types.IP_API_PROTO_UDP,
+					Port:     4789,
+				}),
+			},
+		},
+		"eid": &lisp.LispAddDelLocalEid{
+			IsAdd: true,
+			Eid: lisp_types.Eid{
+				Type:    lisp_types.EID_TYPE_API_MAC,
+				Address: lisp_types.EidAddressUnionMac(ethernet_types.MacAddress{1, 2, 3, 4, 5, 6}),
+			},
+			LocatorSetName: "ls1",
+			Key: lisp_types.HmacKey{
+				ID:  lisp_types.KEY_ID_API_HMAC_SHA_1_96,
+				Key: []byte{0xca, 0xfe},
+			},
+		},
+		"cli": &CliInbandReply{
+			Retval: -3,
+			Reply:  "show version",
+		},
+	}
+}
+
+func TestJSONCodec(t *testing.T) {
+	RegisterTestingT(t)
+
+	data, err := codec.EncodeJSON(textTestMessages()["route"])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(Equal(`{"is_add":true,"is_multipath":false,"route":{"table_id":2,"stats_index":0,` +
+		`"prefix":"10.1.0.0/16","paths":[{"sw_if_index":1,"table_id":0,"rpf_id":0,"weight":0,"preference":0,` +
+		`"type":"FIB_API_PATH_TYPE_NORMAL","flags":"FIB_API_PATH_FLAG_RESOLVE_VIA_HOST|FIB_API_PATH_FLAG_POP_PW_CW",` +
+		`"proto":"FIB_API_PATH_NH_PROTO_IP4","nh":{"address":{"ip4":"10.0.0.1"},"via_label":0,"obj_id":0,` +
+		`"classify_table_index":0},"n_labels":0,"label_stack":[` + zeroLabels(16) + `]}]}}`))
+
+	data, err = codec.EncodeJSON(textTestMessages()["interface"])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(ContainSubstring(`"l2_address":"02:fe:00:00:00:01"`))
+	Expect(string(data)).To(ContainSubstring(`"flags":"IF_STATUS_API_FLAG_ADMIN_UP|IF_STATUS_API_FLAG_LINK_UP"`))
+	Expect(string(data)).To(ContainSubstring(`"mtu":[9000,0,0,0]`))
+
+	data, err = codec.EncodeJSON(textTestMessages()["punt"])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(Equal(`{"is_add":true,"punt":{"type":"PUNT_API_TYPE_L4",` +
+		`"punt":{"l4":{"af":"ADDRESS_IP6","protocol":"IP_API_PROTO_UDP","port":4789}}}}`))
+}
+
+func zeroLabels(n int) string {
+	s := ""
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			s += ","
+		}
+		s += `{"is_uniform":0,"label":0,"ttl":0,"exp":0}`
+	}
+	return s
+}
+
+func TestTextCodecsLossless(t *testing.T) {
+	codecs := map[string]struct {
+		encode func(api.Message) ([]byte, error)
+		decode func([]byte, api.Message) error
+	}{
+		"json":        {codec.EncodeJSON, codec.DecodeJSON},
+		"json indent": {(&codec.JSONCodec{Indent: "  "}).EncodeMsg, (&codec.JSONCodec{}).DecodeMsg},
+		"yaml":        {codec.EncodeYAML, codec.DecodeYAML},
+	}
+	for codecName, c := range codecs {
+		for msgName, msg := range textTestMessages() {
+			t.Run(codecName+"/"+msgName, func(t *testing.T) {
+				RegisterTestingT(t)
+
+				data, err := c.encode(msg)
+				Expect(err).ToNot(HaveOccurred())
+
+				out := newMessage(msg)
+				Expect(c.decode(data, out)).To(Succeed(), string(data))
+
+				expected, err := codec.EncodeMsg(msg, 100)
+				Expect(err).ToNot(HaveOccurred())
+				actual, err := codec.EncodeMsg(out, 100)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(Equal(expected), string(data))
+			})
+		}
+	}
+}
+
+func TestTextCodecsFallback(t *testing.T) {
+	RegisterTestingT(t)
+
+	// unknown enum value and union without matching member
+	msg := &lisp.LispAddDelLocalEid{
+		Eid: lisp_types.Eid{
+			Type:    lisp_types.EidType(7),
+			Address: lisp_types.EidAddressUnionMac(ethernet_types.MacAddress{1, 2, 3, 4, 5, 6}),
+		},
+	}
+	data, err := codec.EncodeJSON(msg)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(ContainSubstring(`"eid":{"type":7,"address":"010203040506` + zeroHex(12) + `"}`))
+
+	out := new(lisp.LispAddDelLocalEid)
+	Expect(codec.DecodeJSON(data, out)).To(Succeed())
+	Expect(out).To(Equal(msg))
+}
+
+func TestTextCodecsDecodeErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	route := new(ip.IPRouteAddDel)
+	err := codec.DecodeJSON([]byte(`{"route":{"paths":[{"proto":"FIB_API_PATH_NH_PROTO_IPX"}]}}`), route)
+	Expect(err).To(MatchError(ContainSubstring("route.paths[0].proto")))
+
+	err = codec.DecodeJSON([]byte(`{"is_add":true,"unknown":1}`), route)
+	Expect(err).To(MatchError(ContainSubstring("field unknown: unknown field")))
+
+	err = codec.DecodeYAML([]byte("route:\n  table_id: -1\n"), route)
+	Expect(err).To(MatchError(ContainSubstring("route.table_id")))
+
+	// flags can be given as names or numbers
+	details := new(interfaces.SwInterfaceDetails)
+	Expect(codec.DecodeYAML([]byte("flags: IF_STATUS_API_FLAG_LINK_UP | IF_STATUS_API_FLAG_ADMIN_UP\nsub_if_flags: 3\n"), details)).To(Succeed())
+	Expect(details.Flags).To(Equal(interface_types.IF_STATUS_API_FLAG_ADMIN_UP | interface_types.IF_STATUS_API_FLAG_LINK_UP))
+	Expect(details.SubIfFlags).To(BeEquivalentTo(3))
+}
+
+func TestYAMLCodec(t *testing.T) {
+	RegisterTestingT(t)
+
+	data, err := codec.EncodeYAML(textTestMessages()["eid"])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(HavePrefix("is_add: true\neid:\n    type: EID_TYPE_API_MAC\n    address:\n        mac: 01:02:03:04:05:06\n"))
+
+	data, err = codec.EncodeYAML(&CliInbandReply{Reply: "123"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(Equal("Retval: 0\nReply: \"123\"\n"))
+}
+
+func newMessage(msg api.Message) api.Message {
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+}
+
+func zeroHex(n int) string {
+	return strings.Repeat("00", n)
+}
+
+func mustParseIPNet(s string) net.IPNet {
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return *ipNet
+}
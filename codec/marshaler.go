@@ -16,6 +16,7 @@ package codec
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 
 	"github.com/lunixbochs/struc"
@@ -36,22 +37,95 @@ type Unmarshaler interface {
 	Unmarshal([]byte) error
 }
 
+// ValueMessage is implemented by the messages without generated types, whose
+// fields are held by a value of struct type built at runtime, e.g. by
+// reflect.StructOf from the API definitions. The value is encoded the same
+// way as the fields of the messages without generated methods.
+type ValueMessage interface {
+	api.Message
+	// MessageValue returns the addressable struct value with the fields.
+	MessageValue() reflect.Value
+}
+
+// messageValue returns the struct value with the fields of the message.
+func messageValue(msg api.Message) reflect.Value {
+	if m, ok := msg.(ValueMessage); ok {
+		return m.MessageValue()
+	}
+	return reflect.ValueOf(msg).Elem()
+}
+
+// structValue returns the struct value with the fields of the message,
+// or false if the message is not a pointer to struct.
+func structValue(msg api.Message) (reflect.Value, bool) {
+	if m, ok := msg.(ValueMessage); ok {
+		rv := m.MessageValue()
+		return rv, rv.Kind() == reflect.Struct
+	}
+	rv := reflect.ValueOf(msg)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	return rv.Elem(), true
+}
+
+// Wrapper provides encoding of the messages without generated methods. The
+// messages are encoded using the plans compiled once for each message type,
+// falling back to struc for the types not supported by the plans.
 type Wrapper struct {
 	api.Message
 }
 
 func (w Wrapper) Size() int {
-	if size, err := struc.Sizeof(w.Message); err != nil {
+	rv := messageValue(w.Message)
+	if p, err := typePlan(rv.Type()); err == nil {
+		return p.size(rv)
+	}
+	if size, err := struc.Sizeof(rv.Addr().Interface()); err != nil {
 		return 0
 	} else {
 		return size
 	}
 }
 
-func (w Wrapper) Marshal(b []byte) ([]byte, error) {
+func (w Wrapper) Marshal(b []byte) (data []byte, err error) {
+	rv := messageValue(w.Message)
+	p, err := typePlan(rv.Type())
+	if err != nil {
+		return w.marshalStruc(b)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("encoding %s failed: %v", w.GetMessageName(), r)
+		}
+	}()
+	size := p.size(rv)
+	if len(b) < size {
+		b = make([]byte, size)
+	}
+	buf := NewBuffer(b[:size])
+	p.encode(buf, rv)
+	return buf.Bytes(), nil
+}
+
+func (w Wrapper) Unmarshal(data []byte) (err error) {
+	rv := messageValue(w.Message)
+	p, err := typePlan(rv.Type())
+	if err != nil {
+		return w.unmarshalStruc(data)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("decoding %s failed: %v", w.GetMessageName(), r)
+		}
+	}()
+	return p.decode(NewBuffer(data), rv, 0, decodeOptions{})
+}
+
+func (w Wrapper) marshalStruc(b []byte) ([]byte, error) {
 	buf := new(bytes.Buffer)
-	if reflect.TypeOf(w.Message).Elem().NumField() > 0 {
-		if err := struc.Pack(buf, w.Message); err != nil {
+	if rv := messageValue(w.Message); rv.NumField() > 0 {
+		if err := struc.Pack(buf, rv.Addr().Interface()); err != nil {
 			return nil, err
 		}
 	}
@@ -61,9 +135,9 @@ func (w Wrapper) Marshal(b []byte) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func (w Wrapper) Unmarshal(data []byte) error {
+func (w Wrapper) unmarshalStruc(data []byte) error {
 	buf := bytes.NewReader(data)
-	if err := struc.Unpack(buf, w.Message); err != nil {
+	if err := struc.Unpack(buf, messageValue(w.Message).Addr().Interface()); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,671 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// This file implements encoding of the messages without generated methods.
+// The encoding plan is compiled once for each message type using reflection
+// and reused for every message of the type.
+//
+// The fields with binapi tag are encoded the same way as by the generated
+// methods:
+//   - strings with length (string[64]) have fixed size, the other strings
+//     are prefixed with their length,
+//   - arrays with length (u32[4]) have fixed size, the arrays sized from
+//     another field (fib_path[n_paths]) have variable size,
+//   - the fields referred by the sized arrays are encoded as array length.
+//
+// The fields without binapi tag are encoded the same way as by struc,
+// supporting the fixed length ([16]byte), sizeof and sizefrom options.
+// The types which cannot be encoded by the plan fall back to struc.
+
+type planKind uint8
+
+const (
+	planBool planKind = iota
+	planInt8
+	planInt16
+	planInt32
+	planInt64
+	planUint8
+	planUint16
+	planUint32
+	planUint64
+	planFloat64
+	planString
+	planBytes
+	planArray
+	planStruct
+)
+
+// plan describes encoding of a value of single type.
+type plan struct {
+	kind planKind
+	// length is fixed length of strings and arrays, zero if variable
+	length int
+	// prefixed is true for variable strings prefixed with their length
+	prefixed bool
+	// bigEndian is true for floats encoded by struc
+	bigEndian bool
+	// strict is true if the values longer than fixed length are rejected
+	strict bool
+	// elem is the plan of array elements
+	elem *plan
+	// fields are the plans of struct fields
+	fields []fieldPlan
+}
+
+// fieldPlan describes encoding of a struct field.
+type fieldPlan struct {
+	index int
+	name  string
+	// path is the name of the field used in decoding errors
+	path string
+	plan *plan
+	// limit is the maximum length of the value, zero if unlimited
+	limit int
+	// sizeFrom is index of the field with number of items, -1 if none
+	sizeFrom int
+	// sizeOf is index of the field whose length is encoded, -1 if none
+	sizeOf int
+}
+
+var plansCache sync.Map // map[reflect.Type]*plan or error
+
+// typePlan returns the cached encoding plan for the struct type.
+func typePlan(t reflect.Type) (*plan, error) {
+	if p, ok := plansCache.Load(t); ok {
+		if err, isErr := p.(error); isErr {
+			return nil, err
+		}
+		return p.(*plan), nil
+	}
+	p, err := compileStruct(t, nil)
+	if err != nil {
+		plansCache.Store(t, err)
+		return nil, err
+	}
+	plansCache.Store(t, p)
+	return p, nil
+}
+
+// compileStruct compiles the plan of the struct type, the visiting
+// types are used to detect recursive types.
+func compileStruct(t reflect.Type, visiting map[reflect.Type]bool) (*plan, error) {
+	if visiting[t] {
+		return nil, fmt.Errorf("recursive type %v", t)
+	}
+	if visiting == nil {
+		visiting = make(map[reflect.Type]bool)
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	p := &plan{kind: planStruct}
+	names := make(map[string]int) // field indexes by name used in tags
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			return nil, fmt.Errorf("unexported field %s.%s", t.Name(), f.Name)
+		}
+		if name := binapiName(f); name != "" {
+			names[name] = i
+		}
+		names[f.Name] = i
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fp := fieldPlan{index: i, name: f.Name, path: f.Name, sizeFrom: -1, sizeOf: -1}
+		if name := binapiName(f); name != "" {
+			fp.path = name
+		}
+		var (
+			length   int
+			sizeFrom string
+			variable bool
+			strict   bool
+		)
+		binapiTag, isBinapi := f.Tag.Lookup("binapi")
+		if isBinapi {
+			typ, opts, _ := strings.Cut(binapiTag, ",")
+			for _, opt := range strings.Split(opts, ",") {
+				if limit, ok := strings.CutPrefix(opt, "limit="); ok {
+					n, err := strconv.Atoi(limit)
+					if err != nil {
+						return nil, fmt.Errorf("field %s: invalid limit %q", f.Name, limit)
+					}
+					fp.limit = n
+				}
+			}
+			if start := strings.Index(typ, "["); start >= 0 && strings.HasSuffix(typ, "]") {
+				if ref := typ[start+1 : len(typ)-1]; ref == "" {
+					variable = true
+				} else if n, err := strconv.Atoi(ref); err == nil {
+					length = n
+				} else {
+					sizeFrom = ref
+				}
+			}
+		} else if tag := f.Tag.Get("struc"); tag != "" {
+			strict = true
+			for _, opt := range strings.Split(tag, ",") {
+				switch {
+				case strings.HasPrefix(opt, "sizeof="):
+					target, ok := names[strings.TrimPrefix(opt, "sizeof=")]
+					if !ok {
+						return nil, fmt.Errorf("field %s: unknown sizeof field", f.Name)
+					}
+					fp.sizeOf = target
+				case strings.HasPrefix(opt, "sizefrom="):
+					sizeFrom = strings.TrimPrefix(opt, "sizefrom=")
+				case strings.HasPrefix(opt, "["):
+					n, err := strconv.Atoi(opt[1:strings.Index(opt, "]")])
+					if err != nil {
+						return nil, fmt.Errorf("field %s: unsupported struc option %q", f.Name, opt)
+					}
+					length = n
+				case opt == strings.ToLower(f.Type.Kind().String()) || opt == "byte" && f.Type.Kind() == reflect.Uint8:
+					// type matching the field type
+				default:
+					return nil, fmt.Errorf("field %s: unsupported struc option %q", f.Name, opt)
+				}
+			}
+		} else {
+			strict = true
+		}
+		if sizeFrom != "" {
+			index, ok := names[sizeFrom]
+			if !ok {
+				return nil, fmt.Errorf("field %s: unknown sizefrom field %s", f.Name, sizeFrom)
+			}
+			fp.sizeFrom = index
+		}
+
+		fplan, err := compileType(f.Type, visiting)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		switch fplan.kind {
+		case planString:
+			fplan = &plan{kind: planString, strict: strict}
+			if length > 0 {
+				fplan.length = length
+			} else if isBinapi {
+				// the generated methods always prefix variable strings
+				fplan.prefixed = true
+			} else if fp.sizeFrom < 0 && !referred(t, i) {
+				return nil, fmt.Errorf("field %s: string without length", f.Name)
+			}
+		case planBytes, planArray:
+			if f.Type.Kind() == reflect.Array {
+				break
+			}
+			fplan = &plan{kind: fplan.kind, elem: fplan.elem, length: length, strict: strict}
+			if length == 0 && fp.sizeFrom < 0 && (isBinapi && !variable || !isBinapi && !referred(t, i)) {
+				return nil, fmt.Errorf("field %s: slice without length", f.Name)
+			}
+		case planFloat64:
+			fplan = &plan{kind: planFloat64, bigEndian: !isBinapi}
+		}
+		fp.plan = fplan
+		p.fields = append(p.fields, fp)
+	}
+
+	// binapi fields referred by sized arrays are encoded as the array length
+	for i, fp := range p.fields {
+		if fp.sizeFrom < 0 {
+			continue
+		}
+		if _, isBinapi := t.Field(i).Tag.Lookup("binapi"); !isBinapi {
+			continue
+		}
+		if count := &p.fields[fp.sizeFrom]; count.sizeOf < 0 {
+			count.sizeOf = i
+		}
+		if fp.plan.prefixed {
+			p.fields[i].sizeFrom = -1
+		}
+	}
+	for i, fp := range p.fields {
+		if fp.sizeOf < 0 {
+			continue
+		}
+		if fp.plan.kind < planInt8 || fp.plan.kind > planUint64 {
+			return nil, fmt.Errorf("field %s: length field must be integer", fp.name)
+		}
+		// struc reads the length of the referred field from the length field
+		if target := &p.fields[fp.sizeOf]; target.sizeFrom < 0 && target.plan.length == 0 && !target.plan.prefixed {
+			target.sizeFrom = i
+		}
+	}
+	return p, nil
+}
+
+// referred returns true if i-th field is referred by the sizeof option of another field.
+func referred(t reflect.Type, i int) bool {
+	for j := 0; j < t.NumField(); j++ {
+		for _, opt := range strings.Split(t.Field(j).Tag.Get("struc"), ",") {
+			if opt == "sizeof="+t.Field(i).Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func compileType(t reflect.Type, visiting map[reflect.Type]bool) (*plan, error) {
+	switch t.Kind() {
+	case reflect.Bool:
+		return &plan{kind: planBool}, nil
+	case reflect.Int8:
+		return &plan{kind: planInt8}, nil
+	case reflect.Int16:
+		return &plan{kind: planInt16}, nil
+	case reflect.Int32:
+		return &plan{kind: planInt32}, nil
+	case reflect.Int64:
+		return &plan{kind: planInt64}, nil
+	case reflect.Uint8:
+		return &plan{kind: planUint8}, nil
+	case reflect.Uint16:
+		return &plan{kind: planUint16}, nil
+	case reflect.Uint32:
+		return &plan{kind: planUint32}, nil
+	case reflect.Uint64:
+		return &plan{kind: planUint64}, nil
+	case reflect.Float64:
+		return &plan{kind: planFloat64}, nil
+	case reflect.String:
+		return &plan{kind: planString}, nil
+	case reflect.Array, reflect.Slice:
+		var length int
+		if t.Kind() == reflect.Array {
+			length = t.Len()
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &plan{kind: planBytes, length: length}, nil
+		}
+		elem, err := compileType(t.Elem(), visiting)
+		if err != nil {
+			return nil, err
+		}
+		if (elem.kind == planString || elem.kind == planBytes || elem.kind == planArray) && elem.length == 0 {
+			return nil, fmt.Errorf("array of %v without length", t.Elem())
+		}
+		return &plan{kind: planArray, length: length, elem: elem}, nil
+	case reflect.Struct:
+		return compileStruct(t, visiting)
+	}
+	return nil, fmt.Errorf("unsupported type %v", t)
+}
+
+// size returns the encoded size of the value.
+func (p *plan) size(rv reflect.Value) int {
+	switch p.kind {
+	case planBool, planInt8, planUint8:
+		return 1
+	case planInt16, planUint16:
+		return 2
+	case planInt32, planUint32:
+		return 4
+	case planInt64, planUint64, planFloat64:
+		return 8
+	case planString, planBytes:
+		if p.length > 0 {
+			return p.length
+		}
+		if p.prefixed {
+			return 4 + rv.Len()
+		}
+		return rv.Len()
+	case planArray:
+		n := p.length
+		if n == 0 {
+			n = rv.Len()
+		}
+		if n == 0 {
+			return 0
+		}
+		if elemSize, fixed := p.elem.fixedSize(); fixed {
+			return n * elemSize
+		}
+		var size int
+		zero := reflect.Zero(rv.Type().Elem())
+		for i := 0; i < n; i++ {
+			if i < rv.Len() {
+				size += p.elem.size(rv.Index(i))
+			} else {
+				size += p.elem.size(zero)
+			}
+		}
+		return size
+	case planStruct:
+		var size int
+		for _, f := range p.fields {
+			size += f.plan.size(rv.Field(f.index))
+		}
+		return size
+	}
+	return 0
+}
+
+// fixedSize returns the size of the values with fixed size.
+func (p *plan) fixedSize() (int, bool) {
+	switch p.kind {
+	case planString, planBytes:
+		return p.length, p.length > 0 && !p.prefixed
+	case planArray:
+		if p.length == 0 {
+			return 0, false
+		}
+		size, fixed := p.elem.fixedSize()
+		return p.length * size, fixed
+	case planStruct:
+		var size int
+		for _, f := range p.fields {
+			fsize, fixed := f.plan.fixedSize()
+			if !fixed {
+				return 0, false
+			}
+			size += fsize
+		}
+		return size, true
+	}
+	return p.size(reflect.Value{}), true
+}
+
+// encode encodes the value into the buffer.
+func (p *plan) encode(b *Buffer, rv reflect.Value) {
+	switch p.kind {
+	case planBool:
+		b.EncodeBool(rv.Bool())
+	case planInt8:
+		b.EncodeInt8(int8(rv.Int()))
+	case planInt16:
+		b.EncodeInt16(int16(rv.Int()))
+	case planInt32:
+		b.EncodeInt32(int32(rv.Int()))
+	case planInt64:
+		b.EncodeInt64(rv.Int())
+	case planUint8:
+		b.EncodeUint8(uint8(rv.Uint()))
+	case planUint16:
+		b.EncodeUint16(uint16(rv.Uint()))
+	case planUint32:
+		b.EncodeUint32(uint32(rv.Uint()))
+	case planUint64:
+		b.EncodeUint64(rv.Uint())
+	case planFloat64:
+		if p.bigEndian {
+			b.EncodeUint64(math.Float64bits(rv.Float()))
+		} else {
+			b.EncodeFloat64(rv.Float())
+		}
+	case planString:
+		s := rv.String()
+		if p.prefixed {
+			b.EncodeString(s, 0)
+			return
+		}
+		p.checkLength(len(s))
+		b.EncodeBytes([]byte(s), p.encodedLength(len(s)))
+	case planBytes:
+		p.checkLength(rv.Len())
+		if rv.Kind() == reflect.Slice {
+			b.EncodeBytes(rv.Bytes(), p.encodedLength(rv.Len()))
+			return
+		}
+		n := rv.Len()
+		for i := 0; i < n; i++ {
+			b.EncodeUint8(uint8(rv.Index(i).Uint()))
+		}
+	case planArray:
+		p.checkLength(rv.Len())
+		n := p.length
+		if n == 0 {
+			n = rv.Len()
+		}
+		var zero reflect.Value
+		for i := 0; i < n; i++ {
+			if i < rv.Len() {
+				p.elem.encode(b, rv.Index(i))
+			} else {
+				if !zero.IsValid() {
+					zero = reflect.Zero(rv.Type().Elem())
+				}
+				p.elem.encode(b, zero)
+			}
+		}
+	case planStruct:
+		for _, f := range p.fields {
+			if f.sizeOf >= 0 {
+				f.plan.encodeCount(b, rv.Field(f.sizeOf).Len())
+				continue
+			}
+			f.plan.encode(b, rv.Field(f.index))
+		}
+	}
+}
+
+// encodeCount encodes the length of the referred field.
+func (p *plan) encodeCount(b *Buffer, n int) {
+	switch p.kind {
+	case planInt8:
+		b.EncodeInt8(int8(n))
+	case planInt16:
+		b.EncodeInt16(int16(n))
+	case planInt32:
+		b.EncodeInt32(int32(n))
+	case planInt64:
+		b.EncodeInt64(int64(n))
+	case planUint8:
+		b.EncodeUint8(uint8(n))
+	case planUint16:
+		b.EncodeUint16(uint16(n))
+	case planUint32:
+		b.EncodeUint32(uint32(n))
+	case planUint64:
+		b.EncodeUint64(uint64(n))
+	}
+}
+
+// checkLength panics for the values longer than the fixed length in strict mode.
+func (p *plan) checkLength(n int) {
+	if p.strict && p.length > 0 && n > p.length {
+		panic(fmt.Errorf("value length %d exceeds fixed length %d", n, p.length))
+	}
+}
+
+// encodedLength returns the number of encoded bytes for the value of length n.
+func (p *plan) encodedLength(n int) int {
+	if p.length > 0 {
+		return p.length
+	}
+	return n
+}
+
+// decode decodes the value from the buffer, the count is the number
+// of items of the variable arrays and strings. The errors are returned
+// only by the strict decoding, otherwise reading past the data panics.
+func (p *plan) decode(b *Buffer, rv reflect.Value, count int, opts decodeOptions) error {
+	if opts.strict {
+		if err := p.check(b, rv, count); err != nil {
+			return err
+		}
+	}
+	switch p.kind {
+	case planBool:
+		rv.SetBool(b.DecodeBool())
+	case planInt8:
+		rv.SetInt(int64(b.DecodeInt8()))
+	case planInt16:
+		rv.SetInt(int64(b.DecodeInt16()))
+	case planInt32:
+		rv.SetInt(int64(b.DecodeInt32()))
+	case planInt64:
+		rv.SetInt(b.DecodeInt64())
+	case planUint8:
+		rv.SetUint(uint64(b.DecodeUint8()))
+	case planUint16:
+		rv.SetUint(uint64(b.DecodeUint16()))
+	case planUint32:
+		rv.SetUint(uint64(b.DecodeUint32()))
+	case planUint64:
+		rv.SetUint(b.DecodeUint64())
+	case planFloat64:
+		if p.bigEndian {
+			rv.SetFloat(math.Float64frombits(b.DecodeUint64()))
+		} else {
+			rv.SetFloat(b.DecodeFloat64())
+		}
+	case planString:
+		var data []byte
+		switch {
+		case p.prefixed:
+			n := int(b.DecodeUint32())
+			if opts.strict {
+				if err := b.needCount("length", n, n); err != nil {
+					return err
+				}
+			}
+			data = b.DecodeBytes(n)
+		case p.length > 0:
+			data = b.DecodeBytes(p.length)
+			if nul := bytes.IndexByte(data, 0); nul >= 0 {
+				data = data[:nul]
+			}
+		default:
+			data = b.DecodeBytes(count)
+		}
+		// comparing does not allocate, the unchanged strings are kept
+		if !opts.reuse || rv.String() != string(data) {
+			rv.SetString(string(data))
+		}
+	case planBytes:
+		if rv.Kind() == reflect.Array {
+			data := b.DecodeBytes(rv.Len())
+			for i, x := range data {
+				rv.Index(i).SetUint(uint64(x))
+			}
+			return nil
+		}
+		n := p.length
+		if n == 0 {
+			n = count
+		}
+		setLen(rv, n, opts)
+		copy(rv.Bytes(), b.DecodeBytes(n))
+	case planArray:
+		if rv.Kind() == reflect.Slice {
+			n := p.length
+			if n == 0 {
+				n = count
+			}
+			setLen(rv, n, opts)
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := p.elem.decode(b, rv.Index(i), 0, opts); err != nil {
+				return prependPath(err, "["+strconv.Itoa(i)+"]")
+			}
+		}
+	case planStruct:
+		for _, f := range p.fields {
+			count := 0
+			if f.sizeFrom >= 0 {
+				n := rv.Field(f.sizeFrom)
+				if n.CanInt() {
+					count = int(n.Int())
+				} else {
+					count = int(n.Uint())
+				}
+			}
+			if err := f.plan.decode(b, rv.Field(f.index), count, opts); err != nil {
+				return prependPath(err, f.path)
+			}
+		}
+	}
+	return nil
+}
+
+// check checks that the remaining data are long enough for the value,
+// the arrays are checked for the minimal size of their items.
+func (p *plan) check(b *Buffer, rv reflect.Value, count int) error {
+	switch p.kind {
+	case planStruct:
+		return nil // the fields are checked one by one
+	case planString, planBytes:
+		switch {
+		case p.prefixed:
+			return b.need(4)
+		case rv.Kind() == reflect.Array:
+			return b.need(rv.Len())
+		case p.length > 0:
+			return b.need(p.length)
+		}
+		return b.needCount("length", count, count)
+	case planArray:
+		switch {
+		case rv.Kind() == reflect.Array:
+			return b.need(rv.Len() * p.elem.minSize())
+		case p.length > 0:
+			return b.need(p.length * p.elem.minSize())
+		}
+		return b.needCount("count", count, count*p.elem.minSize())
+	}
+	size, _ := p.fixedSize()
+	return b.need(size)
+}
+
+// minSize returns the minimal encoded size of the value.
+func (p *plan) minSize() int {
+	if size, fixed := p.fixedSize(); fixed {
+		return size
+	}
+	switch p.kind {
+	case planString, planBytes:
+		if p.prefixed {
+			return 4
+		}
+	case planArray:
+		return p.length * p.elem.minSize()
+	case planStruct:
+		var size int
+		for _, f := range p.fields {
+			size += f.plan.minSize()
+		}
+		return size
+	}
+	return 0
+}
+
+// setLen sets the slice to a new slice of length n, or to the existing
+// slice resliced to length n if reusing its capacity.
+func setLen(rv reflect.Value, n int, opts decodeOptions) {
+	if opts.reuse && rv.Cap() >= n {
+		rv.SetLen(n)
+		return
+	}
+	rv.Set(reflect.MakeSlice(rv.Type(), n, n))
+}
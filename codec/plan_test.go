@@ -0,0 +1,163 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"go.fd.io/govpp/api"
+	_ "go.fd.io/govpp/binapi/dev"
+	_ "go.fd.io/govpp/binapi/flow"
+	_ "go.fd.io/govpp/binapi/interface"
+	_ "go.fd.io/govpp/binapi/ip"
+	_ "go.fd.io/govpp/binapi/lisp"
+	_ "go.fd.io/govpp/binapi/lldp"
+	_ "go.fd.io/govpp/binapi/ping"
+	_ "go.fd.io/govpp/binapi/pnat"
+	_ "go.fd.io/govpp/binapi/punt"
+	_ "go.fd.io/govpp/binapi/sr"
+	_ "go.fd.io/govpp/binapi/vlib"
+	"go.fd.io/govpp/codec"
+)
+
+// TestWrapperMatchesGenerated checks that the plans encode the generated
+// messages byte for byte as their generated methods.
+func TestWrapperMatchesGenerated(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var count int
+	for _, msgs := range api.GetRegisteredMessages() {
+		for name, msg := range msgs {
+			generated, ok := msg.(codec.Marshaler)
+			if !ok {
+				continue
+			}
+			for i := 0; i < 3; i++ {
+				m := reflect.New(reflect.TypeOf(msg).Elem())
+				if i > 0 {
+					fillRandom(rnd, m.Elem())
+				}
+				generated = m.Interface().(codec.Marshaler)
+				wrapper := codec.Wrapper{Message: m.Interface().(api.Message)}
+
+				if generated.Size() != wrapper.Size() {
+					t.Fatalf("%s: size %d, generated %d", name, wrapper.Size(), generated.Size())
+				}
+				expected, err := generated.Marshal(nil)
+				if err != nil {
+					t.Fatalf("%s: generated marshal failed: %v", name, err)
+				}
+				data, err := wrapper.Marshal(nil)
+				if err != nil {
+					t.Fatalf("%s: marshal failed: %v", name, err)
+				}
+				if !bytes.Equal(data, expected) {
+					t.Fatalf("%s: unexpected data,\nexpected: % 02x\n     got: % 02x", name, expected, data)
+				}
+
+				expectedMsg := reflect.New(m.Elem().Type())
+				if err := expectedMsg.Interface().(codec.Unmarshaler).Unmarshal(expected); err != nil {
+					t.Fatalf("%s: generated unmarshal failed: %v", name, err)
+				}
+				decoded := reflect.New(m.Elem().Type())
+				if err := (codec.Wrapper{Message: decoded.Interface().(api.Message)}).Unmarshal(expected); err != nil {
+					t.Fatalf("%s: unmarshal failed: %v", name, err)
+				}
+				if !reflect.DeepEqual(decoded.Interface(), expectedMsg.Interface()) {
+					t.Fatalf("%s: unexpected message:\n%v", name, codec.Diff(expectedMsg.Interface().(api.Message), decoded.Interface().(api.Message)))
+				}
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		t.Fatal("no messages tested")
+	}
+}
+
+func TestWrapperStruc(t *testing.T) {
+	type Item struct {
+		A uint16
+		B float64
+	}
+	type Msg struct {
+		MyMsg
+		Count uint8 `struc:"sizeof=Items"`
+		Items []Item
+		Flag  bool
+	}
+	msg := &Msg{Count: 7, Items: []Item{{A: 1, B: 1.5}, {A: 2}}, Flag: true}
+
+	data, err := codec.Wrapper{Message: msg}.Marshal(nil)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	expected := []byte{
+		0x00, 0x00, // Index
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // Label
+		0x00, 0x00, // Port
+		0x02,                                                       // Count
+		0x00, 0x01, 0x3f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // Items[0]
+		0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // Items[1]
+		0x01, // Flag
+	}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("unexpected data,\nexpected: % 02x\n     got: % 02x", expected, data)
+	}
+
+	decoded := new(Msg)
+	if err := (codec.Wrapper{Message: decoded}).Unmarshal(data); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	msg.Count = 2
+	msg.Label = make([]byte, 16)
+	if !reflect.DeepEqual(decoded, msg) {
+		t.Fatalf("unexpected message,\nexpected: %+v\n     got: %+v", msg, decoded)
+	}
+}
+
+// fillRandom fills the value with random data.
+func fillRandom(rnd *rand.Rand, rv reflect.Value) {
+	switch rv.Kind() {
+	case reflect.Bool:
+		rv.SetBool(rnd.Intn(2) == 1)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(rnd.Int63() >> (64 - rv.Type().Bits()))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		rv.SetUint(rnd.Uint64() >> (64 - rv.Type().Bits()))
+	case reflect.Float64:
+		rv.SetFloat(rnd.Float64())
+	case reflect.String:
+		b := make([]byte, rnd.Intn(8))
+		for i := range b {
+			b[i] = byte('a' + rnd.Intn(26))
+		}
+		rv.SetString(string(b))
+	case reflect.Slice:
+		n := rnd.Intn(4)
+		rv.Set(reflect.MakeSlice(rv.Type(), n, n))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			fillRandom(rnd, rv.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			fillRandom(rnd, rv.Field(i))
+		}
+	}
+}
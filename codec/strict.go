@@ -0,0 +1,100 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrTruncated is returned by the strict decoding if the data end before the message.
+	ErrTruncated = errors.New("data truncated")
+	// ErrTrailingBytes is returned by the strict decoding if the data continue after the message.
+	ErrTrailingBytes = errors.New("unexpected trailing bytes")
+)
+
+// DecodeError describes malformed data found by the strict decoding.
+type DecodeError struct {
+	// Message is the name of the decoded message.
+	Message string
+	// Path is the path of the failing field using VPP field names, e.g. "route.paths[1].nh",
+	// it is empty for errors of the whole message.
+	Path string
+	// Offset is the offset in the message data, including the message header.
+	Offset int
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("decoding")
+	if e.Message != "" {
+		sb.WriteString(" message ")
+		sb.WriteString(e.Message)
+	}
+	if e.Path != "" {
+		sb.WriteString(" field ")
+		sb.WriteString(e.Path)
+	}
+	fmt.Fprintf(&sb, " at offset %d: %v", e.Offset, e.Err)
+	return sb.String()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// need returns an error if less than n bytes remain in the buffer.
+func (b *Buffer) need(n int) error {
+	if remaining := len(b.buf) - b.pos; n > remaining {
+		return &DecodeError{
+			Offset: b.pos,
+			Err:    fmt.Errorf("%w: need %d bytes, %d remaining", ErrTruncated, n, remaining),
+		}
+	}
+	return nil
+}
+
+// needCount returns an error if the count (or length) of items needing
+// n bytes is invalid or exceeds the remaining bytes in the buffer.
+func (b *Buffer) needCount(what string, count, n int) error {
+	remaining := len(b.buf) - b.pos
+	if count < 0 || n > remaining {
+		return &DecodeError{
+			Offset: b.pos,
+			Err:    fmt.Errorf("%w: %s %d needs %d bytes, %d remaining", ErrTruncated, what, count, n, remaining),
+		}
+	}
+	return nil
+}
+
+// prependPath prepends the field name or array index to the path of the decoding error.
+func prependPath(err error, name string) error {
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		return err
+	}
+	switch {
+	case decodeErr.Path == "":
+		decodeErr.Path = name
+	case strings.HasPrefix(decodeErr.Path, "["):
+		decodeErr.Path = name + decodeErr.Path
+	default:
+		decodeErr.Path = name + "." + decodeErr.Path
+	}
+	return err
+}
@@ -0,0 +1,168 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/vlib"
+	"go.fd.io/govpp/codec"
+)
+
+func TestStrictDecodeValid(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	c := codec.NewMsgCodec(codec.Strict)
+	for _, msgs := range api.GetRegisteredMessages() {
+		for name, msg := range msgs {
+			m := reflect.New(reflect.TypeOf(msg).Elem())
+			fillRandom(rnd, m.Elem())
+			data, err := c.EncodeMsg(m.Interface().(api.Message), 100)
+			if err != nil {
+				t.Fatalf("%s: encode failed: %v", name, err)
+			}
+			decoded := reflect.New(m.Elem().Type())
+			if err := c.DecodeMsg(data, decoded.Interface().(api.Message)); err != nil {
+				t.Fatalf("%s: strict decode failed: %v", name, err)
+			}
+			expected := reflect.New(m.Elem().Type())
+			if err := codec.DecodeMsg(data, expected.Interface().(api.Message)); err != nil {
+				t.Fatalf("%s: decode failed: %v", name, err)
+			}
+			if !reflect.DeepEqual(decoded.Interface(), expected.Interface()) {
+				t.Fatalf("%s: unexpected message:\n%v", name, codec.Diff(expected.Interface().(api.Message), decoded.Interface().(api.Message)))
+			}
+		}
+	}
+}
+
+func TestStrictDecodeTruncated(t *testing.T) {
+	msg := &ip.IPRouteAddDel{
+		IsAdd: true,
+		Route: ip.IPRoute{
+			NPaths: 2,
+			Paths:  []fib_types.FibPath{{SwIfIndex: 1}, {SwIfIndex: 2}},
+		},
+	}
+	data, err := codec.EncodeMsg(msg, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n < len(data); n++ {
+		var decoded ip.IPRouteAddDel
+		err := codec.DefaultCodec.DecodeMsgWith(data[:n], &decoded, codec.Strict)
+		var decodeErr *codec.DecodeError
+		if !errors.As(err, &decodeErr) || !errors.Is(err, codec.ErrTruncated) {
+			t.Fatalf("length %d: expected truncation error, got: %v", n, err)
+		}
+		if decodeErr.Message != "ip_route_add_del" || decodeErr.Offset > n {
+			t.Fatalf("length %d: unexpected error: %v", n, err)
+		}
+	}
+
+	// the count of paths exceeds the remaining data
+	var decoded ip.IPRouteAddDel
+	err = codec.DefaultCodec.DecodeMsgWith(data[:len(data)-1], &decoded, codec.Strict)
+	var decodeErr *codec.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Path != "route.paths" {
+		t.Fatalf("expected error of route.paths, got: %v", err)
+	}
+
+	// the failing field is inside of the second item of variable size
+	data, err = codec.EncodeMsg(&strictMsg{Count: 2, Items: []strictItem{{Name: "a"}, {Name: "bc"}}}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = codec.DefaultCodec.DecodeMsgWith(data[:len(data)-1], new(strictMsg), codec.Strict)
+	if !errors.As(err, &decodeErr) || decodeErr.Path != "items[1].name" || decodeErr.Offset != 15 {
+		t.Fatalf("expected error of items[1].name at offset 15, got: %v", err)
+	}
+}
+
+type strictItem struct {
+	Name string `binapi:"string[],name=name"`
+}
+
+type strictMsg struct {
+	Count uint32       `binapi:"u32,name=count"`
+	Items []strictItem `binapi:"strict_item[count],name=items"`
+}
+
+func (*strictMsg) GetMessageName() string          { return "strict_msg" }
+func (*strictMsg) GetCrcString() string            { return "01234567" }
+func (*strictMsg) GetMessageType() api.MessageType { return api.OtherMessage }
+
+func TestStrictDecodeCount(t *testing.T) {
+	msg := &ip.IPRouteAddDel{
+		Route: ip.IPRoute{
+			NPaths: 1,
+			Paths:  []fib_types.FibPath{{SwIfIndex: 1}},
+		},
+	}
+	data, err := codec.EncodeMsg(msg, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// header, is_add, is_multipath, table_id, stats_index and prefix precede n_paths
+	const nPathsOffset = 10 + 1 + 1 + 4 + 4 + 18
+	if data[nPathsOffset] != 1 {
+		t.Fatalf("unexpected n_paths at offset %d: % 02x", nPathsOffset, data)
+	}
+	data[nPathsOffset] = 200
+
+	var decoded ip.IPRouteAddDel
+	err = codec.DefaultCodec.DecodeMsgWith(data, &decoded, codec.Strict)
+	var decodeErr *codec.DecodeError
+	if !errors.As(err, &decodeErr) || !errors.Is(err, codec.ErrTruncated) {
+		t.Fatalf("expected truncation error, got: %v", err)
+	}
+	if decodeErr.Path != "route.paths" || decodeErr.Offset != nPathsOffset+1 {
+		t.Fatalf("expected error of route.paths at offset %d, got: %v", nPathsOffset+1, err)
+	}
+
+	// length of the string exceeding the data
+	reply := []byte{0x00, 0x64, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 'a'}
+	err = codec.DefaultCodec.DecodeMsgWith(reply, new(vlib.CliInbandReply), codec.Strict)
+	if !errors.As(err, &decodeErr) || decodeErr.Path != "reply" || decodeErr.Offset != 14 {
+		t.Fatalf("expected error of reply at offset 14, got: %v", err)
+	}
+}
+
+func TestStrictDecodeTrailingBytes(t *testing.T) {
+	data, err := codec.EncodeMsg(&vlib.CliInbandReply{Reply: "abc"}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, 0x01, 0x02)
+
+	// the trailing bytes are ignored by default
+	if err := codec.DecodeMsg(data, new(vlib.CliInbandReply)); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	err = codec.NewMsgCodec(codec.Strict).DecodeMsg(data, new(vlib.CliInbandReply))
+	var decodeErr *codec.DecodeError
+	if !errors.As(err, &decodeErr) || !errors.Is(err, codec.ErrTrailingBytes) {
+		t.Fatalf("expected trailing bytes error, got: %v", err)
+	}
+	if decodeErr.Path != "" || decodeErr.Offset != len(data)-2 {
+		t.Fatalf("expected error at offset %d, got: %v", len(data)-2, err)
+	}
+}
@@ -0,0 +1,121 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"go.fd.io/govpp/api"
+)
+
+// ValidationError describes a field of message violating the constraints
+// of the API definition.
+type ValidationError struct {
+	// Message is the name of the validated message.
+	Message string
+	// Path is the path of the field using VPP field names, e.g. "route.paths[1].label_stack".
+	Path string
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid message %s field %s: %v", e.Message, e.Path, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateMsg checks that the message satisfies the constraints of the API
+// definition before it is encoded:
+//   - strings are not longer than their fixed length or limit,
+//   - slices encoded as fixed arrays are not longer than the array,
+//   - variable arrays are not longer than their limit,
+//   - count fields match the length of the arrays they count.
+//
+// All violations are returned joined, each of them as *ValidationError.
+func ValidateMsg(msg api.Message) error {
+	return ValidateMsgLimits(msg, nil)
+}
+
+// ValidateMsgLimits checks the message the same as ValidateMsg, using also
+// the limits of fields missing in the binapi tags, e.g. of binapi generated
+// before the limits were added to the API definitions. The limits are keyed
+// by the path of the field without array indexes, e.g. "route.paths", and
+// can be derived from the API definitions by vppapi.FieldLimits.
+func ValidateMsgLimits(msg api.Message, limits map[string]int) error {
+	if msg == nil {
+		return errors.New("nil message passed in")
+	}
+	rv, ok := structValue(msg)
+	if !ok {
+		return fmt.Errorf("message %s must be pointer to struct", msg.GetMessageName())
+	}
+	p, err := typePlan(rv.Type())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	p.validate(rv, "", "", limits, func(path string, err error) {
+		errs = append(errs, &ValidationError{Message: msg.GetMessageName(), Path: path, Err: err})
+	})
+	return errors.Join(errs...)
+}
+
+// validate checks the value and reports the violations of constraints, the
+// key is the path without array indexes used for the additional limits.
+func (p *plan) validate(rv reflect.Value, path, key string, limits map[string]int, report func(path string, err error)) {
+	switch p.kind {
+	case planString, planBytes:
+		if rv.Kind() != reflect.Array && p.length > 0 && rv.Len() > p.length {
+			report(path, fmt.Errorf("length %d exceeds fixed length %d", rv.Len(), p.length))
+		}
+	case planArray:
+		if rv.Kind() != reflect.Array && p.length > 0 && rv.Len() > p.length {
+			report(path, fmt.Errorf("length %d exceeds fixed length %d", rv.Len(), p.length))
+		}
+		for i := 0; i < rv.Len(); i++ {
+			p.elem.validate(rv.Index(i), path+"["+strconv.Itoa(i)+"]", key, limits, report)
+		}
+	case planStruct:
+		for _, f := range p.fields {
+			fv := rv.Field(f.index)
+			fpath, fkey := joinPath(path, f.path), joinPath(key, f.path)
+			f.plan.validate(fv, fpath, fkey, limits, report)
+			limit := f.limit
+			if n, ok := limits[fkey]; ok && (limit == 0 || n < limit) {
+				limit = n
+			}
+			if limit > 0 && (fv.Kind() == reflect.String || fv.Kind() == reflect.Slice) && fv.Len() > limit {
+				report(fpath, fmt.Errorf("length %d exceeds limit %d", fv.Len(), limit))
+			}
+			if f.sizeOf >= 0 {
+				var count int
+				if fv.CanInt() {
+					count = int(fv.Int())
+				} else {
+					count = int(fv.Uint())
+				}
+				target := p.fields[f.sizeOf]
+				if n := rv.Field(target.index).Len(); count != n {
+					report(fpath, fmt.Errorf("count %d does not match length %d of %s", count, n, target.path))
+				}
+			}
+		}
+	}
+}
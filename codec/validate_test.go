@@ -0,0 +1,124 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	interfaces "go.fd.io/govpp/binapi/interface"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/vlib"
+	"go.fd.io/govpp/codec"
+)
+
+type limitedMsg struct {
+	Tag   string   `binapi:"string[],name=tag,limit=4"`
+	Count uint8    `binapi:"u8,name=count" json:"-"`
+	Items []uint32 `binapi:"u32[count],name=items"`
+	Fixed []uint32 `binapi:"u32[2],name=fixed"`
+}
+
+func (*limitedMsg) GetMessageName() string          { return "limited_msg" }
+func (*limitedMsg) GetCrcString() string            { return "01234567" }
+func (*limitedMsg) GetMessageType() api.MessageType { return api.RequestMessage }
+
+func TestValidateMsg(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    api.Message
+		errors []string
+	}{
+		{name: "empty", msg: &ip.IPRouteAddDel{}},
+		{name: "valid",
+			msg: &ip.IPRouteAddDel{Route: ip.IPRoute{
+				NPaths: 1,
+				Paths:  []fib_types.FibPath{{NLabels: 1}},
+			}},
+		},
+		{name: "variable string", msg: &vlib.CliInband{Cmd: strings.Repeat("x", 1000)}},
+		{name: "fixed string",
+			msg:    &interfaces.SwInterfaceTagAddDel{Tag: strings.Repeat("x", 65)},
+			errors: []string{"tag: length 65 exceeds fixed length 64"},
+		},
+		{name: "count",
+			msg: &ip.IPRouteAddDel{Route: ip.IPRoute{
+				Paths: []fib_types.FibPath{{}, {}},
+			}},
+			errors: []string{"route.n_paths: count 0 does not match length 2 of paths"},
+		},
+		{name: "limits",
+			msg: &limitedMsg{
+				Tag:   "abcde",
+				Count: 1,
+				Fixed: []uint32{1, 2, 3},
+			},
+			errors: []string{
+				"tag: length 5 exceeds limit 4",
+				"count: count 1 does not match length 0 of items",
+				"fixed: length 3 exceeds fixed length 2",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := codec.ValidateMsg(test.msg)
+			if len(test.errors) == 0 {
+				if err != nil {
+					t.Fatalf("expected nil error, got: %v", err)
+				}
+				return
+			}
+			var validationErr *codec.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Message != test.msg.GetMessageName() {
+				t.Fatalf("expected validation error, got: %v", err)
+			}
+			for _, expected := range test.errors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error %q, got: %v", expected, err)
+				}
+			}
+			if n := strings.Count(err.Error(), "\n") + 1; n != len(test.errors) {
+				t.Errorf("expected %d errors, got %d: %v", len(test.errors), n, err)
+			}
+		})
+	}
+}
+
+func TestValidateMsgLimits(t *testing.T) {
+	limits := map[string]int{"cmd": 8, "tag": 128}
+
+	err := codec.ValidateMsgLimits(&vlib.CliInband{Cmd: "show version"}, limits)
+	var validationErr *codec.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Path != "cmd" {
+		t.Fatalf("expected validation error of cmd, got: %v", err)
+	}
+	if err := codec.ValidateMsgLimits(&vlib.CliInband{Cmd: "show"}, limits); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+
+	// the smaller of the tag limit and the given limit applies
+	err = codec.ValidateMsgLimits(&limitedMsg{Tag: "abcde"}, limits)
+	if err == nil || err.Error() != "invalid message limited_msg field tag: length 5 exceeds limit 4" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = codec.ValidateMsgLimits(&limitedMsg{Tag: "abc"}, map[string]int{"tag": 2})
+	if err == nil || err.Error() != "invalid message limited_msg field tag: length 3 exceeds limit 2" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
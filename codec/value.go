@@ -0,0 +1,717 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/internal/unionmember"
+)
+
+// This file converts the binary API messages to/from a generic tree of values
+// used by the text codecs. The tree consists of:
+//   - nil, bool, string and json.Number
+//   - []any for arrays
+//   - *object for structs, keeping the order of the fields
+//
+// The conversion is lossless, the message decoded from the tree encodes
+// into the same binary form as the original message:
+//   - enums and flags are converted to their symbolic names,
+//   - types implementing encoding.TextMarshaler (addresses, prefixes..)
+//     are converted to text, if the text converts back to the same value,
+//   - unions are converted to their active member, which is resolved using
+//     the enum field preceding the union in the parent struct,
+//   - byte arrays and unions without active member are converted to hex.
+
+const unionDataField = "XXX_UnionData"
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// object is a struct converted to a list of fields in the original order.
+type object struct {
+	keys   []string
+	values []any
+}
+
+func (o *object) add(key string, value any) {
+	o.keys = append(o.keys, key)
+	o.values = append(o.values, value)
+}
+
+// MarshalJSON encodes the object keeping the order of the fields.
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// messageToValue converts the message to the generic tree of values.
+func messageToValue(msg api.Message) (v any, err error) {
+	if msg == nil {
+		return nil, errors.New("nil message passed in")
+	}
+	rv, ok := structValue(msg)
+	if !ok {
+		return nil, fmt.Errorf("message %s is not a pointer to struct", msg.GetMessageName())
+	}
+	// try to recover panic which might possibly occur
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred during encoding message %s: %v", msg.GetMessageName(), r)
+		}
+	}()
+	return toValue(rv, nil), nil
+}
+
+// valueToMessage converts the generic tree of values to the message.
+func valueToMessage(v any, msg api.Message) (err error) {
+	if msg == nil {
+		return errors.New("nil message passed in")
+	}
+	rv, ok := structValue(msg)
+	if !ok {
+		return fmt.Errorf("message %s is not a pointer to struct", msg.GetMessageName())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic occurred during decoding message %s: %v", msg.GetMessageName(), r)
+		}
+	}()
+	// reset the message for the fields missing in the input
+	rv.Set(reflect.Zero(rv.Type()))
+	if err := fromValue(v, rv, ""); err != nil {
+		return fmt.Errorf("decoding message %s failed: %w", msg.GetMessageName(), err)
+	}
+	return nil
+}
+
+// field refers to the field of the struct containing the converted value.
+type field struct {
+	parent reflect.Value
+	index  int
+}
+
+// toValue converts the value, the fields are the fields containing the value
+// from the outermost one.
+func toValue(rv reflect.Value, fields []field) any {
+	if v, ok := textValue(rv); ok {
+		return v
+	}
+	if isEnum(rv.Type()) {
+		return enumToValue(rv)
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Number(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return json.Number(strconv.FormatUint(rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return json.Number(strconv.FormatFloat(rv.Float(), 'g', -1, 64))
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 && !hasCustomValue(rv.Type().Elem()) {
+			return hex.EncodeToString(byteSlice(rv))
+		}
+		list := make([]any, rv.Len())
+		for i := range list {
+			list[i] = toValue(rv.Index(i), fields)
+		}
+		return list
+	case reflect.Struct:
+		if isUnion(rv.Type()) {
+			return hex.EncodeToString(byteSlice(rv.Field(0)))
+		}
+		return structToValue(rv, fields)
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return toValue(rv.Elem(), fields)
+	}
+	panic(fmt.Sprintf("unsupported type %v", rv.Type()))
+}
+
+func structToValue(rv reflect.Value, fields []field) *object {
+	obj := new(object)
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, skip := fieldName(f)
+		if skip {
+			continue
+		}
+		fields := append(fields[:len(fields):len(fields)], field{rv, i})
+		if isUnion(f.Type) {
+			obj.add(name, unionToValue(rv.Field(i), fields))
+			continue
+		}
+		obj.add(name, toValue(rv.Field(i), fields))
+	}
+	return obj
+}
+
+// unionToValue converts the union to an object with its active member,
+// or to hex if the active member cannot be resolved.
+func unionToValue(union reflect.Value, fields []field) any {
+	data := byteSlice(union.Field(0))
+	if member, ok := activeMember(union.Type(), fields); ok {
+		ptr := reflect.New(union.Type())
+		ptr.Elem().Set(union)
+		val := ptr.MethodByName("Get" + member).Call(nil)[0]
+		// the member must encode back into the same union data
+		check := reflect.New(union.Type())
+		check.MethodByName("Set" + member).Call([]reflect.Value{val})
+		if bytes.Equal(byteSlice(check.Elem().Field(0)), data) {
+			obj := new(object)
+			obj.add(snakeCase(member), toValue(val, nil))
+			return obj
+		}
+	}
+	return hex.EncodeToString(data)
+}
+
+// activeMember resolves the active member of the union from the enum fields
+// preceding the fields containing the union, e.g. address family for address
+// union, the enum values name the active member.
+func activeMember(union reflect.Type, fields []field) (string, bool) {
+	members := unionMembers(union)
+	path := make([]int, len(fields))
+	for k, f := range fields {
+		path[k] = f.index
+	}
+	level, _, cases := unionmember.Resolve(path, func(level, i int) []string {
+		f := fields[level].parent.Field(i)
+		if !isEnum(f.Type()) || !f.CanInterface() {
+			return nil
+		}
+		return []string{f.Interface().(fmt.Stringer).String()}
+	}, members)
+	if level < 0 {
+		return "", false
+	}
+	return members[cases[0]], true
+}
+
+var unionMembersCache sync.Map // map[reflect.Type][]string
+
+// unionMembers returns names of the union members with Get/Set methods.
+func unionMembers(t reflect.Type) []string {
+	if members, ok := unionMembersCache.Load(t); ok {
+		return members.([]string)
+	}
+	var members []string
+	pt := reflect.PointerTo(t)
+	for i := 0; i < pt.NumMethod(); i++ {
+		name := pt.Method(i).Name
+		if !strings.HasPrefix(name, "Get") {
+			continue
+		}
+		member := strings.TrimPrefix(name, "Get")
+		if _, ok := pt.MethodByName("Set" + member); ok {
+			members = append(members, member)
+		}
+	}
+	unionMembersCache.Store(t, members)
+	return members
+}
+
+func fromValue(v any, rv reflect.Value, path string) error {
+	if v == nil {
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+	if s, ok := v.(string); ok && hasTextValue(rv.Type()) {
+		if err := rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			return fieldError(path, err)
+		}
+		return nil
+	}
+	if isEnum(rv.Type()) {
+		if s, ok := v.(string); ok {
+			x, err := parseEnum(rv.Type(), s)
+			if err != nil {
+				return fieldError(path, err)
+			}
+			return fieldError(path, setNumber(rv, x))
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, ok := v.(bool)
+		if !ok {
+			return typeError(path, "bool", v)
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fieldError(path, setNumber(rv, v))
+	case reflect.String:
+		s, ok := v.(string)
+		if !ok {
+			return typeError(path, "string", v)
+		}
+		rv.SetString(s)
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 && !hasCustomValue(rv.Type().Elem()) {
+			s, ok := v.(string)
+			if !ok {
+				return typeError(path, "hex string", v)
+			}
+			b, err := hex.DecodeString(s)
+			if err != nil {
+				return fieldError(path, err)
+			}
+			return fieldError(path, setBytes(rv, b))
+		}
+		list, ok := v.([]any)
+		if !ok {
+			return typeError(path, "array", v)
+		}
+		if rv.Kind() == reflect.Array {
+			if len(list) > rv.Len() {
+				return fieldError(path, fmt.Errorf("too many items (%d), array length is %d", len(list), rv.Len()))
+			}
+		} else if len(list) > 0 {
+			rv.Set(reflect.MakeSlice(rv.Type(), len(list), len(list)))
+		}
+		for i, item := range list {
+			if err := fromValue(item, rv.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if isUnion(rv.Type()) {
+			return unionFromValue(v, rv, path)
+		}
+		return structFromValue(v, rv, path)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return fromValue(v, rv.Elem(), path)
+	default:
+		return fieldError(path, fmt.Errorf("unsupported type %v", rv.Type()))
+	}
+	return nil
+}
+
+func structFromValue(v any, rv reflect.Value, path string) error {
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return typeError(path, "object", v)
+	}
+	t := rv.Type()
+	known := make(map[string]bool, len(fields))
+	for i := 0; i < t.NumField(); i++ {
+		name, skip := fieldName(t.Field(i))
+		if skip {
+			continue
+		}
+		known[name] = true
+		fv, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if err := fromValue(fv, rv.Field(i), joinPath(path, name)); err != nil {
+			return err
+		}
+	}
+	for _, name := range sortedKeys(fields) {
+		if !known[name] {
+			return fieldError(joinPath(path, name), errors.New("unknown field"))
+		}
+	}
+	setLengthFields(rv)
+	return nil
+}
+
+func unionFromValue(v any, rv reflect.Value, path string) error {
+	if s, ok := v.(string); ok {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		return fieldError(path, setBytes(rv.Field(0), b))
+	}
+	fields, ok := v.(map[string]any)
+	if !ok || len(fields) != 1 {
+		return typeError(path, "object with single union member", v)
+	}
+	for name, mv := range fields {
+		var member string
+		for _, m := range unionMembers(rv.Type()) {
+			if normalizeName(m) == normalizeName(name) {
+				member = m
+				break
+			}
+		}
+		if member == "" {
+			return fieldError(joinPath(path, name), errors.New("unknown union member"))
+		}
+		ptr := rv.Addr()
+		val := reflect.New(ptr.MethodByName("Get" + member).Type().Out(0)).Elem()
+		if err := fromValue(mv, val, joinPath(path, name)); err != nil {
+			return err
+		}
+		rv.Set(reflect.Zero(rv.Type()))
+		ptr.MethodByName("Set" + member).Call([]reflect.Value{val})
+	}
+	return nil
+}
+
+// setLengthFields sets the length fields skipped by the text codecs
+// to the length of the slices referring to them.
+func setLengthFields(rv reflect.Value) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if typ, _, _ := strings.Cut(f.Tag.Get("binapi"), ","); strings.HasSuffix(typ, "]") {
+			ref := typ[strings.Index(typ, "[")+1 : len(typ)-1]
+			for j := 0; j < t.NumField(); j++ {
+				if ref != "" && binapiName(t.Field(j)) == ref {
+					setLength(rv.Field(j), rv.Field(i).Len())
+				}
+			}
+		}
+		if tag := f.Tag.Get("struc"); strings.HasPrefix(tag, "sizeof=") {
+			if target := rv.FieldByName(strings.TrimPrefix(tag, "sizeof=")); target.IsValid() {
+				setLength(rv.Field(i), target.Len())
+			}
+		}
+	}
+}
+
+func setLength(rv reflect.Value, n int) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		rv.SetUint(uint64(n))
+	}
+}
+
+// fieldName returns the name of the field in the text form, which is the VPP
+// field name from the binapi tag. Length fields and unexported fields are skipped.
+func fieldName(f reflect.StructField) (name string, skip bool) {
+	if f.PkgPath != "" {
+		return "", true
+	}
+	jsonTag := f.Tag.Get("json")
+	if jsonTag == "-" {
+		return "", true
+	}
+	if name := binapiName(f); name != "" {
+		return name, false
+	}
+	if strings.HasPrefix(f.Name, "XXX_") {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(jsonTag, ","); name != "" {
+		return name, false
+	}
+	return f.Name, false
+}
+
+// binapiName returns the VPP field name from the binapi tag.
+func binapiName(f reflect.StructField) string {
+	for _, opt := range strings.Split(f.Tag.Get("binapi"), ",")[1:] {
+		if name, ok := strings.CutPrefix(opt, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// textValue converts the value to text using its encoding.TextMarshaler,
+// if the text converts back to the same value.
+func textValue(rv reflect.Value) (string, bool) {
+	if !hasTextValue(rv.Type()) {
+		return "", false
+	}
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+	text, err := ptr.Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return "", false
+	}
+	check := reflect.New(rv.Type())
+	if err := check.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+		return "", false
+	}
+	if !reflect.DeepEqual(check.Elem().Interface(), rv.Interface()) {
+		return "", false
+	}
+	return string(text), true
+}
+
+func hasTextValue(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return t.Kind() != reflect.Ptr && pt.Implements(textMarshalerType) && pt.Implements(textUnmarshalerType)
+}
+
+func hasCustomValue(t reflect.Type) bool {
+	return hasTextValue(t) || isEnum(t)
+}
+
+// isEnum returns true for the generated enum types, which are named
+// integer types with String method.
+func isEnum(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return t.PkgPath() != "" && t.Implements(stringerType)
+	}
+	return false
+}
+
+// isUnion returns true for the generated union types.
+func isUnion(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.NumField() == 1 &&
+		t.Field(0).Name == unionDataField && t.Field(0).Type.Kind() == reflect.Array
+}
+
+// enumToValue converts the enum to its symbolic name, flags are converted to
+// the names joined with '|'. Values without a name are kept as numbers.
+func enumToValue(rv reflect.Value) any {
+	s := rv.Interface().(fmt.Stringer).String()
+	if !strings.Contains(s, "(") || strings.Contains(s, "|") {
+		if x, err := parseEnum(rv.Type(), s); err == nil && x == enumNumber(rv) {
+			return s
+		}
+	}
+	return enumNumber(rv)
+}
+
+func enumNumber(rv reflect.Value) json.Number {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return json.Number(strconv.FormatInt(rv.Int(), 10))
+	}
+	return json.Number(strconv.FormatUint(rv.Uint(), 10))
+}
+
+var enumNamesCache sync.Map // map[reflect.Type]map[string]json.Number
+
+// enumNames returns the values of the enum names. The generated enums do not
+// expose their names by reflection, the names are collected by calling String
+// for the small values and for every single bit.
+func enumNames(t reflect.Type) map[string]json.Number {
+	if names, ok := enumNamesCache.Load(t); ok {
+		return names.(map[string]json.Number)
+	}
+	names := make(map[string]json.Number)
+	bits := t.Bits()
+	add := func(x uint64) {
+		rv := reflect.New(t).Elem()
+		if rv.Kind() >= reflect.Uint && rv.Kind() <= reflect.Uint64 {
+			rv.SetUint(x)
+		} else {
+			rv.SetInt(int64(x))
+		}
+		s := rv.Interface().(fmt.Stringer).String()
+		if s != "" && !strings.ContainsAny(s, "(|") {
+			if _, ok := names[s]; !ok {
+				names[s] = enumNumber(rv)
+			}
+		}
+	}
+	maxScan := uint64(1024)
+	if bits < 11 {
+		maxScan = 1 << bits
+	}
+	for x := uint64(0); x < maxScan; x++ {
+		add(x)
+	}
+	for i := 0; i < bits; i++ {
+		add(1 << i)
+	}
+	enumNamesCache.Store(t, names)
+	return names
+}
+
+// parseEnum parses the enum value from its names joined with '|'
+// or from the format used by String for values without a name.
+func parseEnum(t reflect.Type, s string) (json.Number, error) {
+	names := enumNames(t)
+	var value uint64
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		n, ok := names[part]
+		if !ok {
+			n = json.Number(strings.TrimSuffix(strings.TrimPrefix(part, t.Name()+"("), ")"))
+		}
+		x, err := strconv.ParseInt(string(n), 0, 64)
+		if err != nil {
+			return "", fmt.Errorf("unknown %s value %q", t.Name(), part)
+		}
+		value |= uint64(x)
+	}
+	if t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64 {
+		return json.Number(strconv.FormatInt(int64(value), 10)), nil
+	}
+	return json.Number(strconv.FormatUint(value, 10)), nil
+}
+
+// setNumber sets the numeric value from json.Number or from numbers decoded by YAML.
+func setNumber(rv reflect.Value, v any) error {
+	var s string
+	switch x := v.(type) {
+	case json.Number:
+		s = string(x)
+	case int:
+		s = strconv.Itoa(x)
+	case int64:
+		s = strconv.FormatInt(x, 10)
+	case uint64:
+		s = strconv.FormatUint(x, 10)
+	case float64:
+		s = strconv.FormatFloat(x, 'g', -1, 64)
+	default:
+		return fmt.Errorf("expected number, got %T", v)
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || rv.OverflowInt(x) {
+			return fmt.Errorf("invalid value %s for %v", s, rv.Type())
+		}
+		rv.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		x, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || rv.OverflowUint(x) {
+			return fmt.Errorf("invalid value %s for %v", s, rv.Type())
+		}
+		rv.SetUint(x)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(s, 64)
+		if err != nil || (rv.Kind() == reflect.Float32 && math.Abs(x) > math.MaxFloat32) {
+			return fmt.Errorf("invalid value %s for %v", s, rv.Type())
+		}
+		rv.SetFloat(x)
+	default:
+		return fmt.Errorf("unsupported type %v", rv.Type())
+	}
+	return nil
+}
+
+func byteSlice(rv reflect.Value) []byte {
+	b := make([]byte, rv.Len())
+	for i := range b {
+		b[i] = byte(rv.Index(i).Uint())
+	}
+	return b
+}
+
+func setBytes(rv reflect.Value, b []byte) error {
+	if rv.Kind() == reflect.Array {
+		if len(b) > rv.Len() {
+			return fmt.Errorf("too many bytes (%d), array length is %d", len(b), rv.Len())
+		}
+	} else if len(b) > 0 {
+		rv.Set(reflect.MakeSlice(rv.Type(), len(b), len(b)))
+	}
+	for i, x := range b {
+		rv.Index(i).SetUint(uint64(x))
+	}
+	return nil
+}
+
+// snakeCase converts the Go name of the union member to the VPP name,
+// e.g. IP4NTuple to ip4_n_tuple.
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func normalizeName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func fieldError(path string, err error) error {
+	if err == nil || path == "" {
+		return err
+	}
+	return fmt.Errorf("field %s: %w", path, err)
+}
+
+func typeError(path, expected string, v any) error {
+	return fieldError(path, fmt.Errorf("expected %s, got %T", expected, v))
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
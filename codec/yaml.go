@@ -0,0 +1,90 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.fd.io/govpp/api"
+)
+
+// DefaultYAMLCodec is the YAML codec used by EncodeYAML and DecodeYAML.
+var DefaultYAMLCodec = new(YAMLCodec)
+
+// EncodeYAML encodes the message into YAML using the DefaultYAMLCodec.
+func EncodeYAML(msg api.Message) ([]byte, error) {
+	return DefaultYAMLCodec.EncodeMsg(msg)
+}
+
+// DecodeYAML decodes the message from YAML using the DefaultYAMLCodec.
+func DecodeYAML(data []byte, msg api.Message) error {
+	return DefaultYAMLCodec.DecodeMsg(data, msg)
+}
+
+// YAMLCodec provides encoding and decoding of `api.Message` structs into/from
+// YAML, using the same representation of the fields as JSONCodec.
+type YAMLCodec struct{}
+
+func (*YAMLCodec) EncodeMsg(msg api.Message) ([]byte, error) {
+	v, err := messageToValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(yamlNode(v))
+}
+
+func (*YAMLCodec) DecodeMsg(data []byte, msg api.Message) error {
+	var v any
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	return valueToMessage(v, msg)
+}
+
+// yamlNode converts the tree of values to YAML node keeping the order of the fields.
+func yamlNode(v any) *yaml.Node {
+	switch x := v.(type) {
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(x)}
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(string(x), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: string(x)}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: x}
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for _, item := range x {
+			node.Content = append(node.Content, yamlNode(item))
+		}
+		return node
+	case *object:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for i, key := range x.keys {
+			node.Content = append(node.Content, yamlNode(key), yamlNode(x.values[i]))
+		}
+		return node
+	}
+	panic(fmt.Sprintf("unexpected value %T", v))
+}
@@ -72,12 +72,14 @@ type vppReply struct {
 type requestCtx struct {
 	ch     *Channel
 	seqNum uint16
+	req    api.Message
 }
 
 // multiRequestCtx is a context for request with multiple responses
 type multiRequestCtx struct {
 	ch     *Channel
 	seqNum uint16
+	req    api.Message
 }
 
 // subscriptionCtx is a context of subscription for delivery of specific notification messages.
@@ -159,13 +161,13 @@ func (ch *Channel) GetID() uint16 {
 func (ch *Channel) SendRequest(msg api.Message) api.RequestCtx {
 	req := ch.newRequest(msg, false)
 	ch.reqChan <- req
-	return &requestCtx{ch: ch, seqNum: req.seqNum}
+	return &requestCtx{ch: ch, seqNum: req.seqNum, req: msg}
 }
 
 func (ch *Channel) SendMultiRequest(msg api.Message) api.MultiRequestCtx {
 	req := ch.newRequest(msg, true)
 	ch.reqChan <- req
-	return &multiRequestCtx{ch: ch, seqNum: req.seqNum}
+	return &multiRequestCtx{ch: ch, seqNum: req.seqNum, req: msg}
 }
 
 func (ch *Channel) nextSeqNum() uint16 {
@@ -247,7 +249,7 @@ func (req *requestCtx) ReceiveReply(msg api.Message) error {
 		return ErrInvalidRequestCtx
 	}
 
-	lastReplyReceived, err := req.ch.receiveReplyInternal(msg, req.seqNum)
+	lastReplyReceived, err := req.ch.receiveReplyInternal(msg, req.seqNum, req.req)
 	if err != nil {
 		return err
 	} else if lastReplyReceived {
@@ -261,7 +263,7 @@ func (req *multiRequestCtx) ReceiveReply(msg api.Message) (lastReplyReceived boo
 		return false, ErrInvalidRequestCtx
 	}
 
-	return req.ch.receiveReplyInternal(msg, req.seqNum)
+	return req.ch.receiveReplyInternal(msg, req.seqNum, req.req)
 }
 
 func (sub *subscriptionCtx) Unsubscribe() error {
@@ -300,7 +302,8 @@ func (sub *subscriptionCtx) Unsubscribe() error {
 const maxInt64 = 1<<63 - 1
 
 // receiveReplyInternal receives a reply from the reply channel into the provided msg structure.
-func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (lastReplyReceived bool, err error) {
+// The request message is used to describe the request in the error returned for the reply.
+func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16, req api.Message) (lastReplyReceived bool, err error) {
 	if msg == nil {
 		return false, errors.New("nil message passed in")
 	}
@@ -310,7 +313,7 @@ func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (last
 	if vppReply := ch.delayedReply; vppReply != nil {
 		// try the delayed reply
 		ch.delayedReply = nil
-		ignore, lastReplyReceived, err = ch.processReply(vppReply, expSeqNum, msg)
+		ignore, lastReplyReceived, err = ch.processReply(vppReply, expSeqNum, msg, req)
 		if !ignore {
 			return lastReplyReceived, err
 		}
@@ -331,7 +334,7 @@ func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (last
 		select {
 		// blocks until a reply comes to ReplyChan or until timeout expires
 		case vppReply := <-ch.replyChan:
-			ignore, lastReplyReceived, err = ch.processReply(vppReply, expSeqNum, msg)
+			ignore, lastReplyReceived, err = ch.processReply(vppReply, expSeqNum, msg, req)
 			if ignore {
 				ch.logger.WithFields(logrus.Fields{
 					"expSeqNum": expSeqNum,
@@ -357,7 +360,7 @@ func (ch *Channel) receiveReplyInternal(msg api.Message, expSeqNum uint16) (last
 	}
 }
 
-func (ch *Channel) processReply(reply *vppReply, expSeqNum uint16, msg api.Message) (ignore bool, lastReplyReceived bool, err error) {
+func (ch *Channel) processReply(reply *vppReply, expSeqNum uint16, msg api.Message, req api.Message) (ignore bool, lastReplyReceived bool, err error) {
 	// check the sequence number
 	cmpSeqNums := compareSeqNumbers(reply.seqNum, expSeqNum)
 	if cmpSeqNums == -1 {
@@ -412,25 +415,51 @@ func (ch *Channel) processReply(reply *vppReply, expSeqNum uint16, msg api.Messa
 	}
 
 	// check Retval and convert it into VnetAPIError error
-	if strings.HasSuffix(msg.GetMessageName(), "_reply") {
-		// TODO: use categories for messages to avoid checking message name
-		if f := reflect.Indirect(reflect.ValueOf(msg)).FieldByName("Retval"); f.IsValid() {
-			var retval int32
-			switch f.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				retval = int32(f.Int())
-			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-				retval = int32(f.Uint())
-			default:
-				ch.logger.Warnf("invalid kind (%v) for Retval field of message %v", f.Kind(), msg.GetMessageName())
-			}
-			err = api.RetvalToVPPApiError(retval)
-		}
-	}
+	err = ch.replyError(req, msg, reply.seqNum)
 
 	return
 }
 
+// replyError returns the error for the Retval of the reply, or nil if the reply
+// has no Retval or it is zero. The error carries the context of the request.
+func (ch *Channel) replyError(req, reply api.Message, seqNum uint16) error {
+	// TODO: use categories for messages to avoid checking message name
+	if !strings.HasSuffix(reply.GetMessageName(), "_reply") {
+		return nil
+	}
+	f := reflect.Indirect(reflect.ValueOf(reply)).FieldByName("Retval")
+	if !f.IsValid() {
+		return nil
+	}
+	var retval int32
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		retval = int32(f.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		retval = int32(f.Uint())
+	default:
+		ch.logger.Warnf("invalid kind (%v) for Retval field of message %v", f.Kind(), reply.GetMessageName())
+	}
+	if retval == 0 {
+		return nil
+	}
+	if req == nil {
+		return api.RetvalToVPPApiError(retval)
+	}
+	replyErr := &api.ReplyError{
+		Request:    req.GetMessageName(),
+		RequestCRC: req.GetCrcString(),
+		Reply:      reply.GetMessageName(),
+		Retval:     api.VPPApiError(retval),
+		ChannelID:  ch.id,
+		SeqNum:     seqNum,
+	}
+	if ch.conn != nil {
+		replyErr.ConnID = ch.conn.connId
+	}
+	return replyErr
+}
+
 func (ch *Channel) Reset() {
 	if len(ch.reqChan) > 0 || len(ch.replyChan) > 0 {
 		ch.logger.WithField("channel", ch.id).Debugf("draining channel buffers (req: %d, reply: %d)", len(ch.reqChan), len(ch.replyChan))
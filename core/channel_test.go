@@ -15,6 +15,7 @@
 package core
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -123,6 +124,32 @@ func TestRequestReplyMemifCreate(t *testing.T) {
 		"Incorrect SwIfIndex value for MemifCreate")
 }
 
+func TestRequestReplyError(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	// mock reply
+	ctx.mockVpp.MockReply(&memif.MemifCreateReply{
+		Retval: int32(api.NO_SUCH_ENTRY),
+	})
+
+	request := &memif.MemifCreate{}
+	reply := &memif.MemifCreateReply{}
+
+	err := ctx.ch.SendRequest(request).ReceiveReply(reply)
+	Expect(errors.Is(err, api.NO_SUCH_ENTRY)).To(BeTrue())
+	Expect(api.IsNotFound(err)).To(BeTrue())
+
+	var replyErr *api.ReplyError
+	Expect(errors.As(err, &replyErr)).To(BeTrue())
+	Expect(replyErr.Request).To(Equal(request.GetMessageName()))
+	Expect(replyErr.RequestCRC).To(Equal(request.GetCrcString()))
+	Expect(replyErr.Reply).To(Equal(reply.GetMessageName()))
+	Expect(replyErr.ChannelID).To(Equal(ctx.ch.(*Channel).GetID()))
+	Expect(replyErr.ConnID).To(Equal(ctx.conn.connId))
+	Expect(err.Error()).To(ContainSubstring("memif_create"))
+}
+
 func TestRequestReplyMemifDelete(t *testing.T) {
 	ctx := setupTest(t)
 	defer ctx.teardownTest()
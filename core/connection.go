@@ -121,10 +121,13 @@ type Connection struct {
 	async             bool          // connection to be operated in async mode
 	healthCheckExited chan struct{} // used to notify Disconnect() callers about healthcheck loop exit
 
-	codec MessageCodec // message codec
+	codec    MessageCodec              // message codec
+	validate bool                      // validate requests before sending
+	limits   map[string]map[string]int // field limits of messages by message name and CRC
 
 	msgMapByPathLock sync.RWMutex                      // lock for the msgMapByPath map
 	msgMapByPath     map[string]map[uint16]api.Message // map of messages indexed by message ID which are indexed by path
+	runtimeMsgs      []api.Message                     // messages registered by RegisterMessages
 
 	channelsLock  sync.RWMutex        // lock for the channels map and the channel ID
 	channels      map[uint16]*Channel // map of all API channels indexed by the channel ID
@@ -163,7 +166,38 @@ const (
 	resume
 )
 
-func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration, async bool) *Connection {
+// ConnectionOption modifies the connection before connecting to VPP.
+type ConnectionOption func(*Connection)
+
+// WithCodec sets the codec used for encoding and decoding messages,
+// e.g. codec.NewMsgCodec(codec.Strict) for strict decoding of replies.
+func WithCodec(msgCodec MessageCodec) ConnectionOption {
+	return func(c *Connection) {
+		c.codec = msgCodec
+	}
+}
+
+// WithRequestValidation enables validation of every outgoing request against
+// the constraints of the API definition using codec.ValidateMsg. The invalid
+// requests are not sent to VPP and fail with *codec.ValidationError.
+func WithRequestValidation() ConnectionOption {
+	return func(c *Connection) {
+		c.validate = true
+	}
+}
+
+// WithRequestLimits enables validation of every outgoing request the same as
+// WithRequestValidation, using also the field limits missing in the binapi
+// messages, e.g. derived from the API definitions of the connected VPP by
+// vppapi.FieldLimits.
+func WithRequestLimits(limits map[string]map[string]int) ConnectionOption {
+	return func(c *Connection) {
+		c.validate = true
+		c.limits = limits
+	}
+}
+
+func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration, async bool, options ...ConnectionOption) *Connection {
 	if attempts == 0 {
 		attempts = DefaultMaxReconnectAttempts
 	}
@@ -188,6 +222,9 @@ func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration,
 		msgControlPingReply: msgControlPingReply,
 		channelIdPool:       newIDPool(0x7fff),
 	}
+	for _, option := range options {
+		option(c)
+	}
 	c.logger = log.WithFields(logrus.Fields{"connId": c.connId})
 	if async {
 		c.logger = c.logger.WithField("async", true)
@@ -219,9 +256,9 @@ func newConnection(binapi adapter.VppAPI, attempts int, interval time.Duration,
 // Connect connects to VPP API using specified adapter and returns a connection handle.
 // This call blocks until it is either connected, or an error occurs.
 // Only one connection attempt will be performed.
-func Connect(binapi adapter.VppAPI) (*Connection, error) {
+func Connect(binapi adapter.VppAPI, options ...ConnectionOption) (*Connection, error) {
 	// create new connection handle
-	c := newConnection(binapi, DefaultMaxReconnectAttempts, DefaultReconnectInterval, false)
+	c := newConnection(binapi, DefaultMaxReconnectAttempts, DefaultReconnectInterval, false, options...)
 
 	// blocking attempt to connect to VPP
 	if err := c.connectVPP(); err != nil {
@@ -235,10 +272,10 @@ func Connect(binapi adapter.VppAPI) (*Connection, error) {
 // and ConnectionState channel. This call does not block until connection is established, it
 // returns immediately. The caller is supposed to watch the returned ConnectionState channel for
 // Connected/Disconnected events. In case of disconnect, the library will asynchronously try to reconnect.
-func AsyncConnect(binapi adapter.VppAPI, attempts int, interval time.Duration) (*Connection, chan ConnectionEvent, error) {
+func AsyncConnect(binapi adapter.VppAPI, attempts int, interval time.Duration, options ...ConnectionOption) (*Connection, chan ConnectionEvent, error) {
 
 	// create new connection handle
-	conn := newConnection(binapi, attempts, interval, true)
+	conn := newConnection(binapi, attempts, interval, true, options...)
 
 	atomic.StoreUint32(&conn.backgroundLoopActive, 1)
 
@@ -504,8 +541,16 @@ func getMsgID(name, crc string) string {
 
 func getMsgFactory(msg api.Message) func() api.Message {
 	return func() api.Message {
-		return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+		return newMessage(msg)
+	}
+}
+
+// newMessage returns a new instance of the message.
+func newMessage(msg api.Message) api.Message {
+	if f, ok := msg.(api.MessageFactory); ok {
+		return f.NewMessage()
 	}
+	return reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
 }
 
 // GetMessageID returns message identifier of given API message.
@@ -534,6 +579,37 @@ func (c *Connection) GetMessagePath(msg api.Message) string {
 	return path.Dir(reflect.TypeOf(msg).Elem().PkgPath())
 }
 
+// RegisterMessages registers the messages without generated binapi types,
+// e.g. defined at runtime from the API definitions, so that they can be
+// received. The IDs of the messages are retrieved now and after every
+// reconnect, the messages unknown to VPP are skipped.
+func (c *Connection) RegisterMessages(msgs ...api.Message) {
+	c.msgMapByPathLock.Lock()
+	defer c.msgMapByPathLock.Unlock()
+
+	c.runtimeMsgs = append(c.runtimeMsgs, msgs...)
+	c.addRuntimeMessages(msgs)
+}
+
+// addRuntimeMessages stores the messages registered by RegisterMessages by
+// their IDs, the msgMapByPathLock must be held.
+func (c *Connection) addRuntimeMessages(msgs []api.Message) {
+	for _, msg := range msgs {
+		msgID, err := c.GetMessageID(msg)
+		if err != nil {
+			if isDebugOn(debugOptMsgId) {
+				c.logger.Warnf("failed to retrieve message ID for %s: %v", msg.GetMessageName(), err)
+			}
+			continue
+		}
+		pkgPath := c.GetMessagePath(msg)
+		if c.msgMapByPath[pkgPath] == nil {
+			c.msgMapByPath[pkgPath] = make(map[uint16]api.Message)
+		}
+		c.msgMapByPath[pkgPath][msgID] = msg
+	}
+}
+
 // retrieveMessageIDs retrieves IDs for all registered messages and stores them in map
 func (c *Connection) retrieveMessageIDs() (err error) {
 	msgsByPath := api.GetRegisteredMessages()
@@ -581,6 +657,10 @@ func (c *Connection) retrieveMessageIDs() (err error) {
 				Debugf("retrieved IDs for %d/%d messages", nn, len(msgs))
 		}
 	}
+	c.msgMapByPathLock.Lock()
+	c.addRuntimeMessages(c.runtimeMsgs)
+	c.msgMapByPathLock.Unlock()
+
 	if debugMsgIDs {
 		c.logger.WithField("took", time.Since(t)).
 			Debugf("done retrieving IDs for %d messages", n)
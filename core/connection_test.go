@@ -29,6 +29,7 @@ import (
 	interfaces "go.fd.io/govpp/binapi/interface"
 	"go.fd.io/govpp/binapi/interface_types"
 	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapi/vlib"
 	"go.fd.io/govpp/codec"
 	"go.fd.io/govpp/core"
 )
@@ -517,3 +518,58 @@ func TestCycleOverSetOfSequenceNumbers(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestValidation(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp, core.WithRequestValidation())
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	// invalid request is not sent
+	req := &interfaces.SwInterfaceTagAddDel{Tag: "this-tag-is-longer-than-sixty-four-characters-which-vpp-would-truncate"}
+	err = ch.SendRequest(req).ReceiveReply(&interfaces.SwInterfaceTagAddDelReply{})
+	var validationErr *codec.ValidationError
+	Expect(errors.As(err, &validationErr)).To(BeTrue(), "unexpected error: %v", err)
+	Expect(validationErr.Path).To(Equal("tag"))
+
+	// valid request is sent
+	mockVpp.MockReply(&interfaces.SwInterfaceTagAddDelReply{})
+	req.Tag = "short"
+	err = ch.SendRequest(req).ReceiveReply(&interfaces.SwInterfaceTagAddDelReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestRequestLimits(t *testing.T) {
+	RegisterTestingT(t)
+
+	mockVpp := mock.NewVppAdapter()
+	limits := map[string]map[string]int{
+		"cli_inband_" + (&vlib.CliInband{}).GetCrcString(): {"cmd": 8},
+	}
+	conn, err := core.Connect(mockVpp, core.WithRequestLimits(limits))
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ShouldNot(HaveOccurred())
+	defer ch.Close()
+
+	// request exceeding the limit is not sent
+	req := &vlib.CliInband{Cmd: "show version"}
+	err = ch.SendRequest(req).ReceiveReply(&vlib.CliInbandReply{})
+	var validationErr *codec.ValidationError
+	Expect(errors.As(err, &validationErr)).To(BeTrue(), "unexpected error: %v", err)
+	Expect(validationErr.Path).To(Equal("cmd"))
+
+	// request within the limit is sent
+	mockVpp.MockReply(&vlib.CliInbandReply{})
+	req.Cmd = "show"
+	err = ch.SendRequest(req).ReceiveReply(&vlib.CliInbandReply{})
+	Expect(err).ShouldNot(HaveOccurred())
+}
@@ -17,13 +17,13 @@ package core
 import (
 	"errors"
 	"fmt"
-	"reflect"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
 )
 
 var ReplyChannelTimeout = time.Millisecond * 100
@@ -79,6 +79,15 @@ func (c *Connection) processRequest(ch *Channel, req *vppRequest) error {
 		return err
 	}
 
+	// validate the message against the API definition
+	if c.validate {
+		limits := c.limits[req.msg.GetMessageName()+"_"+req.msg.GetCrcString()]
+		if err := codec.ValidateMsgLimits(req.msg, limits); err != nil {
+			newLog().WithField("error", err).Warnf("Invalid message: %T %+v", req.msg, req.msg)
+			return err
+		}
+	}
+
 	// retrieve message ID
 	msgID, err := c.GetMessageID(req.msg)
 	if err != nil {
@@ -228,7 +237,7 @@ func (c *Connection) msgCallback(msgID uint16, data []byte) {
 		var timestamp time.Time
 		timestamp, _ = c.trace.registerNew()
 		decoded = true
-		msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+		msg = newMessage(msg)
 		if err = c.codec.DecodeMsg(data, msg); err != nil {
 			newLog(msg, context, chanID, seqNum, isMulti).Debugf("Unable to decode message: %v", err)
 		} else {
@@ -246,7 +255,7 @@ func (c *Connection) msgCallback(msgID uint16, data []byte) {
 	if log.Level >= logrus.DebugLevel { // for performance reasons - logrus does some processing even if debugs are disabled
 		if !decoded {
 			decoded = true
-			msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+			msg = newMessage(msg)
 			if err = c.codec.DecodeMsg(data, msg); err != nil {
 				newLog(msg, context, chanID, seqNum, isMulti).Debugf("Unable to decode message: %v", err)
 			}
@@ -266,7 +275,7 @@ func (c *Connection) msgCallback(msgID uint16, data []byte) {
 	c.channelsLock.RUnlock()
 	if !ok {
 		if !decoded {
-			msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+			msg = newMessage(msg)
 			if err = c.codec.DecodeMsg(data, msg); err != nil {
 				newLog(msg, context, chanID, seqNum, isMulti).Debugf("Unable to decode message: %v", err)
 			}
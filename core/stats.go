@@ -1,12 +1,17 @@
 package core
 
 import (
+	"container/list"
+	"context"
 	"path"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.fd.io/govpp/adapter"
 	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/vlib"
 )
 
 var (
@@ -75,6 +80,9 @@ const (
 	NetworkStats_Punt      = NetworkStatsPrefix + "punt"
 )
 
+// implements StatsProviderContext
+var _ api.StatsProviderContext = (*StatsConnection)(nil)
+
 type StatsConnection struct {
 	statsClient adapter.StatsAPI
 
@@ -90,6 +98,13 @@ type StatsConnection struct {
 	sysStatsData   *adapter.StatDir
 	bufStatsData   *adapter.StatDir
 	memStatsData   *adapter.StatDir
+
+	queryMu        sync.Mutex
+	queryDirs      map[string]*list.Element // stat dirs cached per query patterns
+	queryLRU       list.List                // cached stat dirs, most recently used first
+	queryNamesData *adapter.StatDir
+
+	threadNames atomic.Pointer[[]string] // names of threads for per-thread stats
 }
 
 func newStatsConnection(stats adapter.StatsAPI, attempts int, interval time.Duration) *StatsConnection {
@@ -205,20 +220,20 @@ func (c *StatsConnection) monitorSocket() {
 	}
 }
 
-func (c *StatsConnection) updateStats(statDir **adapter.StatDir, patterns ...string) error {
+func (c *StatsConnection) updateStats(ctx context.Context, statDir **adapter.StatDir, patterns ...string) error {
 	if statDir == nil {
 		panic("statDir must not nil")
 	}
 	try := func() error {
 		if (*statDir) == nil {
-			dir, err := c.statsClient.PrepareDir(patterns...)
+			dir, err := c.prepareDir(ctx, patterns...)
 			if err != nil {
 				log.Debugln("preparing dir failed:", err)
 				return err
 			}
 			*statDir = dir
 		} else {
-			if err := c.statsClient.UpdateDir(*statDir); err != nil {
+			if err := c.updateDir(ctx, *statDir); err != nil {
 				log.Debugln("updating dir failed:", err)
 				*statDir = nil
 				return err
@@ -238,7 +253,11 @@ func (c *StatsConnection) updateStats(statDir **adapter.StatDir, patterns ...str
 			// retrying
 			if r > 1 {
 				log.Debugf("sleeping for %v before next try", RetryUpdateDelay)
-				time.Sleep(RetryUpdateDelay)
+				select {
+				case <-time.After(RetryUpdateDelay):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
 			}
 		} else {
 			// error is not retryable
@@ -248,9 +267,36 @@ func (c *StatsConnection) updateStats(statDir **adapter.StatDir, patterns ...str
 	return err
 }
 
+// prepareDir prepares the stat dir using the context-aware adapter call if supported.
+func (c *StatsConnection) prepareDir(ctx context.Context, patterns ...string) (*adapter.StatDir, error) {
+	if statsClient, ok := c.statsClient.(adapter.StatsAPIContext); ok {
+		return statsClient.PrepareDirContext(ctx, patterns...)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.statsClient.PrepareDir(patterns...)
+}
+
+// updateDir updates the stat dir using the context-aware adapter call if supported.
+func (c *StatsConnection) updateDir(ctx context.Context, dir *adapter.StatDir) error {
+	if statsClient, ok := c.statsClient.(adapter.StatsAPIContext); ok {
+		return statsClient.UpdateDirContext(ctx, dir)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.statsClient.UpdateDir(dir)
+}
+
 // GetSystemStats retrieves VPP system stats.
 func (c *StatsConnection) GetSystemStats(sysStats *api.SystemStats) (err error) {
-	if err := c.updateStats(&c.sysStatsData, SystemStatsPrefix); err != nil {
+	return c.GetSystemStatsContext(context.Background(), sysStats)
+}
+
+// GetSystemStatsContext retrieves VPP system stats.
+func (c *StatsConnection) GetSystemStatsContext(ctx context.Context, sysStats *api.SystemStats) (err error) {
+	if err := c.updateStats(ctx, &c.sysStatsData, SystemStatsPrefix); err != nil {
 		return err
 	}
 
@@ -292,14 +338,21 @@ func (c *StatsConnection) GetSystemStats(sysStats *api.SystemStats) (err error)
 
 // GetErrorStats retrieves VPP error stats.
 func (c *StatsConnection) GetErrorStats(errorStats *api.ErrorStats) (err error) {
-	if err := c.updateStats(&c.errorStatsData, CounterStatsPrefix); err != nil {
+	return c.GetErrorStatsContext(context.Background(), errorStats)
+}
+
+// GetErrorStatsContext retrieves VPP error stats.
+func (c *StatsConnection) GetErrorStatsContext(ctx context.Context, errorStats *api.ErrorStats) (err error) {
+	if err := c.updateStats(ctx, &c.errorStatsData, CounterStatsPrefix); err != nil {
 		return err
 	}
 
 	if errorStats.Errors == nil || len(errorStats.Errors) != len(c.errorStatsData.Entries) {
 		errorStats.Errors = make([]api.ErrorCounter, len(c.errorStatsData.Entries))
 		for i := 0; i < len(c.errorStatsData.Entries); i++ {
-			errorStats.Errors[i].CounterName = string(c.errorStatsData.Entries[i].Name)
+			name := string(c.errorStatsData.Entries[i].Name)
+			errorStats.Errors[i].CounterName = name
+			errorStats.Errors[i].Node, errorStats.Errors[i].Reason = SplitErrorCounterName(name)
 		}
 	}
 
@@ -324,8 +377,55 @@ func (c *StatsConnection) GetErrorStats(errorStats *api.ErrorStats) (err error)
 	return nil
 }
 
+// nodeCounters maps node stats to the node counter fields, it is used by
+// both the aggregate and the per thread node stats
+var nodeCounters = map[string]func(*api.NodeCounters, uint64){
+	NodeStats_Clocks:   func(node *api.NodeCounters, val uint64) { node.Clocks = val },
+	NodeStats_Vectors:  func(node *api.NodeCounters, val uint64) { node.Vectors = val },
+	NodeStats_Calls:    func(node *api.NodeCounters, val uint64) { node.Calls = val },
+	NodeStats_Suspends: func(node *api.NodeCounters, val uint64) { node.Suspends = val },
+}
+
+// interfaceCounters maps simple counter interface stats to the interface counter fields,
+// it is used by both the aggregate and the per thread interface stats
+var interfaceCounters = map[string]func(*api.InterfaceCounters, uint64){
+	InterfaceStats_Drops:   func(iface *api.InterfaceCounters, val uint64) { iface.Drops = val },
+	InterfaceStats_Punt:    func(iface *api.InterfaceCounters, val uint64) { iface.Punts = val },
+	InterfaceStats_IP4:     func(iface *api.InterfaceCounters, val uint64) { iface.IP4 = val },
+	InterfaceStats_IP6:     func(iface *api.InterfaceCounters, val uint64) { iface.IP6 = val },
+	InterfaceStats_RxNoBuf: func(iface *api.InterfaceCounters, val uint64) { iface.RxNoBuf = val },
+	InterfaceStats_RxMiss:  func(iface *api.InterfaceCounters, val uint64) { iface.RxMiss = val },
+	InterfaceStats_RxError: func(iface *api.InterfaceCounters, val uint64) { iface.RxErrors = val },
+	InterfaceStats_TxError: func(iface *api.InterfaceCounters, val uint64) { iface.TxErrors = val },
+	InterfaceStats_Mpls:    func(iface *api.InterfaceCounters, val uint64) { iface.Mpls = val },
+}
+
+// interfaceCombinedCounters maps combined counter interface stats to the interface counter fields
+var interfaceCombinedCounters = map[string]func(*api.InterfaceCounters, [2]uint64){
+	InterfaceStats_Rx:          func(iface *api.InterfaceCounters, val [2]uint64) { iface.Rx = combined(val) },
+	InterfaceStats_RxUnicast:   func(iface *api.InterfaceCounters, val [2]uint64) { iface.RxUnicast = combined(val) },
+	InterfaceStats_RxMulticast: func(iface *api.InterfaceCounters, val [2]uint64) { iface.RxMulticast = combined(val) },
+	InterfaceStats_RxBroadcast: func(iface *api.InterfaceCounters, val [2]uint64) { iface.RxBroadcast = combined(val) },
+	InterfaceStats_Tx:          func(iface *api.InterfaceCounters, val [2]uint64) { iface.Tx = combined(val) },
+	InterfaceStats_TxUnicast:   func(iface *api.InterfaceCounters, val [2]uint64) { iface.TxUnicast = combined(val) },
+	// tx-unicast-miss was a spelling mistake in older versions
+	InterfaceStats_TxUnicastMiss: func(iface *api.InterfaceCounters, val [2]uint64) { iface.TxUnicast = combined(val) },
+	InterfaceStats_TxMulticast:   func(iface *api.InterfaceCounters, val [2]uint64) { iface.TxMulticast = combined(val) },
+	InterfaceStats_TxBroadcast:   func(iface *api.InterfaceCounters, val [2]uint64) { iface.TxBroadcast = combined(val) },
+}
+
+func combined(val [2]uint64) api.InterfaceCounterCombined {
+	return api.InterfaceCounterCombined{Packets: val[0], Bytes: val[1]}
+}
+
+// GetNodeStats retrieves VPP per node stats.
 func (c *StatsConnection) GetNodeStats(nodeStats *api.NodeStats) (err error) {
-	if err := c.updateStats(&c.nodeStatsData, NodeStatsPrefix); err != nil {
+	return c.GetNodeStatsContext(context.Background(), nodeStats)
+}
+
+// GetNodeStatsContext retrieves VPP per node stats.
+func (c *StatsConnection) GetNodeStatsContext(ctx context.Context, nodeStats *api.NodeStats) (err error) {
+	if err := c.updateStats(ctx, &c.nodeStatsData, NodeStatsPrefix); err != nil {
 		return err
 	}
 
@@ -359,22 +459,62 @@ func (c *StatsConnection) GetNodeStats(nodeStats *api.NodeStats) (err error) {
 					}
 				}
 			}
-		case NodeStats_Clocks:
-			perNode(stat, func(node *api.NodeCounters, val uint64) {
-				node.Clocks = val
-			})
-		case NodeStats_Vectors:
-			perNode(stat, func(node *api.NodeCounters, val uint64) {
-				node.Vectors = val
-			})
-		case NodeStats_Calls:
-			perNode(stat, func(node *api.NodeCounters, val uint64) {
-				node.Calls = val
-			})
-		case NodeStats_Suspends:
-			perNode(stat, func(node *api.NodeCounters, val uint64) {
-				node.Suspends = val
-			})
+		default:
+			if fn, ok := nodeCounters[string(stat.Name)]; ok {
+				perNode(stat, fn)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetNodeThreadStats retrieves VPP per node stats for every thread separately.
+func (c *StatsConnection) GetNodeThreadStats(nodeStats *api.NodeThreadStats) (err error) {
+	return c.GetNodeThreadStatsContext(context.Background(), nodeStats)
+}
+
+// GetNodeThreadStatsContext retrieves VPP per node stats for every thread separately.
+func (c *StatsConnection) GetNodeThreadStatsContext(ctx context.Context, nodeStats *api.NodeThreadStats) (err error) {
+	if err := c.updateStats(ctx, &c.nodeStatsData, NodeStatsPrefix); err != nil {
+		return err
+	}
+
+	var names adapter.NameStat
+	prepThreads := func(s adapter.SimpleCounterStat) {
+		nodeStats.Threads = prepNodeThreads(nodeStats.Threads, len(s))
+		for t, thread := range s {
+			th := &nodeStats.Threads[t]
+			if th.Nodes == nil || len(th.Nodes) != len(thread) {
+				th.Nodes = make([]api.NodeCounters, len(thread))
+				for i := range th.Nodes {
+					th.Nodes[i].NodeIndex = uint32(i)
+				}
+			}
+		}
+	}
+
+	for _, stat := range c.nodeStatsData.Entries {
+		name := string(stat.Name)
+		if name == NodeStats_Names {
+			names, _ = stat.Data.(adapter.NameStat)
+		} else if fn, ok := nodeCounters[name]; ok {
+			if s, ok := stat.Data.(adapter.SimpleCounterStat); ok {
+				prepThreads(s)
+				for t, thread := range s {
+					for i, val := range thread {
+						fn(&nodeStats.Threads[t].Nodes[i], uint64(val))
+					}
+				}
+			}
+		}
+	}
+	for t := range nodeStats.Threads {
+		nodeStats.Threads[t].ThreadName = c.threadName(t)
+		for i := range nodeStats.Threads[t].Nodes {
+			if i < len(names) {
+				nodeStats.Threads[t].Nodes[i].NodeName = string(names[i])
+			}
 		}
 	}
 
@@ -383,7 +523,12 @@ func (c *StatsConnection) GetNodeStats(nodeStats *api.NodeStats) (err error) {
 
 // GetInterfaceStats retrieves VPP per interface stats.
 func (c *StatsConnection) GetInterfaceStats(ifaceStats *api.InterfaceStats) (err error) {
-	if err := c.updateStats(&c.ifaceStatsData, InterfaceStatsPrefix); err != nil {
+	return c.GetInterfaceStatsContext(context.Background(), ifaceStats)
+}
+
+// GetInterfaceStatsContext retrieves VPP per interface stats.
+func (c *StatsConnection) GetInterfaceStatsContext(ctx context.Context, ifaceStats *api.InterfaceStats) (err error) {
+	if err := c.updateStats(ctx, &c.ifaceStatsData, InterfaceStatsPrefix); err != nil {
 		return err
 	}
 
@@ -426,95 +571,144 @@ func (c *StatsConnection) GetInterfaceStats(ifaceStats *api.InterfaceStats) (err
 					}
 				}
 			}
-		case InterfaceStats_Drops:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.Drops = val
-			})
-		case InterfaceStats_Punt:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.Punts = val
-			})
-		case InterfaceStats_IP4:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.IP4 = val
-			})
-		case InterfaceStats_IP6:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.IP6 = val
-			})
-		case InterfaceStats_RxNoBuf:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.RxNoBuf = val
-			})
-		case InterfaceStats_RxMiss:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.RxMiss = val
-			})
-		case InterfaceStats_RxError:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.RxErrors = val
-			})
-		case InterfaceStats_TxError:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.TxErrors = val
-			})
-		case InterfaceStats_Mpls:
-			perNode(stat, func(iface *api.InterfaceCounters, val uint64) {
-				iface.Mpls = val
-			})
-		case InterfaceStats_Rx:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.Rx.Packets = val[0]
-				iface.Rx.Bytes = val[1]
-			})
-		case InterfaceStats_RxUnicast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.RxUnicast.Packets = val[0]
-				iface.RxUnicast.Bytes = val[1]
-			})
-		case InterfaceStats_RxMulticast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.RxMulticast.Packets = val[0]
-				iface.RxMulticast.Bytes = val[1]
-			})
-		case InterfaceStats_RxBroadcast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.RxBroadcast.Packets = val[0]
-				iface.RxBroadcast.Bytes = val[1]
-			})
-		case InterfaceStats_Tx:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.Tx.Packets = val[0]
-				iface.Tx.Bytes = val[1]
-			})
-		case InterfaceStats_TxUnicastMiss:
-			// tx-unicast-miss was a spelling mistake in older versions
-			//
-			fallthrough
-		case InterfaceStats_TxUnicast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.TxUnicast.Packets = val[0]
-				iface.TxUnicast.Bytes = val[1]
-			})
-		case InterfaceStats_TxMulticast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.TxMulticast.Packets = val[0]
-				iface.TxMulticast.Bytes = val[1]
-			})
-		case InterfaceStats_TxBroadcast:
-			perNodeComb(stat, func(iface *api.InterfaceCounters, val [2]uint64) {
-				iface.TxBroadcast.Packets = val[0]
-				iface.TxBroadcast.Bytes = val[1]
-			})
+		default:
+			if fn, ok := interfaceCounters[string(stat.Name)]; ok {
+				perNode(stat, fn)
+			} else if fn, ok := interfaceCombinedCounters[string(stat.Name)]; ok {
+				perNodeComb(stat, fn)
+			}
 		}
 	}
 
 	return nil
 }
 
+// GetInterfaceThreadStats retrieves VPP per interface stats for every thread separately.
+func (c *StatsConnection) GetInterfaceThreadStats(ifaceStats *api.InterfaceThreadStats) (err error) {
+	return c.GetInterfaceThreadStatsContext(context.Background(), ifaceStats)
+}
+
+// GetInterfaceThreadStatsContext retrieves VPP per interface stats for every thread separately.
+func (c *StatsConnection) GetInterfaceThreadStatsContext(ctx context.Context, ifaceStats *api.InterfaceThreadStats) (err error) {
+	if err := c.updateStats(ctx, &c.ifaceStatsData, InterfaceStatsPrefix); err != nil {
+		return err
+	}
+
+	var names adapter.NameStat
+	prepThread := func(t, l int) *api.InterfaceThreadCounters {
+		th := &ifaceStats.Threads[t]
+		if th.Interfaces == nil || len(th.Interfaces) != l {
+			th.Interfaces = make([]api.InterfaceCounters, l)
+			for i := range th.Interfaces {
+				th.Interfaces[i].InterfaceIndex = uint32(i)
+			}
+		}
+		return th
+	}
+
+	for _, stat := range c.ifaceStatsData.Entries {
+		name := string(stat.Name)
+		if name == InterfaceStats_Names {
+			names, _ = stat.Data.(adapter.NameStat)
+		} else if fn, ok := interfaceCounters[name]; ok {
+			if s, ok := stat.Data.(adapter.SimpleCounterStat); ok {
+				ifaceStats.Threads = prepInterfaceThreads(ifaceStats.Threads, len(s))
+				for t, thread := range s {
+					th := prepThread(t, len(thread))
+					for i, val := range thread {
+						fn(&th.Interfaces[i], uint64(val))
+					}
+				}
+			}
+		} else if fn, ok := interfaceCombinedCounters[name]; ok {
+			if s, ok := stat.Data.(adapter.CombinedCounterStat); ok {
+				ifaceStats.Threads = prepInterfaceThreads(ifaceStats.Threads, len(s))
+				for t, thread := range s {
+					th := prepThread(t, len(thread))
+					for i, val := range thread {
+						fn(&th.Interfaces[i], val)
+					}
+				}
+			}
+		}
+	}
+	for t := range ifaceStats.Threads {
+		ifaceStats.Threads[t].ThreadName = c.threadName(t)
+		for i := range ifaceStats.Threads[t].Interfaces {
+			if i < len(names) {
+				ifaceStats.Threads[t].Interfaces[i].InterfaceName = string(names[i])
+			}
+		}
+	}
+
+	return nil
+}
+
+// prepInterfaceThreads resizes the per-thread interface counters to the number of threads.
+func prepInterfaceThreads(threads []api.InterfaceThreadCounters, l int) []api.InterfaceThreadCounters {
+	if len(threads) == l {
+		return threads
+	}
+	threads = make([]api.InterfaceThreadCounters, l)
+	for t := range threads {
+		threads[t].ThreadIndex = uint32(t)
+	}
+	return threads
+}
+
+// prepNodeThreads resizes the per-thread node counters to the number of threads.
+func prepNodeThreads(threads []api.NodeThreadCounters, l int) []api.NodeThreadCounters {
+	if len(threads) == l {
+		return threads
+	}
+	threads = make([]api.NodeThreadCounters, l)
+	for t := range threads {
+		threads[t].ThreadIndex = uint32(t)
+	}
+	return threads
+}
+
+// SetThreadNames sets names of the VPP threads indexed by thread index, which
+// are used for the per-thread stats. The stats segment does not contain the
+// thread names, they can be retrieved by GetThreadNames. The thread name is
+// empty for threads without the name.
+func (c *StatsConnection) SetThreadNames(names []string) {
+	c.threadNames.Store(&names)
+}
+
+// threadName returns name of the VPP thread set by SetThreadNames.
+func (c *StatsConnection) threadName(index int) string {
+	if names := c.threadNames.Load(); names != nil && index < len(*names) {
+		return (*names)[index]
+	}
+	return ""
+}
+
+// GetThreadNames retrieves names of the VPP threads indexed by thread index,
+// the same as the per-thread stats, using the show_threads request.
+func GetThreadNames(ctx context.Context, conn api.Connection) ([]string, error) {
+	reply, err := vlib.NewServiceClient(conn).ShowThreads(ctx, new(vlib.ShowThreads))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, thread := range reply.ThreadData {
+		for int(thread.ID) >= len(names) {
+			names = append(names, "")
+		}
+		names[thread.ID] = thread.Name
+	}
+	return names, nil
+}
+
 // GetBufferStats retrieves VPP buffer pools stats.
 func (c *StatsConnection) GetBufferStats(bufStats *api.BufferStats) (err error) {
-	if err := c.updateStats(&c.bufStatsData, BufferStatsPrefix); err != nil {
+	return c.GetBufferStatsContext(context.Background(), bufStats)
+}
+
+// GetBufferStatsContext retrieves VPP buffer pools stats.
+func (c *StatsConnection) GetBufferStatsContext(ctx context.Context, bufStats *api.BufferStats) (err error) {
+	if err := c.updateStats(ctx, &c.bufStatsData, BufferStatsPrefix); err != nil {
 		return err
 	}
 
@@ -554,8 +748,14 @@ func (c *StatsConnection) GetBufferStats(bufStats *api.BufferStats) (err error)
 	return nil
 }
 
+// GetMemoryStats retrieves VPP memory stats.
 func (c *StatsConnection) GetMemoryStats(memStats *api.MemoryStats) (err error) {
-	if err := c.updateStats(&c.memStatsData, MemoryStatSegPrefix, MemoryStatSegment, MemoryMainHeap); err != nil {
+	return c.GetMemoryStatsContext(context.Background(), memStats)
+}
+
+// GetMemoryStatsContext retrieves VPP memory stats.
+func (c *StatsConnection) GetMemoryStatsContext(ctx context.Context, memStats *api.MemoryStats) (err error) {
+	if err := c.updateStats(ctx, &c.memStatsData, MemoryStatSegPrefix, MemoryStatSegment, MemoryMainHeap); err != nil {
 		return err
 	}
 	convertStats := func(stats []adapter.Counter) api.MemoryCounters {
@@ -0,0 +1,188 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// SplitErrorCounterName splits the name of the error counter, e.g.
+// /err/ip4-input/bad checksum, into the node name and the error reason.
+// Both are empty if the name is not an error counter name.
+func SplitErrorCounterName(name string) (node, reason string) {
+	if !strings.HasPrefix(name, CounterStatsPrefix) {
+		return "", ""
+	}
+	node, reason, _ = strings.Cut(strings.TrimPrefix(name, CounterStatsPrefix), "/")
+	return node, reason
+}
+
+// ErrorCounterInfo describes a single error counter of the running VPP.
+type ErrorCounterInfo struct {
+	// Name is the stats entry name, e.g. /err/ip4-input/bad checksum.
+	Name string
+	// Node is the name of the node counting the error, e.g. ip4-input.
+	Node string
+	// Reason is the error reason, e.g. bad checksum.
+	Reason string
+	// Index is the index of the stats entry in the stats directory.
+	Index uint32
+	// Severity is the severity of the error, e.g. error or info.
+	// It is empty unless the counter definitions are loaded.
+	Severity string
+	// Description is the description of the error.
+	// It is empty unless the counter definitions are loaded.
+	Description string
+}
+
+// ErrorRegistry resolves error counters of the running VPP from the /err/
+// entries of the stats segment. Unlike static tables, the registry always
+// contains the exact node names and error reasons of the VPP version it
+// is connected to. The registry is rebuilt when the stats directory changes.
+//
+// The severities and descriptions of the errors come from the counter
+// definitions of the VPP API files, see LoadCounters. The descriptions of
+// the VPP API errors returned by the replies are registered separately
+// with api.RegisterVPPApiErrors.
+type ErrorRegistry struct {
+	conn *StatsConnection
+
+	mu     sync.RWMutex
+	dir    *adapter.StatDir
+	epoch  int64
+	defs   map[string]vppapi.Element
+	byName map[string]ErrorCounterInfo
+	byNode map[string][]ErrorCounterInfo
+}
+
+// NewErrorRegistry returns a new error counter registry for the stats connection.
+// The registry is empty until refreshed.
+func NewErrorRegistry(conn *StatsConnection) *ErrorRegistry {
+	return &ErrorRegistry{
+		conn: conn,
+	}
+}
+
+// LoadCounters loads the error counter definitions from the counters and
+// paths of the VPP API schema, e.g. parsed from the API files installed
+// with the running VPP. The definitions apply from the next Refresh.
+func (r *ErrorRegistry) LoadCounters(schema *vppapi.Schema) {
+	counters := make(map[string][]vppapi.Element)
+	for _, file := range schema.Files {
+		for _, counter := range file.Counters {
+			counters[counter.Name] = counter.Elements
+		}
+	}
+	defs := make(map[string]vppapi.Element)
+	for _, file := range schema.Files {
+		for _, paths := range file.Paths {
+			for _, path := range paths.Paths {
+				if !strings.HasPrefix(path, CounterStatsPrefix) {
+					continue
+				}
+				for _, elem := range counters[paths.Name] {
+					// the stats entry is named by the description in older VPP versions
+					if _, ok := defs[path+"/"+elem.Description]; !ok {
+						defs[path+"/"+elem.Description] = elem
+					}
+					defs[path+"/"+elem.Name] = elem
+				}
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.defs = defs
+	r.byName = nil
+}
+
+// Refresh updates the registry from the stats segment.
+func (r *ErrorRegistry) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.conn.updateStats(ctx, &r.dir, CounterStatsPrefix); err != nil {
+		return err
+	}
+	if r.byName != nil && r.epoch == r.dir.Epoch {
+		return nil
+	}
+	r.byName = make(map[string]ErrorCounterInfo, len(r.dir.Entries))
+	r.byNode = make(map[string][]ErrorCounterInfo)
+	for _, entry := range r.dir.Entries {
+		name := string(entry.Name)
+		node, reason := SplitErrorCounterName(name)
+		if node == "" {
+			continue
+		}
+		info := ErrorCounterInfo{
+			Name:   name,
+			Node:   node,
+			Reason: reason,
+			Index:  entry.Index,
+		}
+		if def, ok := r.defs[name]; ok {
+			info.Severity = def.Severity
+			info.Description = def.Description
+		}
+		r.byName[name] = info
+		r.byNode[node] = append(r.byNode[node], info)
+	}
+	r.epoch = r.dir.Epoch
+	return nil
+}
+
+// Lookup returns the error counter with the given stats entry name.
+func (r *ErrorRegistry) Lookup(name string) (ErrorCounterInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.byName[name]
+	return info, ok
+}
+
+// LookupReason returns the error counter of the node with the given reason.
+func (r *ErrorRegistry) LookupReason(node, reason string) (ErrorCounterInfo, bool) {
+	return r.Lookup(CounterStatsPrefix + node + "/" + reason)
+}
+
+// NodeErrors returns all error counters of the node.
+func (r *ErrorRegistry) NodeErrors(node string) []ErrorCounterInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]ErrorCounterInfo(nil), r.byNode[node]...)
+}
+
+// Nodes returns sorted names of all nodes with error counters.
+func (r *ErrorRegistry) Nodes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	nodes := make([]string, 0, len(r.byNode))
+	for node := range r.byNode {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
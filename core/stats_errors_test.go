@@ -0,0 +1,119 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+func errorEntries(names ...string) []adapter.StatEntry {
+	entries := []adapter.StatEntry{
+		{StatIdentifier: adapter.StatIdentifier{Name: []byte(SystemStats_Heartbeat)}, Type: adapter.ScalarIndex, Data: adapter.ScalarStat(1)},
+	}
+	for _, name := range names {
+		entries = append(entries, adapter.StatEntry{
+			StatIdentifier: adapter.StatIdentifier{Name: []byte(name)},
+			Type:           adapter.SimpleCounterVector,
+			Data:           adapter.SimpleCounterStat{{1}, {2}},
+		})
+	}
+	return entries
+}
+
+func TestErrorRegistry(t *testing.T) {
+	ctx := setupStatsWatchTest(t, errorEntries("/err/ip4-input/bad checksum", "/err/ip4-input/ttl expired", "/err/arp-reply/no error"))
+	defer ctx.teardownTest()
+
+	registry := NewErrorRegistry(ctx.conn)
+	_, ok := registry.Lookup("/err/ip4-input/bad checksum")
+	Expect(ok).To(BeFalse())
+
+	Expect(registry.Refresh(context.Background())).To(Succeed())
+	Expect(registry.Nodes()).To(Equal([]string{"arp-reply", "ip4-input"}))
+
+	info, ok := registry.LookupReason("ip4-input", "ttl expired")
+	Expect(ok).To(BeTrue())
+	Expect(info).To(Equal(ErrorCounterInfo{Name: "/err/ip4-input/ttl expired", Node: "ip4-input", Reason: "ttl expired", Index: 2}))
+	Expect(registry.NodeErrors("ip4-input")).To(HaveLen(2))
+
+	// the registry follows the stats directory changes
+	Expect(ctx.segment.Write(errorEntries("/err/ip6-input/bad checksum"))).To(Succeed())
+	Expect(registry.Refresh(context.Background())).To(Succeed())
+	Expect(registry.Nodes()).To(Equal([]string{"ip6-input"}))
+	_, ok = registry.LookupReason("ip4-input", "ttl expired")
+	Expect(ok).To(BeFalse())
+}
+
+func TestErrorRegistryCounters(t *testing.T) {
+	ctx := setupStatsWatchTest(t, errorEntries("/err/example-node/dropped", "/err/example-output/packets processed", "/err/ip4-input/ttl expired"))
+	defer ctx.teardownTest()
+
+	file, err := vppapi.ParseFile("../binapigen/vppapi/testdata/src/plugins/example/example.api")
+	Expect(err).ToNot(HaveOccurred())
+
+	registry := NewErrorRegistry(ctx.conn)
+	Expect(registry.Refresh(context.Background())).To(Succeed())
+	info, _ := registry.LookupReason("example-node", "dropped")
+	Expect(info.Severity).To(BeEmpty())
+
+	registry.LoadCounters(&vppapi.Schema{Files: []vppapi.File{*file}})
+	Expect(registry.Refresh(context.Background())).To(Succeed())
+
+	info, ok := registry.LookupReason("example-node", "dropped")
+	Expect(ok).To(BeTrue())
+	Expect(info).To(Equal(ErrorCounterInfo{
+		Name: "/err/example-node/dropped", Node: "example-node", Reason: "dropped", Index: 1,
+		Severity: "error", Description: "packets dropped",
+	}))
+	// older VPP versions name the entries by the description
+	info, _ = registry.LookupReason("example-output", "packets processed")
+	Expect(info.Severity).To(Equal("info"))
+	Expect(info.Description).To(Equal("packets processed"))
+	// errors without definitions
+	info, _ = registry.LookupReason("ip4-input", "ttl expired")
+	Expect(info.Severity).To(BeEmpty())
+}
+
+func TestGetErrorStatsReasons(t *testing.T) {
+	ctx := setupStatsWatchTest(t, errorEntries("/err/ip4-input/bad checksum"))
+	defer ctx.teardownTest()
+
+	var errStats api.ErrorStats
+	Expect(ctx.conn.GetErrorStats(&errStats)).To(Succeed())
+	Expect(errStats.Errors).To(Equal([]api.ErrorCounter{
+		{CounterName: "/err/ip4-input/bad checksum", Node: "ip4-input", Reason: "bad checksum", Values: []uint64{1, 2}},
+	}))
+}
+
+func TestSplitErrorCounterName(t *testing.T) {
+	RegisterTestingT(t)
+
+	node, reason := SplitErrorCounterName("/err/ip4-input/bad checksum")
+	Expect(node).To(Equal("ip4-input"))
+	Expect(reason).To(Equal("bad checksum"))
+
+	node, reason = SplitErrorCounterName("/if/drops")
+	Expect(node).To(BeEmpty())
+	Expect(reason).To(BeEmpty())
+}
@@ -0,0 +1,210 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+)
+
+// SymlinkInterfacesPrefix is the prefix of the per interface symlinks, e.g. /interfaces/eth0/rx.
+const SymlinkInterfacesPrefix = "/interfaces/"
+
+// MaxCachedQueries is the maximum number of query patterns with the stats
+// directory cached by Query, the least recently used are dropped first.
+const MaxCachedQueries = 64
+
+// Query retrieves all stat entries matching any of the patterns and returns
+// their values reduced for all threads. The indexes of interface and node
+// counters are resolved to the interface and node names, and the error
+// counters are split into the node and the error reason.
+//
+// The stats directory prepared for the patterns is cached and updated on
+// subsequent queries with the same patterns, it is prepared again when
+// its epoch changes. The directories of up to MaxCachedQueries recently
+// used patterns are cached.
+func (c *StatsConnection) Query(patterns ...string) (*api.StatsQueryResult, error) {
+	return c.QueryContext(context.Background(), patterns...)
+}
+
+// QueryContext retrieves all stat entries matching any of the patterns, see Query.
+// If the stats directory keeps changing between reading the entries and the
+// names of their indexes, it fails with adapter.ErrStatsDirStale after
+// RetryUpdateCount attempts.
+func (c *StatsConnection) QueryContext(ctx context.Context, patterns ...string) (*api.StatsQueryResult, error) {
+	c.queryMu.Lock()
+	defer c.queryMu.Unlock()
+
+	key := strings.Join(patterns, "\x00")
+	dir := c.cachedQueryDir(key)
+	var names map[string]adapter.NameStat
+	stale := false
+	for r := 0; r < RetryUpdateCount; r++ {
+		if err := c.updateStats(ctx, &dir, patterns...); err != nil {
+			return nil, err
+		}
+		if !needsNames(dir) {
+			names, stale = nil, false
+			break
+		}
+		if err := c.updateStats(ctx, &c.queryNamesData, InterfaceStats_Names, NodeStats_Names); err != nil {
+			return nil, err
+		}
+		names = make(map[string]adapter.NameStat)
+		for _, entry := range c.queryNamesData.Entries {
+			if s, ok := entry.Data.(adapter.NameStat); ok {
+				names[string(entry.Name)] = s
+			}
+		}
+		// both dirs must come from the same epoch for the names to match the indexes
+		stale = dir.Epoch != c.queryNamesData.Epoch
+		if !stale {
+			break
+		}
+	}
+	c.cacheQueryDir(key, dir)
+	if stale {
+		return nil, fmt.Errorf("names changed in %d attempts: %w", RetryUpdateCount, adapter.ErrStatsDirStale)
+	}
+
+	result := &api.StatsQueryResult{
+		Epoch:   dir.Epoch,
+		Entries: make([]api.StatsQueryEntry, 0, len(dir.Entries)),
+	}
+	for _, entry := range dir.Entries {
+		result.Entries = append(result.Entries, queryEntry(entry, names))
+	}
+	return result, nil
+}
+
+// queryDir is the stats directory cached for the query patterns.
+type queryDir struct {
+	key string
+	dir *adapter.StatDir
+}
+
+// cachedQueryDir returns the directory cached for the query patterns key,
+// or nil if there is none. The caller must hold c.queryMu.
+func (c *StatsConnection) cachedQueryDir(key string) *adapter.StatDir {
+	e, ok := c.queryDirs[key]
+	if !ok {
+		return nil
+	}
+	c.queryLRU.MoveToFront(e)
+	return e.Value.(*queryDir).dir
+}
+
+// cacheQueryDir caches the directory for the query patterns key and drops
+// the least recently used one if the cache is full. The caller must hold
+// c.queryMu.
+func (c *StatsConnection) cacheQueryDir(key string, dir *adapter.StatDir) {
+	if e, ok := c.queryDirs[key]; ok {
+		e.Value.(*queryDir).dir = dir
+		c.queryLRU.MoveToFront(e)
+		return
+	}
+	if c.queryDirs == nil {
+		c.queryDirs = make(map[string]*list.Element)
+	}
+	c.queryDirs[key] = c.queryLRU.PushFront(&queryDir{key: key, dir: dir})
+	if c.queryLRU.Len() > MaxCachedQueries {
+		oldest := c.queryLRU.Remove(c.queryLRU.Back()).(*queryDir)
+		delete(c.queryDirs, oldest.key)
+	}
+}
+
+// needsNames returns true if any of the entries has indexes referring to interfaces or nodes.
+func needsNames(dir *adapter.StatDir) bool {
+	for _, entry := range dir.Entries {
+		if indexNames(string(entry.Name), entry.Symlink) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// indexNames returns the name of the name vector the indexes of the entry refer to.
+func indexNames(name string, symlink bool) string {
+	switch {
+	case symlink, name == InterfaceStats_Names, name == NodeStats_Names:
+		return ""
+	case strings.HasPrefix(name, InterfaceStatsPrefix):
+		return InterfaceStats_Names
+	case strings.HasPrefix(name, NodeStatsPrefix):
+		return NodeStats_Names
+	}
+	return ""
+}
+
+func queryEntry(entry adapter.StatEntry, names map[string]adapter.NameStat) api.StatsQueryEntry {
+	name := string(entry.Name)
+	e := api.StatsQueryEntry{
+		Name:    name,
+		Type:    string(entry.Type),
+		Symlink: entry.Symlink,
+	}
+	if entry.Data != nil {
+		e.Type = string(entry.Data.Type())
+	}
+	if strings.HasPrefix(name, CounterStatsPrefix) {
+		e.ErrorNode, e.ErrorReason = SplitErrorCounterName(name)
+	}
+
+	labels := names[indexNames(name, entry.Symlink)]
+	label := func(i int) string {
+		if i < len(labels) {
+			return string(labels[i])
+		}
+		return ""
+	}
+	if entry.Symlink && strings.HasPrefix(name, SymlinkInterfacesPrefix) {
+		// the symlink points to a single item, the path contains its name
+		ifName, _, _ := strings.Cut(strings.TrimPrefix(name, SymlinkInterfacesPrefix), "/")
+		label = func(int) string { return ifName }
+	}
+
+	switch s := entry.Data.(type) {
+	case adapter.ScalarStat:
+		e.Values = []api.StatsQueryValue{{Scalar: float64(s)}}
+	case adapter.GaugeStat:
+		e.Values = []api.StatsQueryValue{{Scalar: float64(s)}}
+	case adapter.ErrorStat:
+		var val uint64
+		for _, v := range s {
+			val += uint64(v)
+		}
+		e.Values = []api.StatsQueryValue{{Value: val}}
+	case adapter.SimpleCounterStat:
+		for i, val := range adapter.ReduceSimpleCounterStat(s) {
+			e.Values = append(e.Values, api.StatsQueryValue{Index: uint32(i), Label: label(i), Value: val})
+		}
+	case adapter.CombinedCounterStat:
+		for i, val := range adapter.ReduceCombinedCounterStat(s) {
+			e.Values = append(e.Values, api.StatsQueryValue{Index: uint32(i), Label: label(i), Value: val[0], Bytes: val[1]})
+		}
+	case adapter.NameStat:
+		for i, n := range s {
+			e.Values = append(e.Values, api.StatsQueryValue{Index: uint32(i), Name: string(n)})
+		}
+	case adapter.HistogramLog2Stat:
+		e.Values = []api.StatsQueryValue{{Value: s.Total()}}
+	}
+	return e
+}
@@ -0,0 +1,181 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsclient"
+	"go.fd.io/govpp/adapter/statsclient/statsegtest"
+	"go.fd.io/govpp/api"
+)
+
+func queryEntries(ifNames ...string) []adapter.StatEntry {
+	entry := func(name string, data adapter.Stat) adapter.StatEntry {
+		return adapter.StatEntry{StatIdentifier: adapter.StatIdentifier{Name: []byte(name)}, Type: data.Type(), Data: data}
+	}
+	names := make(adapter.NameStat, len(ifNames))
+	drops := adapter.SimpleCounterStat{make([]adapter.Counter, len(ifNames)), make([]adapter.Counter, len(ifNames))}
+	for i, n := range ifNames {
+		names[i] = adapter.Name(n)
+		drops[0][i], drops[1][i] = adapter.Counter(i), adapter.Counter(10*i)
+	}
+	return []adapter.StatEntry{
+		entry(InterfaceStats_Names, names),
+		entry(InterfaceStats_Drops, drops),
+		entry(NodeStats_Names, adapter.NameStat{adapter.Name("ip4-input")}),
+		entry(NodeStats_Calls, adapter.SimpleCounterStat{{1}, {2}}),
+		entry("/err/ip4-input/bad checksum", adapter.SimpleCounterStat{{3}, {4}}),
+		entry("/nat44-ed/total-users", adapter.GaugeStat(5)),
+		entry("/net/route/to", adapter.CombinedCounterStat{{{1, 64}}, {{2, 128}}}),
+	}
+}
+
+func TestStatsQuery(t *testing.T) {
+	ctx := setupStatsWatchTest(t, queryEntries("local0", "eth0"))
+	defer ctx.teardownTest()
+
+	result, err := ctx.conn.Query("^/if/drops", "^/sys/node/calls", "^/err/", "^/nat44-ed/", "^/net/route/to")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Entries).To(Equal([]api.StatsQueryEntry{
+		{Name: "/if/drops", Type: string(adapter.SimpleCounterVector), Values: []api.StatsQueryValue{
+			{Index: 0, Label: "local0"},
+			{Index: 1, Label: "eth0", Value: 11},
+		}},
+		{Name: "/sys/node/calls", Type: string(adapter.SimpleCounterVector), Values: []api.StatsQueryValue{
+			{Index: 0, Label: "ip4-input", Value: 3},
+		}},
+		{Name: "/err/ip4-input/bad checksum", Type: string(adapter.SimpleCounterVector), ErrorNode: "ip4-input", ErrorReason: "bad checksum",
+			Values: []api.StatsQueryValue{{Value: 7}}},
+		{Name: "/nat44-ed/total-users", Type: string(adapter.GaugeIndex), Values: []api.StatsQueryValue{{Scalar: 5}}},
+		{Name: "/net/route/to", Type: string(adapter.CombinedCounterVector), Values: []api.StatsQueryValue{
+			{Index: 0, Value: 3, Bytes: 192},
+		}},
+	}))
+
+	names, err := ctx.conn.Query("^/if/names$")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(names.Entries).To(HaveLen(1))
+	Expect(names.Entries[0].Values).To(Equal([]api.StatsQueryValue{{Index: 0, Name: "local0"}, {Index: 1, Name: "eth0"}}))
+}
+
+func TestStatsQueryEpochChange(t *testing.T) {
+	ctx := setupStatsWatchTest(t, queryEntries("local0"))
+	defer ctx.teardownTest()
+
+	result, err := ctx.conn.Query("^/if/drops")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Entries[0].Values).To(HaveLen(1))
+
+	Expect(ctx.segment.Write(queryEntries("local0", "eth0", "eth1"))).To(Succeed())
+
+	result, err = ctx.conn.Query("^/if/drops")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Epoch).To(Equal(ctx.segment.Epoch()))
+	Expect(result.Entries[0].Values).To(HaveLen(3))
+	Expect(result.Entries[0].Values[2]).To(Equal(api.StatsQueryValue{Index: 2, Label: "eth1", Value: 22}))
+}
+
+// epochBumpingClient changes the epoch of the segment after each read of the
+// stats directory.
+type epochBumpingClient struct {
+	adapter.StatsAPI
+	segment *statsegtest.Segment
+	reads   int
+}
+
+func (c *epochBumpingClient) PrepareDir(prefixes ...string) (*adapter.StatDir, error) {
+	dir, err := c.StatsAPI.PrepareDir(prefixes...)
+	c.bump()
+	return dir, err
+}
+
+func (c *epochBumpingClient) UpdateDir(dir *adapter.StatDir) error {
+	err := c.StatsAPI.UpdateDir(dir)
+	c.bump()
+	return err
+}
+
+func (c *epochBumpingClient) bump() {
+	c.reads++
+	c.segment.BumpEpoch()
+}
+
+func TestStatsQueryEpochChanging(t *testing.T) {
+	ctx := setupStatsWatchTest(t, queryEntries("local0"))
+	defer ctx.teardownTest()
+
+	client := &epochBumpingClient{StatsAPI: statsclient.NewStatsClient(ctx.socket), segment: ctx.segment}
+	conn, err := ConnectStats(client)
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+
+	// the names never come from the same epoch as the entries
+	_, err = conn.Query("^/if/drops")
+	Expect(err).To(MatchError(adapter.ErrStatsDirStale))
+	Expect(client.reads).To(BeNumerically(">=", 2*RetryUpdateCount))
+
+	// the entries without indexes do not need the names
+	result, err := conn.Query("^/nat44-ed/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Entries).To(HaveLen(1))
+}
+
+func TestStatsQuerySymlink(t *testing.T) {
+	ctx := setupStatsWatchTest(t, queryEntries("local0", "eth0"))
+	defer ctx.teardownTest()
+
+	Expect(ctx.segment.Write(queryEntries("local0", "eth0"),
+		statsegtest.Symlink{Name: "/interfaces/eth0/drops", Target: InterfaceStats_Drops, Index: 1})).To(Succeed())
+
+	result, err := ctx.conn.Query("^/interfaces/")
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Entries).To(Equal([]api.StatsQueryEntry{
+		{Name: "/interfaces/eth0/drops", Type: string(adapter.SimpleCounterVector), Symlink: true, Values: []api.StatsQueryValue{
+			{Index: 0, Label: "eth0", Value: 11},
+		}},
+	}))
+}
+
+func TestStatsQueryCache(t *testing.T) {
+	ctx := setupStatsWatchTest(t, queryEntries("local0"))
+	defer ctx.teardownTest()
+
+	pattern := func(i int) string {
+		return "^/if/drops$|^/unknown/" + strconv.Itoa(i) + "$"
+	}
+	for i := 0; i < MaxCachedQueries; i++ {
+		_, err := ctx.conn.Query(pattern(i))
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+	// the first query is used again, so the second is dropped
+	_, err := ctx.conn.Query(pattern(0))
+	Expect(err).ShouldNot(HaveOccurred())
+	result, err := ctx.conn.Query(pattern(MaxCachedQueries))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(result.Entries).To(HaveLen(1))
+
+	Expect(ctx.conn.queryDirs).To(HaveLen(MaxCachedQueries))
+	Expect(ctx.conn.queryLRU.Len()).To(Equal(MaxCachedQueries))
+	Expect(ctx.conn.queryDirs).To(HaveKey(pattern(0)))
+	Expect(ctx.conn.queryDirs).ToNot(HaveKey(pattern(1)))
+	Expect(ctx.conn.queryDirs).To(HaveKey(pattern(MaxCachedQueries)))
+}
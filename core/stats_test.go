@@ -0,0 +1,51 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+)
+
+func TestGetStatsContext(t *testing.T) {
+	ctx := setupStatsWatchTest(t, threadEntries())
+	defer ctx.teardownTest()
+
+	var ifStats api.InterfaceStats
+	Expect(ctx.conn.GetInterfaceStatsContext(context.Background(), &ifStats)).To(Succeed())
+	Expect(ifStats.Interfaces).To(HaveLen(2))
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	Expect(ctx.conn.GetNodeStatsContext(canceledCtx, &api.NodeStats{})).To(MatchError(context.Canceled))
+	_, err := ctx.conn.QueryContext(canceledCtx, "^/if/")
+	Expect(err).To(MatchError(context.Canceled))
+
+	// the stats segment being updated by VPP blocks until the context is done
+	ctx.segment.SetInProgress(true)
+	defer ctx.segment.SetInProgress(false)
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	start := time.Now()
+	Expect(ctx.conn.GetInterfaceStatsContext(timeoutCtx, &ifStats)).To(MatchError(context.DeadlineExceeded))
+	Expect(time.Since(start)).To(BeNumerically("<", time.Millisecond*100))
+}
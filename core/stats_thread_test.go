@@ -0,0 +1,102 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/vlib"
+)
+
+func threadEntries() []adapter.StatEntry {
+	entry := func(name string, data adapter.Stat) adapter.StatEntry {
+		return adapter.StatEntry{StatIdentifier: adapter.StatIdentifier{Name: []byte(name)}, Type: data.Type(), Data: data}
+	}
+	return []adapter.StatEntry{
+		entry(InterfaceStats_Names, adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0")}),
+		entry(InterfaceStats_Drops, adapter.SimpleCounterStat{{1, 2}, {3, 4}}),
+		entry(InterfaceStats_Rx, adapter.CombinedCounterStat{{{1, 64}, {2, 128}}, {{3, 192}, {4, 256}}}),
+		entry(InterfaceStats_TxUnicastMiss, adapter.CombinedCounterStat{{{0, 0}, {5, 320}}, {{0, 0}, {6, 384}}}),
+		entry(NodeStats_Names, adapter.NameStat{adapter.Name("ip4-input"), adapter.Name("ip4-lookup")}),
+		entry(NodeStats_Calls, adapter.SimpleCounterStat{{10, 20}, {30, 40}}),
+		entry(NodeStats_Vectors, adapter.SimpleCounterStat{{100, 200}, {300, 400}}),
+	}
+}
+
+func TestGetInterfaceThreadStats(t *testing.T) {
+	ctx := setupStatsWatchTest(t, threadEntries())
+	defer ctx.teardownTest()
+
+	var stats api.InterfaceThreadStats
+	Expect(ctx.conn.GetInterfaceThreadStats(&stats)).To(Succeed())
+	Expect(stats.Threads).To(HaveLen(2))
+	Expect(stats.Threads[1].ThreadIndex).To(BeEquivalentTo(1))
+	Expect(stats.Threads[1].ThreadName).To(BeEmpty())
+
+	ctx.conn.SetThreadNames([]string{"vpp_main", "vpp_wk_0"})
+	Expect(ctx.conn.GetInterfaceThreadStats(&stats)).To(Succeed())
+	Expect(stats.Threads[0].ThreadName).To(Equal("vpp_main"))
+	Expect(stats.Threads[1].ThreadName).To(Equal("vpp_wk_0"))
+
+	eth0 := stats.Threads[1].Interfaces[1]
+	Expect(eth0.InterfaceIndex).To(BeEquivalentTo(1))
+	Expect(eth0.InterfaceName).To(Equal("eth0"))
+	Expect(eth0.Drops).To(BeEquivalentTo(4))
+	Expect(eth0.Rx).To(Equal(api.InterfaceCounterCombined{Packets: 4, Bytes: 256}))
+	Expect(eth0.TxUnicast).To(Equal(api.InterfaceCounterCombined{Packets: 6, Bytes: 384}))
+
+	// per-thread counters sum up to the reduced counters
+	var total api.InterfaceStats
+	Expect(ctx.conn.GetInterfaceStats(&total)).To(Succeed())
+	Expect(total.Interfaces[1].Drops).To(Equal(stats.Threads[0].Interfaces[1].Drops + eth0.Drops))
+	Expect(total.Interfaces[1].TxUnicast.Packets).To(BeEquivalentTo(11))
+}
+
+func TestGetNodeThreadStats(t *testing.T) {
+	ctx := setupStatsWatchTest(t, threadEntries())
+	defer ctx.teardownTest()
+
+	var stats api.NodeThreadStats
+	Expect(ctx.conn.GetNodeThreadStats(&stats)).To(Succeed())
+	Expect(stats.Threads).To(HaveLen(2))
+	Expect(stats.Threads[0].Nodes).To(Equal([]api.NodeCounters{
+		{NodeIndex: 0, NodeName: "ip4-input", Calls: 10, Vectors: 100},
+		{NodeIndex: 1, NodeName: "ip4-lookup", Calls: 20, Vectors: 200},
+	}))
+	Expect(stats.Threads[1].Nodes[1]).To(Equal(api.NodeCounters{NodeIndex: 1, NodeName: "ip4-lookup", Calls: 40, Vectors: 400}))
+}
+
+func TestGetThreadNames(t *testing.T) {
+	ctx := setupTest(t)
+	defer ctx.teardownTest()
+
+	ctx.mockVpp.MockReply(&vlib.ShowThreadsReply{
+		Count: 2,
+		ThreadData: []vlib.ThreadData{
+			{ID: 1, Name: "fwd_wk_0", Type: "workers"},
+			{ID: 0, Name: "fwd_main"},
+		},
+	})
+	names, err := GetThreadNames(context.Background(), ctx.conn)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(names).To(Equal([]string{"fwd_main", "fwd_wk_0"}))
+}
@@ -0,0 +1,160 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/api"
+)
+
+// counterKey identifies a single counter across snapshots
+type counterKey struct {
+	name  string
+	index uint32
+}
+
+// statsWatcher keeps the state of a single Watch call
+type statsWatcher struct {
+	conn     *StatsConnection
+	patterns []string
+	dir      *adapter.StatDir
+
+	last     time.Time
+	previous map[counterKey]api.CounterSample
+}
+
+// Watch periodically reads simple, combined and error counters matching
+// any of the patterns and sends the snapshots with the values reduced
+// for all threads to the returned channel. Each counter carries its delta
+// and per-second rate computed from the previous snapshot.
+//
+// The stats directory is prepared again when its epoch changes, counters
+// appearing later (e.g. for new interfaces) are marked as new and count
+// from zero, and counters that decreased (e.g. after a VPP restart) are
+// marked as reset with the delta counted from zero.
+//
+// The first snapshot is sent immediately and all its counters are marked
+// as new with zero deltas. The channel is closed when the context is done.
+func (c *StatsConnection) Watch(ctx context.Context, patterns []string, interval time.Duration) (<-chan api.StatsSnapshot, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid watch interval: %v", interval)
+	}
+	w := &statsWatcher{
+		conn:     c,
+		patterns: patterns,
+	}
+	snapshots := make(chan api.StatsSnapshot, 1)
+
+	go func() {
+		defer close(snapshots)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case snapshots <- w.snapshot(ctx):
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return snapshots, nil
+}
+
+// snapshot reads the counters and computes changes since the previous snapshot.
+func (w *statsWatcher) snapshot(ctx context.Context) api.StatsSnapshot {
+	now := time.Now()
+	if err := w.conn.updateStats(ctx, &w.dir, w.patterns...); err != nil {
+		return api.StatsSnapshot{Timestamp: now, Err: err}
+	}
+
+	snapshot := api.StatsSnapshot{
+		Timestamp: now,
+		Epoch:     w.dir.Epoch,
+	}
+	if w.previous != nil {
+		snapshot.Elapsed = now.Sub(w.last)
+	}
+
+	current := make(map[counterKey]api.CounterSample, len(w.previous))
+	add := func(sample api.CounterSample) {
+		key := counterKey{name: sample.Name, index: sample.Index}
+		if _, ok := current[key]; ok {
+			// symlinked directory entries may repeat the counter
+			return
+		}
+		w.computeDelta(&sample, key, snapshot.Elapsed)
+		current[key] = sample
+		snapshot.Counters = append(snapshot.Counters, sample)
+	}
+
+	for _, entry := range w.dir.Entries {
+		name := string(entry.Name)
+		switch s := entry.Data.(type) {
+		case adapter.SimpleCounterStat:
+			for i, val := range adapter.ReduceSimpleCounterStat(s) {
+				add(api.CounterSample{Name: name, Index: uint32(i), Value: val})
+			}
+		case adapter.CombinedCounterStat:
+			for i, val := range adapter.ReduceCombinedCounterStat(s) {
+				add(api.CounterSample{Name: name, Index: uint32(i), Value: val[0], Bytes: val[1], Combined: true})
+			}
+		case adapter.ErrorStat:
+			var val uint64
+			for _, v := range s {
+				val += uint64(v)
+			}
+			add(api.CounterSample{Name: name, Value: val})
+		}
+	}
+
+	w.previous = current
+	w.last = now
+	return snapshot
+}
+
+// computeDelta fills in delta and rate of the sample using its previous value.
+func (w *statsWatcher) computeDelta(sample *api.CounterSample, key counterKey, elapsed time.Duration) {
+	if w.previous == nil {
+		sample.New = true
+		return
+	}
+	prev, ok := w.previous[key]
+	if !ok {
+		// counter created since the last snapshot
+		sample.New = true
+		prev = api.CounterSample{}
+	} else if sample.Value < prev.Value || sample.Bytes < prev.Bytes {
+		sample.Reset = true
+		prev = api.CounterSample{}
+	}
+	sample.Delta = sample.Value - prev.Value
+	sample.BytesDelta = sample.Bytes - prev.Bytes
+	if seconds := elapsed.Seconds(); seconds > 0 {
+		sample.Rate = float64(sample.Delta) / seconds
+		sample.BytesRate = float64(sample.BytesDelta) / seconds
+	}
+}
@@ -0,0 +1,170 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build linux
+
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsclient"
+	"go.fd.io/govpp/adapter/statsclient/statsegtest"
+	"go.fd.io/govpp/api"
+)
+
+const watchInterval = 100 * time.Millisecond
+
+type statsWatchCtx struct {
+	socket  string
+	segment *statsegtest.Segment
+	conn    *StatsConnection
+}
+
+func setupStatsWatchTest(t *testing.T, entries []adapter.StatEntry) *statsWatchCtx {
+	RegisterTestingT(t)
+
+	ctx := &statsWatchCtx{
+		socket: filepath.Join(t.TempDir(), "stats.sock"),
+	}
+	ctx.serve(entries)
+
+	var err error
+	ctx.conn, err = ConnectStats(statsclient.NewStatsClient(ctx.socket))
+	Expect(err).ShouldNot(HaveOccurred())
+	return ctx
+}
+
+func (ctx *statsWatchCtx) serve(entries []adapter.StatEntry) {
+	var err error
+	ctx.segment, err = statsegtest.NewSegment(0)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(ctx.segment.Write(entries)).To(Succeed())
+	Expect(ctx.segment.Serve(ctx.socket)).To(Succeed())
+}
+
+func (ctx *statsWatchCtx) teardownTest() {
+	ctx.conn.Disconnect()
+	Expect(ctx.segment.Close()).To(Succeed())
+}
+
+func watchEntries(drops adapter.SimpleCounterStat, rx adapter.CombinedCounterStat) []adapter.StatEntry {
+	return []adapter.StatEntry{
+		{StatIdentifier: adapter.StatIdentifier{Name: []byte("/if/drops")}, Type: adapter.SimpleCounterVector, Data: drops},
+		{StatIdentifier: adapter.StatIdentifier{Name: []byte("/if/rx")}, Type: adapter.CombinedCounterVector, Data: rx},
+		{StatIdentifier: adapter.StatIdentifier{Name: []byte("/err/ip4-input/bad checksum")}, Type: adapter.SimpleCounterVector,
+			Data: adapter.SimpleCounterStat{{1}, {1}}},
+	}
+}
+
+func receiveSnapshot(snapshots <-chan api.StatsSnapshot) api.StatsSnapshot {
+	var snapshot api.StatsSnapshot
+	Eventually(snapshots, time.Second).Should(Receive(&snapshot))
+	Expect(snapshot.Err).ShouldNot(HaveOccurred())
+	return snapshot
+}
+
+func TestStatsWatch(t *testing.T) {
+	ctx := setupStatsWatchTest(t, watchEntries(
+		adapter.SimpleCounterStat{{1}, {2}},
+		adapter.CombinedCounterStat{{{1, 100}}, {{2, 200}}},
+	))
+	defer ctx.teardownTest()
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	snapshots, err := ctx.conn.Watch(watchCtx, []string{"^/if/", "^/err/"}, watchInterval)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	first := receiveSnapshot(snapshots)
+	Expect(first.Elapsed).To(BeZero())
+	Expect(first.Counters).To(ConsistOf(
+		api.CounterSample{Name: "/if/drops", Index: 0, Value: 3, New: true},
+		api.CounterSample{Name: "/if/rx", Index: 0, Value: 3, Bytes: 300, Combined: true, New: true},
+		api.CounterSample{Name: "/err/ip4-input/bad checksum", Index: 0, Value: 2, New: true},
+	))
+
+	// new interface appears
+	Expect(ctx.segment.Write(watchEntries(
+		adapter.SimpleCounterStat{{2, 1}, {4, 1}},
+		adapter.CombinedCounterStat{{{11, 1100}, {1, 64}}, {{2, 200}, {0, 0}}},
+	))).To(Succeed())
+
+	second := receiveSnapshot(snapshots)
+	Expect(second.Elapsed).To(BeNumerically(">", 0))
+	Expect(second.Epoch).To(BeNumerically(">", first.Epoch))
+	Expect(second.Counters).To(HaveLen(5))
+
+	rx := second.Counters[2]
+	Expect(rx.Name).To(Equal("/if/rx"))
+	Expect(rx.Delta).To(BeEquivalentTo(10))
+	Expect(rx.BytesDelta).To(BeEquivalentTo(1000))
+	Expect(rx.Rate).To(BeNumerically("~", 10/second.Elapsed.Seconds()))
+	Expect(rx.BytesRate).To(BeNumerically("~", 1000/second.Elapsed.Seconds()))
+	Expect(rx.New).To(BeFalse())
+
+	newRx := second.Counters[3]
+	Expect(newRx.Index).To(BeEquivalentTo(1))
+	Expect(newRx.New).To(BeTrue())
+	Expect(newRx.Delta).To(BeEquivalentTo(1))
+
+	errs := second.Counters[4]
+	Expect(errs.Delta).To(BeZero())
+	Expect(errs.Rate).To(BeZero())
+
+	cancel()
+	Eventually(snapshots, time.Second).Should(BeClosed())
+}
+
+func TestStatsWatchRestart(t *testing.T) {
+	ctx := setupStatsWatchTest(t, watchEntries(
+		adapter.SimpleCounterStat{{100}},
+		adapter.CombinedCounterStat{{{100, 10000}}},
+	))
+	defer ctx.teardownTest()
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	snapshots, err := ctx.conn.Watch(watchCtx, []string{"^/if/drops$"}, watchInterval)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	first := receiveSnapshot(snapshots)
+	Expect(first.Counters).To(HaveLen(1))
+	Expect(first.Counters[0].Value).To(BeEquivalentTo(100))
+
+	// VPP restarts with a new stats segment
+	Expect(ctx.segment.Close()).To(Succeed())
+	ctx.serve(watchEntries(
+		adapter.SimpleCounterStat{{5}},
+		adapter.CombinedCounterStat{{{5, 500}}},
+	))
+
+	// snapshots may fail while the client reconnects
+	var restarted api.CounterSample
+	Eventually(func() bool {
+		snapshot := <-snapshots
+		if snapshot.Err != nil || len(snapshot.Counters) != 1 {
+			return false
+		}
+		restarted = snapshot.Counters[0]
+		return restarted.Reset
+	}, 5*time.Second).Should(BeTrue())
+	Expect(restarted.Value).To(BeEquivalentTo(5))
+	Expect(restarted.Delta).To(BeEquivalentTo(5))
+}
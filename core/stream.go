@@ -18,7 +18,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"reflect"
 	"sync"
 	"time"
 
@@ -37,9 +36,20 @@ type Stream struct {
 	replyTimeout time.Duration
 	// per-request context
 	pkgPath string
+	// requests waiting for the replies in the order they were sent
+	requests []sentRequest
+	// request and sequence number of the last received reply
+	replyReq    api.Message
+	replySeqNum uint16
 	sync.Mutex
 }
 
+// sentRequest is the request sent by the stream.
+type sentRequest struct {
+	seqNum uint16
+	msg    api.Message
+}
+
 func (c *Connection) NewStream(ctx context.Context, options ...api.StreamOption) (api.Stream, error) {
 	if c == nil {
 		return nil, errors.New("nil connection passed in")
@@ -88,7 +98,7 @@ func (c *Connection) Invoke(ctx context.Context, req api.Message, reply api.Mess
 	if err := s.channel.msgCodec.DecodeMsg(rep.data, reply); err != nil {
 		return err
 	}
-	return nil
+	return s.channel.replyError(req, reply, rep.seqNum)
 }
 
 type watcher struct {
@@ -237,6 +247,7 @@ func (s *Stream) SendMsg(msg api.Message) error {
 	}
 	s.Lock()
 	s.pkgPath = s.conn.GetMessagePath(msg)
+	s.requests = append(s.requests, sentRequest{seqNum: req.seqNum, msg: msg})
 	s.Unlock()
 	return nil
 }
@@ -249,13 +260,19 @@ func (s *Stream) RecvMsg() (api.Message, error) {
 	// resolve message type
 	s.Lock()
 	path := s.pkgPath
+	s.replyReq = s.requestOf(reply.seqNum)
+	if s.replyReq != nil {
+		// the reply is defined by the API of its request
+		path = s.conn.GetMessagePath(s.replyReq)
+	}
+	s.replySeqNum = reply.seqNum
 	s.Unlock()
 	msg, err := s.channel.msgIdentifier.LookupByID(path, reply.msgID)
 	if err != nil {
 		return nil, err
 	}
 	// allocate message instance
-	msg = reflect.New(reflect.TypeOf(msg).Elem()).Interface().(api.Message)
+	msg = newMessage(msg)
 	// decode message data
 	if err := s.channel.msgCodec.DecodeMsg(reply.data, msg); err != nil {
 		return nil, err
@@ -263,6 +280,29 @@ func (s *Stream) RecvMsg() (api.Message, error) {
 	return msg, nil
 }
 
+// ReplyError returns error for the retval of reply last received by RecvMsg.
+// The error is api.ReplyError carrying the context of the request the reply
+// belongs to, the same as the one returned by Invoke. Retval 0 returns nil error.
+func (s *Stream) ReplyError(reply api.Message) error {
+	s.Lock()
+	req, seqNum := s.replyReq, s.replySeqNum
+	s.Unlock()
+	return s.channel.replyError(req, reply, seqNum)
+}
+
+// requestOf returns the request with sequence number seqNum, or nil if there
+// is no such request. The requests sent before it are dropped, VPP replies to
+// the requests in the order they were sent.
+func (s *Stream) requestOf(seqNum uint16) api.Message {
+	for i, req := range s.requests {
+		if req.seqNum == seqNum {
+			s.requests = s.requests[i:]
+			return req.msg
+		}
+	}
+	return nil
+}
+
 func WithRequestSize(size int) api.StreamOption {
 	return func(stream api.Stream) {
 		stream.(*Stream).requestSize = size
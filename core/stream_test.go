@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
 )
 
 type streamCtx struct {
@@ -63,3 +64,47 @@ func TestStreamReply(t *testing.T) {
 	Expect(err.Error()).To(HavePrefix("no reply received within the timeout period"))
 	Expect(errors.Is(err, ErrReplyTimeout)).To(Equal(true))
 }
+
+func TestInvokeReplyError(t *testing.T) {
+	ctx := setupStreamTest(t)
+	defer ctx.teardownTest()
+
+	// mock reply
+	ctx.mockVpp.MockReply(&ControlPingReply{Retval: int32(api.VALUE_EXIST)})
+
+	reply := &ControlPingReply{}
+	err := ctx.conn.Invoke(context.TODO(), &ControlPing{}, reply)
+	Expect(errors.Is(err, api.VALUE_EXIST)).To(BeTrue())
+	Expect(api.IsAlreadyExists(err)).To(BeTrue())
+	Expect(reply.Retval).To(BeEquivalentTo(api.VALUE_EXIST))
+
+	var replyErr *api.ReplyError
+	Expect(errors.As(err, &replyErr)).To(BeTrue())
+	Expect(replyErr.Request).To(Equal("control_ping"))
+	Expect(replyErr.Reply).To(Equal("control_ping_reply"))
+	Expect(replyErr.ConnID).To(Equal(ctx.conn.connId))
+}
+
+func TestStreamReplyError(t *testing.T) {
+	ctx := setupStreamTest(t)
+	defer ctx.teardownTest()
+
+	// mock reply
+	ctx.mockVpp.MockReply(&ControlPingReply{Retval: int32(api.NO_SUCH_ENTRY)})
+
+	Expect(ctx.stream.SendMsg(&ControlPing{})).To(Succeed())
+	msg, err := ctx.stream.RecvMsg()
+	Expect(err).ShouldNot(HaveOccurred())
+	reply := msg.(*ControlPingReply)
+
+	err = api.StreamReplyError(ctx.stream, reply, reply.Retval)
+	Expect(api.IsNotFound(err)).To(BeTrue())
+
+	var replyErr *api.ReplyError
+	Expect(errors.As(err, &replyErr)).To(BeTrue())
+	Expect(replyErr.Request).To(Equal("control_ping"))
+	Expect(replyErr.Reply).To(Equal("control_ping_reply"))
+	Expect(replyErr.ChannelID).To(Equal(ctx.stream.channel.id))
+	Expect(replyErr.SeqNum).To(Equal(ctx.stream.replySeqNum))
+	Expect(replyErr.ConnID).To(Equal(ctx.conn.connId))
+}
@@ -30,6 +30,7 @@ import (
 	"go.fd.io/govpp/binapi/ip_types"
 	"go.fd.io/govpp/binapi/memclnt"
 	"go.fd.io/govpp/binapi/vpe"
+	"go.fd.io/govpp/codec"
 	"go.fd.io/govpp/core"
 )
 
@@ -37,6 +38,9 @@ var (
 	sockAddr = flag.String("socket", socketclient.DefaultSocketName, "Path to VPP API socket file")
 )
 
+// traceFormatter prints the traced messages as trees of their non-zero fields
+var traceFormatter = &codec.Formatter{Indent: "    ", OmitZero: true}
+
 func main() {
 	flag.Parse()
 
@@ -352,4 +356,5 @@ func printTrace(item *api.Record) {
 	}
 	fmt.Printf("%dh:%dm:%ds:%dns %s sucess: %t %s\n", h, m, s,
 		item.Timestamp.Nanosecond(), item.Message.GetMessageName(), item.Succeeded, reply)
+	fmt.Println(traceFormatter.Format(item.Message))
 }
@@ -27,8 +27,8 @@ import (
 //
 // This call blocks until VPP is connected, or an error occurs.
 // Only one connection attempt will be performed.
-func Connect(target string) (*core.Connection, error) {
-	return core.Connect(NewVppAdapter(target))
+func Connect(target string, options ...core.ConnectionOption) (*core.Connection, error) {
+	return core.Connect(NewVppAdapter(target), options...)
 }
 
 // AsyncConnect asynchronously connects to the VPP API using a new adapter instance
@@ -37,8 +37,8 @@ func Connect(target string) (*core.Connection, error) {
 // This call does not block until connection is established, it returns immediately.
 // The caller is supposed to watch the returned ConnectionState channel for connection events.
 // In case of disconnect, the library will asynchronously try to reconnect.
-func AsyncConnect(target string, attempts int, interval time.Duration) (*core.Connection, chan core.ConnectionEvent, error) {
-	return core.AsyncConnect(NewVppAdapter(target), attempts, interval)
+func AsyncConnect(target string, attempts int, interval time.Duration, options ...core.ConnectionOption) (*core.Connection, chan core.ConnectionEvent, error) {
+	return core.AsyncConnect(NewVppAdapter(target), attempts, interval, options...)
 }
 
 // NewVppAdapter returns new instance of VPP adapter for connecting to VPP API.
@@ -0,0 +1,333 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/codec"
+)
+
+// The binapi values are converted using the API definitions of their types,
+// which map the fields of the Go structs to the protobuf fields numbered by
+// their order, the same as the converters generated by the proto plugin.
+
+// pathField is a field on the path to the converted value, the field with
+// the index in fields of the struct v.
+type pathField struct {
+	fields []*binapigen.Field
+	v      reflect.Value
+	index  int
+}
+
+// structToProto sets fields of protobuf message m from the binapi struct v,
+// the path contains the fields containing the struct from the outermost one.
+func structToProto(fields []*binapigen.Field, v reflect.Value, m protoreflect.Message, path []pathField) {
+	fds := m.Descriptor().Fields()
+	for i, field := range fields {
+		fd := fds.ByNumber(protoreflect.FieldNumber(i + 1))
+		gv := v.FieldByName(field.GoName)
+		if fd == nil || !gv.IsValid() {
+			continue
+		}
+		path := append(path[:len(path):len(path)], pathField{fields, v, i})
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			for j := 0; j < gv.Len(); j++ {
+				list.Append(valueToProto(field, fd, gv.Index(j), list.NewElement, path))
+			}
+			continue
+		}
+		newField := func() protoreflect.Value { return m.NewField(fd) }
+		m.Set(fd, valueToProto(field, fd, gv, newField, path))
+	}
+}
+
+// structFromProto sets fields of the binapi struct v from protobuf message m.
+// The count fields are set to the length of arrays they count.
+func structFromProto(fields []*binapigen.Field, m protoreflect.Message, v reflect.Value) error {
+	fds := m.Descriptor().Fields()
+	for i, field := range fields {
+		fd := fds.ByNumber(protoreflect.FieldNumber(i + 1))
+		gv := v.FieldByName(field.GoName)
+		if fd == nil || !gv.IsValid() || field.FieldSizeOf != nil {
+			continue
+		}
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			if gv.Kind() == reflect.Slice {
+				gv.Set(reflect.MakeSlice(gv.Type(), list.Len(), list.Len()))
+			} else if err := codec.CheckLength(list.Len(), gv.Len()); err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+			for j := 0; j < list.Len(); j++ {
+				if err := valueFromProto(field, fd, list.Get(j), gv.Index(j)); err != nil {
+					return fmt.Errorf("%s: %w", field.Name, err)
+				}
+			}
+			continue
+		}
+		if err := valueFromProto(field, fd, m.Get(fd), gv); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+	for _, field := range fields {
+		gv := v.FieldByName(field.GoName)
+		if field.FieldSizeOf == nil || !gv.IsValid() {
+			continue
+		}
+		n := v.FieldByName(field.FieldSizeOf.GoName).Len()
+		if err := setInt(gv, int64(n)); err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// valueToProto returns protobuf value of a single element of the field,
+// the path ends with the field.
+func valueToProto(field *binapigen.Field, fd protoreflect.FieldDescriptor, gv reflect.Value, newMessage func() protoreflect.Value, path []pathField) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(gv.Bool())
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(intValue(gv)))
+	case protoreflect.Int32Kind:
+		return protoreflect.ValueOfInt32(int32(intValue(gv)))
+	case protoreflect.Int64Kind:
+		return protoreflect.ValueOfInt64(intValue(gv))
+	case protoreflect.Uint32Kind:
+		return protoreflect.ValueOfUint32(uint32(intValue(gv)))
+	case protoreflect.Uint64Kind:
+		return protoreflect.ValueOfUint64(uint64(intValue(gv)))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(gv.Float())
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(gv.String())
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(bytesValue(gv))
+	case protoreflect.MessageKind:
+		pv := newMessage()
+		if union := fieldUnion(field); union != nil {
+			unionToProto(union, gv, pv.Message(), unionMember(path))
+		} else {
+			structToProto(fieldStruct(field), gv, pv.Message(), path)
+		}
+		return pv
+	}
+	return fd.Default()
+}
+
+// valueFromProto sets a single element gv of the field from protobuf value.
+func valueFromProto(field *binapigen.Field, fd protoreflect.FieldDescriptor, pv protoreflect.Value, gv reflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		gv.SetBool(pv.Bool())
+	case protoreflect.EnumKind:
+		return setInt(gv, int64(pv.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return setInt(gv, pv.Int())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return setUint(gv, pv.Uint())
+	case protoreflect.DoubleKind:
+		gv.SetFloat(pv.Float())
+	case protoreflect.StringKind:
+		gv.SetString(pv.String())
+	case protoreflect.BytesKind:
+		return setBytes(gv, pv.Bytes())
+	case protoreflect.MessageKind:
+		if union := fieldUnion(field); union != nil {
+			return unionFromProto(union, pv.Message(), gv)
+		}
+		return structFromProto(fieldStruct(field), pv.Message(), gv)
+	}
+	return nil
+}
+
+// unionToProto sets the oneof of protobuf message m to the member of binapi
+// union v. The largest member is used when the member is unknown, since it
+// preserves all union data.
+func unionToProto(union *binapigen.Union, v reflect.Value, m protoreflect.Message, member int) {
+	v = addressable(v)
+	members := make([]reflect.Value, len(union.Fields))
+	for i, field := range union.Fields {
+		getter := v.Addr().MethodByName("Get" + field.GoName)
+		if !getter.IsValid() {
+			return
+		}
+		members[i] = getter.Call(nil)[0]
+	}
+	if member < 0 || member >= len(members) {
+		member = 0
+		for i, mv := range members {
+			if mv.Type().Size() > members[member].Type().Size() {
+				member = i
+			}
+		}
+	}
+	fd := m.Descriptor().Fields().ByNumber(protoreflect.FieldNumber(member + 1))
+	if fd == nil {
+		return
+	}
+	newField := func() protoreflect.Value { return m.NewField(fd) }
+	m.Set(fd, valueToProto(union.Fields[member], fd, addressable(members[member]), newField, nil))
+}
+
+// unionFromProto sets the binapi union v to the member set in oneof of
+// protobuf message m.
+func unionFromProto(union *binapigen.Union, m protoreflect.Message, v reflect.Value) error {
+	oneofs := m.Descriptor().Oneofs()
+	if oneofs.Len() == 0 {
+		return nil
+	}
+	fd := m.WhichOneof(oneofs.Get(0))
+	if fd == nil || int(fd.Number()) > len(union.Fields) {
+		return nil
+	}
+	field := union.Fields[fd.Number()-1]
+	setter := v.Addr().MethodByName("Set" + field.GoName)
+	if !setter.IsValid() {
+		return nil
+	}
+	arg := reflect.New(setter.Type().In(0)).Elem()
+	if err := valueFromProto(field, fd, m.Get(fd), arg); err != nil {
+		return fmt.Errorf("%s: %w", field.Name, err)
+	}
+	setter.Call([]reflect.Value{arg})
+	return nil
+}
+
+// unionMember returns index of the union member selected by the enum field
+// preceding the union field at the end of path, or by the enum field of the
+// structs containing it. It returns -1 if no member is selected.
+func unionMember(path []pathField) int {
+	structs := make([][]*binapigen.Field, len(path))
+	indexes := make([]int, len(path))
+	for i, f := range path {
+		structs[i], indexes[i] = f.fields, f.index
+	}
+	level, discr, cases := binapigen.UnionDiscriminator(structs, indexes)
+	if level < 0 {
+		return -1
+	}
+	dv := path[level].v.FieldByName(discr.GoName)
+	if member, ok := cases[uint32(intValue(dv))]; ok {
+		return member
+	}
+	return -1
+}
+
+// fieldUnion returns union type of the field, or nil for other types.
+func fieldUnion(field *binapigen.Field) *binapigen.Union {
+	if field.TypeAlias != nil {
+		return field.TypeAlias.TypeUnion
+	}
+	return field.TypeUnion
+}
+
+// fieldStruct returns fields of struct type of the field.
+func fieldStruct(field *binapigen.Field) []*binapigen.Field {
+	switch {
+	case field.TypeAlias != nil && field.TypeAlias.TypeStruct != nil:
+		return field.TypeAlias.TypeStruct.Fields
+	case field.TypeStruct != nil:
+		return field.TypeStruct.Fields
+	}
+	return nil
+}
+
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p.Elem()
+}
+
+func intValue(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+	}
+	return 0
+}
+
+func setInt(v reflect.Value, n int64) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.OverflowInt(n) {
+			return outOfRange(v, n)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n < 0 || v.OverflowUint(uint64(n)) {
+			return outOfRange(v, n)
+		}
+		v.SetUint(uint64(n))
+	case reflect.Bool:
+		v.SetBool(n != 0)
+	}
+	return nil
+}
+
+func setUint(v reflect.Value, n uint64) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n > math.MaxInt64 || v.OverflowInt(int64(n)) {
+			return outOfRange(v, n)
+		}
+		v.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.OverflowUint(n) {
+			return outOfRange(v, n)
+		}
+		v.SetUint(n)
+	case reflect.Bool:
+		v.SetBool(n != 0)
+	}
+	return nil
+}
+
+func outOfRange[N int64 | uint64](v reflect.Value, n N) error {
+	return fmt.Errorf("%w: %d does not fit %s", codec.ErrOutOfRange, n, v.Type())
+}
+
+func bytesValue(v reflect.Value) []byte {
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b
+}
+
+func setBytes(v reflect.Value, b []byte) error {
+	if v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), len(b), len(b)))
+	} else if err := codec.CheckLength(len(b), v.Len()); err != nil {
+		return err
+	}
+	reflect.Copy(v, reflect.ValueOf(b))
+	return nil
+}
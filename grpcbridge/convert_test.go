@@ -0,0 +1,169 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"go.fd.io/govpp/binapi/fib_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/mfib_types"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+)
+
+func loadIPFile(t *testing.T) (*binapigen.File, *dynamicpb.Types) {
+	RegisterTestingT(t)
+
+	file, err := vppapi.ParseFile("../binapigen/vppapi/testdata/ip.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	gen, err := binapigen.New(binapigen.Options{}, &vppapi.VppInput{Schema: vppapi.Schema{Files: []vppapi.File{*file}}})
+	Expect(err).ShouldNot(HaveOccurred())
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{binapigen.ProtoFileDescriptor(gen.Files[0])},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	return gen.Files[0], dynamicpb.NewTypes(files)
+}
+
+func newProtoMessage(types *dynamicpb.Types, name protoreflect.FullName) protoreflect.Message {
+	typ, err := types.FindMessageByName(name)
+	Expect(err).ShouldNot(HaveOccurred())
+	return typ.New()
+}
+
+func TestConvertUnionMember(t *testing.T) {
+	file, types := loadIPFile(t)
+
+	var model *binapigen.Struct
+	for _, typ := range file.Structs {
+		if typ.Name == "address" {
+			model = typ
+		}
+	}
+	Expect(model).ToNot(BeNil())
+
+	addr := ip_types.Address{Af: ip_types.ADDRESS_IP4, Un: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1})}
+	m := newProtoMessage(types, "vpp.ip.Address")
+	structToProto(model.Fields, reflect.ValueOf(addr), m, nil)
+
+	un := m.Get(m.Descriptor().Fields().ByName("un")).Message()
+	member := un.WhichOneof(un.Descriptor().Oneofs().Get(0))
+	Expect(member).ToNot(BeNil())
+	Expect(member.Name()).To(BeEquivalentTo("ip4"))
+	Expect(un.Get(member).Bytes()).To(Equal([]byte{10, 0, 0, 1}))
+
+	var out ip_types.Address
+	Expect(structFromProto(model.Fields, m, reflect.ValueOf(&out).Elem())).To(Succeed())
+	Expect(out).To(Equal(addr))
+}
+
+func TestConvertMessage(t *testing.T) {
+	file, types := loadIPFile(t)
+
+	var model *binapigen.Message
+	for _, msg := range file.Messages {
+		if msg.Name == "ip_mroute_add_del" {
+			model = msg
+		}
+	}
+	Expect(model).ToNot(BeNil())
+
+	msg := &ip.IPMrouteAddDel{
+		IsAdd: true,
+		Route: ip.IPMroute{
+			TableID: 3,
+			Prefix: ip_types.Mprefix{
+				Af:               ip_types.ADDRESS_IP6,
+				GrpAddressLength: 128,
+				GrpAddress:       ip_types.AddressUnionIP6(ip_types.IP6Address{0xff, 0x02, 15: 0x01}),
+			},
+			NPaths: 1,
+			Paths: []mfib_types.MfibPath{{
+				ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT,
+				Path: fib_types.FibPath{
+					SwIfIndex: 1,
+					Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+					Nh:        fib_types.FibPathNh{Address: ip_types.AddressUnionIP4(ip_types.IP4Address{192, 168, 0, 1})},
+				},
+			}},
+		},
+	}
+	m := newProtoMessage(types, "vpp.ip.IPMrouteAddDel")
+	structToProto(model.Fields, reflect.ValueOf(msg).Elem(), m, nil)
+
+	// the member of next hop address is selected by proto of the path
+	route := m.Get(m.Descriptor().Fields().ByName("route")).Message()
+	mpath := route.Get(route.Descriptor().Fields().ByName("paths")).List().Get(0).Message()
+	path := mpath.Get(mpath.Descriptor().Fields().ByName("path")).Message()
+	nh := path.Get(path.Descriptor().Fields().ByName("nh")).Message()
+	addr := nh.Get(nh.Descriptor().Fields().ByName("address")).Message()
+	member := addr.WhichOneof(addr.Descriptor().Oneofs().Get(0))
+	Expect(member).ToNot(BeNil())
+	Expect(member.Name()).To(BeEquivalentTo("ip4"))
+
+	out := new(ip.IPMrouteAddDel)
+	Expect(structFromProto(model.Fields, m, reflect.ValueOf(out).Elem())).To(Succeed())
+	Expect(out).To(Equal(msg))
+
+	// the count of paths is set from their list
+	out = new(ip.IPMrouteAddDel)
+	route = m.Mutable(m.Descriptor().Fields().ByName("route")).Message()
+	route.Set(route.Descriptor().Fields().ByName("n_paths"), protoreflect.ValueOfUint32(0))
+	Expect(structFromProto(model.Fields, m, reflect.ValueOf(out).Elem())).To(Succeed())
+	Expect(out.Route.NPaths).To(BeEquivalentTo(1))
+}
+
+func TestConvertOutOfRange(t *testing.T) {
+	file, types := loadIPFile(t)
+
+	var model *binapigen.Message
+	for _, msg := range file.Messages {
+		if msg.Name == "ip_mroute_add_del" {
+			model = msg
+		}
+	}
+	Expect(model).ToNot(BeNil())
+
+	msg := &ip.IPMrouteAddDel{
+		Route: ip.IPMroute{
+			Prefix: ip_types.Mprefix{Af: ip_types.ADDRESS_IP4},
+		},
+	}
+	m := newProtoMessage(types, "vpp.ip.IPMrouteAddDel")
+	structToProto(model.Fields, reflect.ValueOf(msg).Elem(), m, nil)
+	route := m.Mutable(m.Descriptor().Fields().ByName("route")).Message()
+	prefix := route.Mutable(route.Descriptor().Fields().ByName("prefix")).Message()
+
+	prefix.Set(prefix.Descriptor().Fields().ByName("grp_address_length"), protoreflect.ValueOfUint32(1<<16))
+	err := structFromProto(model.Fields, m, reflect.ValueOf(new(ip.IPMrouteAddDel)).Elem())
+	Expect(err).To(MatchError(codec.ErrOutOfRange))
+	Expect(err.Error()).To(HavePrefix("route: prefix: grp_address_length:"))
+
+	prefix.Set(prefix.Descriptor().Fields().ByName("grp_address_length"), protoreflect.ValueOfUint32(32))
+	un := prefix.Mutable(prefix.Descriptor().Fields().ByName("grp_address")).Message()
+	un.Set(un.Descriptor().Fields().ByName("ip4"), protoreflect.ValueOfBytes(make([]byte, 5)))
+	err = structFromProto(model.Fields, m, reflect.ValueOf(new(ip.IPMrouteAddDel)).Elem())
+	Expect(err).To(MatchError(codec.ErrOutOfRange))
+}
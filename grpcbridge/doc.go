@@ -0,0 +1,35 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package grpcbridge serves VPP API as gRPC services.
+//
+// Each API file with a service is served as gRPC service described by the
+// protobuf schema generated by the proto plugin. The unary RPCs are forwarded
+// to VPP, the dump RPCs stream the details messages and the RPCs watching
+// events stream the events until the client cancels the call. The reply
+// following the details messages is sent in trailer metadata ReplyTrailer.
+//
+// The servers generated by the grpc plugin use this package to map errors to
+// gRPC status. Server serves the same services without generated code:
+//
+//	bridge, err := grpcbridge.NewServer(conn, schema)
+//	if err != nil {
+//		return err
+//	}
+//	srv := grpc.NewServer()
+//	bridge.Register(srv)
+//
+// The messages are sent to VPP as binapi messages, so the binapi packages of
+// the served API files must be imported.
+package grpcbridge
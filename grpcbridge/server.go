@@ -0,0 +1,372 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/internal/apischema"
+)
+
+// Server serves services of VPP API files as gRPC services, without any code
+// generated for them. The services and messages are described by the schema
+// generated by the proto plugin, which is built from the API definitions at
+// runtime, and the calls are forwarded to VPP the same way as by the servers
+// generated by the grpc plugin.
+//
+// The messages are sent to VPP as binapi messages registered by the imported
+// binapi packages with the same name and CRC as in the API definitions.
+// The RPCs with messages that are not registered fail with code Unimplemented.
+type Server struct {
+	conn     api.Connection
+	files    *protoregistry.Files
+	services []*grpc.ServiceDesc
+	subs     Subscriptions
+
+	controlPing reflect.Type
+}
+
+// message maps API message to its protobuf descriptor and binapi type.
+type message struct {
+	model *binapigen.Message
+	desc  protoreflect.MessageDescriptor
+	typ   reflect.Type // nil if the message is not registered
+}
+
+// NewServer returns server of the services defined by API files in schema,
+// which forwards the calls to VPP using connection conn.
+func NewServer(conn api.Connection, schema *vppapi.Schema) (*Server, error) {
+	gen, err := apischema.Load(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	set := new(descriptorpb.FileDescriptorSet)
+	fileDescs := make(map[*binapigen.File]*descriptorpb.FileDescriptorProto)
+	for _, file := range gen.Files {
+		fd := binapigen.ProtoFileDescriptor(file)
+		fileDescs[file] = fd
+		set.File = append(set.File, fd)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("building protobuf descriptors failed: %w", err)
+	}
+
+	registry := apischema.NewRegistry()
+	s := &Server{
+		conn:        conn,
+		files:       files,
+		controlPing: registry.ControlPing(),
+	}
+	lookup := func(msg *binapigen.Message) (*message, error) {
+		name := protoreflect.FullName(fileDescs[msg.File].GetPackage()).Append(protoreflect.Name(msg.GoName))
+		desc, err := files.FindDescriptorByName(name)
+		if err != nil {
+			return nil, err
+		}
+		return &message{
+			model: msg,
+			desc:  desc.(protoreflect.MessageDescriptor),
+			typ:   registry.MessageType(msg),
+		}, nil
+	}
+
+	for _, file := range gen.Files {
+		if file.Service == nil || len(file.Service.RPCs) == 0 {
+			continue
+		}
+		svc, err := s.newService(file, fileDescs[file], lookup)
+		if err != nil {
+			return nil, fmt.Errorf("service of API file %s: %w", file.Desc.Name, err)
+		}
+		s.services = append(s.services, svc)
+	}
+
+	return s, nil
+}
+
+// Files returns descriptors of protobuf schemas of the services.
+func (s *Server) Files() *protoregistry.Files {
+	return s.files
+}
+
+// Register registers all services in gRPC server reg.
+func (s *Server) Register(reg grpc.ServiceRegistrar) {
+	for _, svc := range s.services {
+		reg.RegisterService(svc, s)
+	}
+}
+
+func (s *Server) newService(file *binapigen.File, fd *descriptorpb.FileDescriptorProto, lookup func(*binapigen.Message) (*message, error)) (*grpc.ServiceDesc, error) {
+	sd := fd.GetService()[0]
+	svc := &grpc.ServiceDesc{
+		ServiceName: fd.GetPackage() + "." + sd.GetName(),
+		HandlerType: (*any)(nil),
+		Metadata:    fd.GetName(),
+	}
+	for _, rpc := range file.Service.RPCs {
+		if rpc.MsgReply == nil {
+			continue
+		}
+		req, err := lookup(rpc.MsgRequest)
+		if err != nil {
+			return nil, err
+		}
+		reply, err := lookup(rpc.MsgReply)
+		if err != nil {
+			return nil, err
+		}
+		if rpc.VPP.Stream {
+			details := reply
+			if rpc.MsgStream != nil {
+				if details, err = lookup(rpc.MsgStream); err != nil {
+					return nil, err
+				}
+			}
+			svc.Streams = append(svc.Streams, grpc.StreamDesc{
+				StreamName:    rpc.GoName,
+				Handler:       s.dumpHandler(req, details, reply, rpc.MsgStream == nil),
+				ServerStreams: true,
+			})
+		} else {
+			svc.Methods = append(svc.Methods, grpc.MethodDesc{
+				MethodName: rpc.GoName,
+				Handler:    s.unaryHandler(svc.ServiceName+"/"+rpc.GoName, req, reply),
+			})
+		}
+	}
+	for _, watch := range binapigen.ProtoWatchRPCs(file.Service) {
+		req, err := lookup(watch.RPC.MsgRequest)
+		if err != nil {
+			return nil, err
+		}
+		reply, err := lookup(watch.RPC.MsgReply)
+		if err != nil {
+			return nil, err
+		}
+		event, err := lookup(watch.Event)
+		if err != nil {
+			return nil, err
+		}
+		svc.Streams = append(svc.Streams, grpc.StreamDesc{
+			StreamName:    watch.Name,
+			Handler:       s.watchHandler(req, reply, event, watch.Enable),
+			ServerStreams: true,
+		})
+	}
+	return svc, nil
+}
+
+func (s *Server) unaryHandler(method string, req, reply *message) grpc.MethodHandler {
+	handler := func(ctx context.Context, in any) (any, error) {
+		msg, err := req.fromProto(in.(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+		out, err := reply.new()
+		if err != nil {
+			return nil, err
+		}
+		if err := s.conn.Invoke(ctx, msg, out); err != nil {
+			return nil, Status(err)
+		}
+		return reply.toProto(out), nil
+	}
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := dynamicpb.NewMessage(req.desc)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: "/" + method,
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// dumpHandler returns handler streaming the details messages. The reply
+// ending the RPCs with details is sent as trailer metadata, see ReplyTrailer.
+func (s *Server) dumpHandler(req, details, reply *message, controlPing bool) grpc.StreamHandler {
+	return func(srv any, stream grpc.ServerStream) error {
+		in := dynamicpb.NewMessage(req.desc)
+		if err := stream.RecvMsg(in); err != nil {
+			return err
+		}
+		msg, err := req.fromProto(in)
+		if err != nil {
+			return err
+		}
+		if controlPing && s.controlPing == nil {
+			return status.Errorf(codes.Unimplemented, "message %s is not registered", apischema.ControlPingName)
+		}
+		if _, err := details.new(); err != nil {
+			return err
+		}
+
+		vppStream, err := s.conn.NewStream(stream.Context())
+		if err != nil {
+			return Status(err)
+		}
+		defer vppStream.Close()
+		if err := vppStream.SendMsg(msg); err != nil {
+			return Status(err)
+		}
+		if controlPing {
+			ping := reflect.New(s.controlPing).Interface().(api.Message)
+			if err := vppStream.SendMsg(ping); err != nil {
+				return Status(err)
+			}
+		}
+		for {
+			m, err := vppStream.RecvMsg()
+			if err != nil {
+				return Status(err)
+			}
+			if reflect.TypeOf(m).Elem() != details.typ {
+				// the reply or control ping reply ends the stream
+				if !controlPing && reflect.TypeOf(m).Elem() == reply.typ {
+					if err := SetReplyTrailer(stream, reply.toProto(m)); err != nil {
+						return err
+					}
+				}
+				return Status(retvalError(vppStream, m))
+			}
+			if err := stream.SendMsg(details.toProto(m)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) watchHandler(req, reply, event *message, enable *binapigen.Field) grpc.StreamHandler {
+	return func(srv any, stream grpc.ServerStream) error {
+		in := dynamicpb.NewMessage(req.desc)
+		if err := stream.RecvMsg(in); err != nil {
+			return err
+		}
+		msg, err := req.fromProto(in)
+		if err != nil {
+			return err
+		}
+		out, err := reply.new()
+		if err != nil {
+			return err
+		}
+		ev, err := event.new()
+		if err != nil {
+			return err
+		}
+
+		ctx := stream.Context()
+		watcher, err := s.conn.WatchEvent(ctx, ev)
+		if err != nil {
+			return Status(err)
+		}
+		defer watcher.Close()
+		enableEvents := func() error {
+			return s.conn.Invoke(ctx, msg, out)
+		}
+		if enable == nil {
+			if err := enableEvents(); err != nil {
+				return Status(err)
+			}
+		} else {
+			// the events are shared by the watchers of the same request
+			unsubscribe, err := s.subs.Subscribe(msg, enableEvents, func() {
+				// disable the events, the stream context is already done
+				disable := reflect.New(req.typ)
+				disable.Elem().Set(reflect.ValueOf(msg).Elem())
+				disable.Elem().FieldByName(enable.GoName).SetZero()
+				out, _ := reply.new()
+				_ = s.conn.Invoke(context.Background(), disable.Interface().(api.Message), out)
+			})
+			if err != nil {
+				return Status(err)
+			}
+			defer unsubscribe()
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case e, ok := <-watcher.Events():
+				if !ok {
+					return nil
+				}
+				if err := stream.SendMsg(event.toProto(e)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// new returns new instance of binapi message.
+func (m *message) new() (api.Message, error) {
+	if m.typ == nil {
+		return nil, status.Errorf(codes.Unimplemented, "message %s (CRC %s) is not registered", m.model.Name, m.model.CRC)
+	}
+	return reflect.New(m.typ).Interface().(api.Message), nil
+}
+
+// fromProto returns binapi message converted from protobuf message, or
+// InvalidArgument status error if it does not fit the binapi message.
+func (m *message) fromProto(in proto.Message) (api.Message, error) {
+	msg, err := m.new()
+	if err != nil {
+		return nil, err
+	}
+	err = structFromProto(m.model.Fields, in.ProtoReflect(), reflect.ValueOf(msg).Elem())
+	if err := CheckRequest(msg, err); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// toProto returns protobuf message converted from binapi message.
+func (m *message) toProto(msg api.Message) proto.Message {
+	out := dynamicpb.NewMessage(m.desc)
+	structToProto(m.model.Fields, reflect.ValueOf(msg).Elem(), out, nil)
+	return out
+}
+
+// retvalError returns error for the retval of reply message received from stream.
+func retvalError(stream api.Stream, reply api.Message) error {
+	retval := reflect.ValueOf(reply).Elem().FieldByName("Retval")
+	if !retval.IsValid() {
+		return nil
+	}
+	return api.StreamReplyError(stream, reply, int32(intValue(retval)))
+}
@@ -0,0 +1,369 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+	"go.fd.io/govpp/grpcbridge"
+)
+
+type wantTestEvents struct {
+	EnableDisable bool   `binapi:"bool,name=enable_disable" json:"enable_disable,omitempty"`
+	PID           uint32 `binapi:"u32,name=pid" json:"pid,omitempty"`
+}
+
+func (m *wantTestEvents) Reset()                        { *m = wantTestEvents{} }
+func (*wantTestEvents) GetMessageName() string          { return "want_test_events" }
+func (*wantTestEvents) GetCrcString() string            { return "c5e2af94" }
+func (*wantTestEvents) GetMessageType() api.MessageType { return api.RequestMessage }
+
+type wantTestEventsReply struct {
+	Retval int32 `binapi:"i32,name=retval" json:"retval,omitempty"`
+}
+
+func (m *wantTestEventsReply) Reset()                        { *m = wantTestEventsReply{} }
+func (*wantTestEventsReply) GetMessageName() string          { return "want_test_events_reply" }
+func (*wantTestEventsReply) GetCrcString() string            { return "e8d4e804" }
+func (*wantTestEventsReply) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+type testEvent struct {
+	PID   uint32 `binapi:"u32,name=pid" json:"pid,omitempty"`
+	Index uint32 `binapi:"u32,name=index" json:"index,omitempty"`
+}
+
+func (m *testEvent) Reset()                        { *m = testEvent{} }
+func (*testEvent) GetMessageName() string          { return "test_event" }
+func (*testEvent) GetCrcString() string            { return "2b3c4d5e" }
+func (*testEvent) GetMessageType() api.MessageType { return api.EventMessage }
+
+type testGet struct {
+	Cursor uint32 `binapi:"u32,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *testGet) Reset()                        { *m = testGet{} }
+func (*testGet) GetMessageName() string          { return "test_get" }
+func (*testGet) GetCrcString() string            { return "3c5f8a91" }
+func (*testGet) GetMessageType() api.MessageType { return api.RequestMessage }
+
+type testGetReply struct {
+	Retval int32  `binapi:"i32,name=retval" json:"retval,omitempty"`
+	Cursor uint32 `binapi:"u32,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *testGetReply) Reset()                        { *m = testGetReply{} }
+func (*testGetReply) GetMessageName() string          { return "test_get_reply" }
+func (*testGetReply) GetCrcString() string            { return "53b48f5d" }
+func (*testGetReply) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+type testDetails struct {
+	Index uint32 `binapi:"u32,name=index" json:"index,omitempty"`
+}
+
+func (m *testDetails) Reset()                        { *m = testDetails{} }
+func (*testDetails) GetMessageName() string          { return "test_details" }
+func (*testDetails) GetCrcString() string            { return "8d6a3f02" }
+func (*testDetails) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+func init() {
+	api.RegisterMessage((*testGet)(nil), "grpcbridge_test.TestGet")
+	api.RegisterMessage((*testGetReply)(nil), "grpcbridge_test.TestGetReply")
+	api.RegisterMessage((*testDetails)(nil), "grpcbridge_test.TestDetails")
+	api.RegisterMessage((*wantTestEvents)(nil), "grpcbridge_test.WantTestEvents")
+	api.RegisterMessage((*wantTestEventsReply)(nil), "grpcbridge_test.WantTestEventsReply")
+	api.RegisterMessage((*testEvent)(nil), "grpcbridge_test.TestEvent")
+}
+
+type testCtx struct {
+	mockVpp *mock.VppAdapter
+	server  *grpcbridge.Server
+	client  *grpc.ClientConn
+}
+
+func setupTest(t *testing.T) *testCtx {
+	RegisterTestingT(t)
+
+	schema := new(vppapi.Schema)
+	for _, path := range []string{"../binapigen/vppapi/testdata/ip.api.json", "testdata/events.api.json", "testdata/cursor.api.json"} {
+		file, err := vppapi.ParseFile(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		schema.Files = append(schema.Files, *file)
+	}
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(conn.Disconnect)
+
+	server, err := grpcbridge.NewServer(conn, schema)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	server.Register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	client, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(func() { _ = client.Close() })
+
+	return &testCtx{
+		mockVpp: mockVpp,
+		server:  server,
+		client:  client,
+	}
+}
+
+func (ctx *testCtx) newMessage(name protoreflect.FullName) *dynamicpb.Message {
+	desc, err := ctx.server.Files().FindDescriptorByName(name)
+	Expect(err).ShouldNot(HaveOccurred())
+	return dynamicpb.NewMessage(desc.(protoreflect.MessageDescriptor))
+}
+
+func (ctx *testCtx) newStream(method string) grpc.ClientStream {
+	desc := &grpc.StreamDesc{ServerStreams: true}
+	stream, err := ctx.client.NewStream(context.Background(), desc, method)
+	Expect(err).ShouldNot(HaveOccurred())
+	return stream
+}
+
+func TestServerUnary(t *testing.T) {
+	ctx := setupTest(t)
+
+	req := ctx.newMessage("vpp.ip.IPTableAddDel")
+	req.Set(req.Descriptor().Fields().ByName("is_add"), protoreflect.ValueOfBool(true))
+	table := req.Mutable(req.Descriptor().Fields().ByName("table")).Message()
+	table.Set(table.Descriptor().Fields().ByName("table_id"), protoreflect.ValueOfUint32(10))
+	table.Set(table.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("ten"))
+
+	ctx.mockVpp.MockReply(&ip.IPTableAddDelReply{})
+	reply := ctx.newMessage("vpp.ip.IPTableAddDelReply")
+	err := ctx.client.Invoke(context.Background(), "/vpp.ip.IPService/IPTableAddDel", req, reply)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	ctx.mockVpp.MockReply(&ip.IPTableAddDelReply{Retval: int32(api.NO_SUCH_FIB)})
+	err = ctx.client.Invoke(context.Background(), "/vpp.ip.IPService/IPTableAddDel", req, reply)
+	Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	// the name exceeds the fixed length of binapi string
+	table.Set(table.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString(strings.Repeat("x", 65)))
+	err = ctx.client.Invoke(context.Background(), "/vpp.ip.IPService/IPTableAddDel", req, reply)
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func TestServerUnregistered(t *testing.T) {
+	ctx := setupTest(t)
+
+	// the binapi message has different CRC than ip_route_lookup in schema
+	req := ctx.newMessage("vpp.ip.IPRouteLookup")
+	reply := ctx.newMessage("vpp.ip.IPRouteLookupReply")
+	err := ctx.client.Invoke(context.Background(), "/vpp.ip.IPService/IPRouteLookup", req, reply)
+	Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+}
+
+func TestServerDump(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 1, Name: "one"}},
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 2, IsIP6: true, Name: "two"}},
+	)
+	ctx.mockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	stream := ctx.newStream("/vpp.ip.IPService/IPTableDump")
+	Expect(stream.SendMsg(ctx.newMessage("vpp.ip.IPTableDump"))).To(Succeed())
+	Expect(stream.CloseSend()).To(Succeed())
+
+	var names []string
+	for {
+		details := ctx.newMessage("vpp.ip.IPTableDetails")
+		err := stream.RecvMsg(details)
+		if err == io.EOF {
+			break
+		}
+		Expect(err).ShouldNot(HaveOccurred())
+		table := details.Get(details.Descriptor().Fields().ByName("table")).Message()
+		names = append(names, table.Get(table.Descriptor().Fields().ByName("name")).String())
+	}
+	Expect(names).To(Equal([]string{"one", "two"}))
+}
+
+func TestServerDumpReply(t *testing.T) {
+	ctx := setupTest(t)
+
+	recv := func(retval api.VPPApiError) ([]uint32, protoreflect.Message, error) {
+		ctx.mockVpp.MockReply(
+			&testDetails{Index: 1},
+			&testDetails{Index: 2},
+			&testGetReply{Retval: int32(retval), Cursor: 3},
+		)
+		stream := ctx.newStream("/vpp.cursor.CursorService/TestGet")
+		Expect(stream.SendMsg(ctx.newMessage("vpp.cursor.TestGet"))).To(Succeed())
+		Expect(stream.CloseSend()).To(Succeed())
+
+		var indexes []uint32
+		for {
+			details := ctx.newMessage("vpp.cursor.TestDetails")
+			if err := stream.RecvMsg(details); err != nil {
+				reply := ctx.newMessage("vpp.cursor.TestGetReply")
+				ok, trailerErr := grpcbridge.ReplyFromTrailer(stream.Trailer(), reply)
+				Expect(trailerErr).ShouldNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				return indexes, reply, err
+			}
+			indexes = append(indexes, uint32(details.Get(details.Descriptor().Fields().ByName("index")).Uint()))
+		}
+	}
+
+	indexes, reply, err := recv(0)
+	Expect(err).To(Equal(io.EOF))
+	Expect(indexes).To(Equal([]uint32{1, 2}))
+	Expect(reply.Get(reply.Descriptor().Fields().ByName("cursor")).Uint()).To(BeEquivalentTo(3))
+
+	// the reply is sent also with the error of its retval
+	_, reply, err = recv(api.NO_SUCH_ENTRY)
+	Expect(status.Code(err)).To(Equal(codes.NotFound))
+	Expect(reply.Get(reply.Descriptor().Fields().ByName("retval")).Int()).To(BeEquivalentTo(api.NO_SUCH_ENTRY))
+}
+
+func TestServerWatch(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(&wantTestEventsReply{}, &testEvent{PID: 7, Index: 3})
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := ctx.client.NewStream(streamCtx, &grpc.StreamDesc{ServerStreams: true}, "/vpp.events.EventsService/WatchTestEvent")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	req := ctx.newMessage("vpp.events.WantTestEvents")
+	req.Set(req.Descriptor().Fields().ByName("enable_disable"), protoreflect.ValueOfBool(true))
+	Expect(stream.SendMsg(req)).To(Succeed())
+	Expect(stream.CloseSend()).To(Succeed())
+
+	event := ctx.newMessage("vpp.events.TestEvent")
+	Expect(stream.RecvMsg(event)).To(Succeed())
+	Expect(event.Get(event.Descriptor().Fields().ByName("index")).Uint()).To(BeEquivalentTo(3))
+	Expect(event.Get(event.Descriptor().Fields().ByName("pid")).Uint()).To(BeEquivalentTo(7))
+
+	// reply to disabling the events
+	ctx.mockVpp.MockReply(&wantTestEventsReply{})
+}
+
+func TestServerWatchShared(t *testing.T) {
+	ctx := setupTest(t)
+
+	// record the requests enabling and disabling the events, the handler
+	// is used only when there are no mocked replies
+	var mu sync.Mutex
+	var requests []bool
+	ctx.mockVpp.MockReplyHandler(func(req mock.MessageDTO) ([]byte, uint16, bool) {
+		if req.MsgName == "want_test_events" {
+			var msg wantTestEvents
+			Expect(codec.DefaultCodec.DecodeMsg(req.Data, &msg)).To(Succeed())
+			mu.Lock()
+			requests = append(requests, msg.EnableDisable)
+			mu.Unlock()
+		}
+		return nil, 0, false
+	})
+	recorded := func() []bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]bool(nil), requests...)
+	}
+
+	watch := func(streamCtx context.Context) <-chan uint64 {
+		stream, err := ctx.client.NewStream(streamCtx, &grpc.StreamDesc{ServerStreams: true}, "/vpp.events.EventsService/WatchTestEvent")
+		Expect(err).ShouldNot(HaveOccurred())
+		req := ctx.newMessage("vpp.events.WantTestEvents")
+		req.Set(req.Descriptor().Fields().ByName("enable_disable"), protoreflect.ValueOfBool(true))
+		Expect(stream.SendMsg(req)).To(Succeed())
+		Expect(stream.CloseSend()).To(Succeed())
+
+		events := make(chan uint64, 10)
+		go func() {
+			defer close(events)
+			for {
+				event := ctx.newMessage("vpp.events.TestEvent")
+				if err := stream.RecvMsg(event); err != nil {
+					return
+				}
+				events <- event.Get(event.Descriptor().Fields().ByName("index")).Uint()
+			}
+		}()
+		return events
+	}
+
+	// the first watcher enables the events
+	ctx.mockVpp.MockReply(&wantTestEventsReply{}, &testEvent{Index: 1})
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	events1 := watch(ctx1)
+	Eventually(events1).Should(Receive(BeEquivalentTo(1)))
+
+	// the second watcher shares the events, it is ready when it receives
+	// the event sent after the reply to other request
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	events2 := watch(ctx2)
+	Eventually(func() bool {
+		ctx.mockVpp.MockReply(&ip.IPTableAddDelReply{}, &testEvent{Index: 2})
+		req := ctx.newMessage("vpp.ip.IPTableAddDel")
+		reply := ctx.newMessage("vpp.ip.IPTableAddDelReply")
+		Expect(ctx.client.Invoke(context.Background(), "/vpp.ip.IPService/IPTableAddDel", req, reply)).To(Succeed())
+		select {
+		case index := <-events2:
+			return index == 2
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}).Should(BeTrue())
+
+	// the events stay enabled until the last watcher ends
+	cancel1()
+	Eventually(events1).Should(BeClosed())
+	Consistently(recorded, 200*time.Millisecond).Should(BeEmpty())
+	cancel2()
+	Eventually(recorded).Should(Equal([]bool{false}))
+}
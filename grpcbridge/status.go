@@ -0,0 +1,84 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+)
+
+// Status returns the error of VPP API call as gRPC status error. The code
+// is derived from the category of VPP API error returned in retval, or from
+// the failure of the call itself.
+func Status(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(Code(err), err.Error())
+}
+
+// CheckRequest returns InvalidArgument status error if the conversion of
+// request req from protobuf failed with err, or if req violates constraints
+// of the binapi message, e.g. the fixed lengths of its fields.
+func CheckRequest(req api.Message, err error) error {
+	if err == nil {
+		err = codec.ValidateMsg(req)
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// Code returns gRPC code for the error of VPP API call.
+func Code(err error) codes.Code {
+	var compatErr *api.CompatibilityError
+	var validationErr *codec.ValidationError
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, core.ErrReplyTimeout):
+		return codes.DeadlineExceeded
+	case errors.Is(err, core.ErrNotConnected):
+		return codes.Unavailable
+	case errors.As(err, &compatErr):
+		return codes.Unimplemented
+	case errors.Is(err, codec.ErrOutOfRange), errors.As(err, &validationErr):
+		return codes.InvalidArgument
+	case api.IsNotFound(err):
+		return codes.NotFound
+	case api.IsAlreadyExists(err):
+		return codes.AlreadyExists
+	case api.IsInUse(err):
+		return codes.FailedPrecondition
+	case api.IsInvalidArgument(err):
+		return codes.InvalidArgument
+	case api.IsUnimplemented(err):
+		return codes.Unimplemented
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,81 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+	"go.fd.io/govpp/grpcbridge"
+)
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"nil", nil, codes.OK},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"timeout", fmt.Errorf("call: %w", core.ErrReplyTimeout), codes.DeadlineExceeded},
+		{"not connected", core.ErrNotConnected, codes.Unavailable},
+		{"incompatible", &api.CompatibilityError{}, codes.Unimplemented},
+		{"out of range", fmt.Errorf("field: %w", codec.ErrOutOfRange), codes.InvalidArgument},
+		{"validation", &codec.ValidationError{Err: codec.ErrOutOfRange}, codes.InvalidArgument},
+		{"no such entry", api.NO_SUCH_ENTRY, codes.NotFound},
+		{"already exists", api.VALUE_EXIST, codes.AlreadyExists},
+		{"invalid value", api.INVALID_VALUE, codes.InvalidArgument},
+		{"unimplemented", api.UNIMPLEMENTED, codes.Unimplemented},
+		{"other", api.SYSCALL_ERROR_1, codes.Unknown},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(grpcbridge.Code(test.err)).To(Equal(test.want))
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(grpcbridge.Status(nil)).To(Succeed())
+
+	err := grpcbridge.Status(api.NO_SUCH_ENTRY)
+	Expect(status.Code(err)).To(Equal(codes.NotFound))
+	Expect(status.Convert(err).Message()).To(Equal(api.NO_SUCH_ENTRY.Error()))
+
+	// the status errors are passed as they are
+	statusErr := status.Error(codes.Aborted, "aborted")
+	Expect(grpcbridge.Status(statusErr)).To(BeIdenticalTo(statusErr))
+}
+
+func TestCheckRequest(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(grpcbridge.CheckRequest(&memclnt.ControlPing{}, nil)).To(Succeed())
+
+	err := grpcbridge.CheckRequest(nil, fmt.Errorf("field: %w", codec.ErrOutOfRange))
+	Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
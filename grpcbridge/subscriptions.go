@@ -0,0 +1,66 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"fmt"
+	"sync"
+
+	"go.fd.io/govpp/api"
+)
+
+// Subscriptions counts the watchers of events enabled by want_* requests.
+// VPP keeps single registration of the events per client, so the events are
+// enabled for the first watcher and disabled after the last watcher ends.
+// The watchers are counted per request, the requests with different values,
+// e.g. filtering the events, are enabled separately.
+//
+// The zero value is ready to use.
+type Subscriptions struct {
+	mu       sync.Mutex
+	watchers map[string]int
+}
+
+// Subscribe calls enable if there is no other watcher of the events enabled
+// by request req. It returns function ending the watch, which calls disable
+// if there is no other watcher left.
+func (s *Subscriptions) Subscribe(req api.Message, enable func() error, disable func()) (unsubscribe func(), err error) {
+	key := fmt.Sprintf("%s%+v", req.GetMessageName(), req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.watchers[key] == 0 {
+		if err := enable(); err != nil {
+			return nil, err
+		}
+	}
+	if s.watchers == nil {
+		s.watchers = make(map[string]int)
+	}
+	s.watchers[key]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.watchers[key]--; s.watchers[key] > 0 {
+				return
+			}
+			delete(s.watchers, key)
+			disable()
+		})
+	}, nil
+}
@@ -0,0 +1,50 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package grpcbridge
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReplyTrailer is the key of trailer metadata with the reply ending the dump
+// RPCs that stream details messages, e.g. with the cursor for the next dump.
+// The value is the reply message in protobuf binary encoding.
+const ReplyTrailer = "vpp-reply-bin"
+
+// SetReplyTrailer sets reply ending the dump RPC as trailer metadata of
+// stream. The reply is sent also if the RPC fails with its retval.
+func SetReplyTrailer(stream grpc.ServerStream, reply proto.Message) error {
+	data, err := proto.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.Pairs(ReplyTrailer, string(data)))
+	return nil
+}
+
+// ReplyFromTrailer decodes the reply ending the dump RPC from trailer
+// metadata md into reply. It reports false if md contains no reply.
+func ReplyFromTrailer(md metadata.MD, reply proto.Message) (bool, error) {
+	values := md.Get(ReplyTrailer)
+	if len(values) == 0 {
+		return false, nil
+	}
+	if err := proto.Unmarshal([]byte(values[len(values)-1]), reply); err != nil {
+		return false, err
+	}
+	return true, nil
+}
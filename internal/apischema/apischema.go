@@ -0,0 +1,86 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package apischema loads VPP API definitions at runtime and resolves their
+// messages to the binapi message types registered by the imported binapi
+// packages.
+package apischema
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// ControlPingName is the name of the control ping message ending the dumps.
+const ControlPingName = "control_ping"
+
+// Load returns generator with the API files of schema with resolved types.
+// The files of schema are left intact.
+func Load(schema *vppapi.Schema) (*binapigen.Generator, error) {
+	// generator normalizes the API files in place
+	input := &vppapi.VppInput{Schema: *schema}
+	input.Schema.Files = append([]vppapi.File(nil), schema.Files...)
+	gen, err := binapigen.New(binapigen.Options{}, input)
+	if err != nil {
+		return nil, fmt.Errorf("loading API files failed: %w", err)
+	}
+	return gen, nil
+}
+
+// Registry maps the API messages to the registered binapi message types,
+// or to the types of messages defined at runtime, see NewMessageType.
+type Registry struct {
+	types       map[string]reflect.Type
+	controlPing reflect.Type
+
+	named map[string][]reflect.Type // binapi types by VPP type name
+	built map[string]reflect.Type   // types of the messages defined at runtime by VPP type name
+}
+
+// NewRegistry returns registry of the binapi messages registered so far.
+func NewRegistry() *Registry {
+	r := &Registry{
+		types: make(map[string]reflect.Type),
+		named: make(map[string][]reflect.Type),
+		built: make(map[string]reflect.Type),
+	}
+	visited := make(map[reflect.Type]bool)
+	for _, msgs := range api.GetRegisteredMessages() {
+		for key, msg := range msgs {
+			r.types[key] = reflect.TypeOf(msg).Elem()
+			if msg.GetMessageName() == ControlPingName {
+				r.controlPing = reflect.TypeOf(msg).Elem()
+			}
+			r.addNamedTypes(reflect.TypeOf(msg).Elem(), visited)
+		}
+	}
+	r.sortNamedTypes()
+	return r
+}
+
+// MessageType returns binapi type of the message registered with the same
+// name and CRC as msg, or nil if there is no such message.
+func (r *Registry) MessageType(msg *binapigen.Message) reflect.Type {
+	return r.types[msg.Name+"_"+msg.CRC]
+}
+
+// ControlPing returns binapi type of the control ping message, or nil if
+// it is not registered.
+func (r *Registry) ControlPing() reflect.Type {
+	return r.controlPing
+}
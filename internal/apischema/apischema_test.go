@@ -0,0 +1,115 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package apischema
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+)
+
+func TestLoad(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("../../binapigen/vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	schema := &vppapi.Schema{Files: files}
+	var types []int
+	for _, file := range files {
+		types = append(types, len(file.StructTypes))
+	}
+
+	gen, err := Load(schema)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(gen.FilesByName).To(HaveKey("ip"))
+	for _, typ := range gen.FilesByName["ip"].Structs {
+		Expect(typ.Name).ToNot(Equal("prefix"))
+	}
+	// the imported types are removed only from the loaded files
+	for i, file := range schema.Files {
+		Expect(file.StructTypes).To(HaveLen(types[i]))
+	}
+
+	registry := NewRegistry()
+	Expect(registry.ControlPing()).To(Equal(reflect.TypeOf(memclnt.ControlPing{})))
+
+	msgs := make(map[string]reflect.Type)
+	for _, msg := range gen.FilesByName["ip"].Messages {
+		msgs[msg.Name] = registry.MessageType(msg)
+	}
+	Expect(msgs["ip_table_add_del"]).To(Equal(reflect.TypeOf(ip.IPTableAddDel{})))
+	// the message with different CRC is not resolved
+	msg := *gen.FilesByName["ip"].Messages[0]
+	msg.CRC = "00000000"
+	Expect(registry.MessageType(&msg)).To(BeNil())
+}
+
+func TestNewMessageType(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("../../binapigen/vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	gen, err := Load(&vppapi.Schema{Files: files})
+	Expect(err).ShouldNot(HaveOccurred())
+	registry := NewRegistry()
+
+	// the message without binapi type uses the binapi types of its fields
+	var routeAddDel *binapigen.Message
+	for _, msg := range gen.FilesByName["ip"].Messages {
+		if msg.Name == "ip_route_add_del" {
+			routeAddDel = msg
+		}
+	}
+	msgType := registry.NewMessageType(routeAddDel)
+	Expect(msgType.Type().Field(2).Type).To(Equal(reflect.TypeOf(ip.IPRoute{})))
+
+	msg := msgType.New()
+	Expect(msg.GetMessageType()).To(Equal(api.RequestMessage))
+	Expect(codec.DecodeJSON([]byte(`{"is_add": true, "route": {"table_id": 1, "prefix": "10.0.0.0/24", "paths": [{"weight": 1}]}}`), msg)).To(Succeed())
+	data, err := codec.EncodeMsg(msg, 1)
+	Expect(err).ShouldNot(HaveOccurred())
+	var binapiMsg ip.IPRouteAddDel
+	Expect(codec.DecodeMsg(data, &binapiMsg)).To(Succeed())
+	Expect(binapiMsg.IsAdd).To(BeTrue())
+	Expect(binapiMsg.Route.Prefix.String()).To(Equal("10.0.0.0/24"))
+	Expect(binapiMsg.Route.Paths).To(HaveLen(1))
+
+	// the types without binapi type are built from the definitions
+	var details *binapigen.Message
+	for _, msg := range gen.FilesByName["example"].Messages {
+		if msg.Name == "example_details" {
+			details = msg
+		}
+	}
+	msg = registry.NewMessageType(details).New()
+	Expect(msg.GetMessageType()).To(Equal(api.ReplyMessage))
+	Expect(codec.DecodeJSON([]byte(`{"data": "0102", "name": "x"}`), msg)).To(Succeed())
+	data, err = codec.EncodeMsg(msg, 1)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(data[6:]).To(HaveLen(1 + 2 + 32))
+	decoded := msg.NewMessage()
+	Expect(codec.DecodeMsg(data, decoded)).To(Succeed())
+	out, err := codec.EncodeJSON(decoded)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(out).To(MatchJSON(`{"data": "0102", "name": "x"}`))
+}
@@ -0,0 +1,311 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package apischema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// Message is API message without binapi type, defined at runtime from the
+// API definitions. The fields of the message are held by a value of struct
+// type built by reflect.StructOf the same way as the binapi types are
+// generated, which is encoded by the codec using the reflection plans.
+type Message struct {
+	typ   *MessageType
+	value reflect.Value
+}
+
+func (m *Message) GetMessageName() string          { return m.typ.name }
+func (m *Message) GetCrcString() string            { return m.typ.crc }
+func (m *Message) GetMessageType() api.MessageType { return m.typ.kind }
+
+// NewMessage returns a new message of the same type.
+func (m *Message) NewMessage() api.Message {
+	return m.typ.New()
+}
+
+// MessageValue returns the struct value with the fields of the message.
+func (m *Message) MessageValue() reflect.Value {
+	return m.value
+}
+
+// MessageType is the type of the messages defined at runtime.
+type MessageType struct {
+	name string
+	crc  string
+	kind api.MessageType
+	typ  reflect.Type
+}
+
+// New returns a new message of the type.
+func (t *MessageType) New() *Message {
+	return &Message{typ: t, value: reflect.New(t.typ).Elem()}
+}
+
+// Type returns the struct type with the fields of the messages.
+func (t *MessageType) Type() reflect.Type {
+	return t.typ
+}
+
+// types of the built-in VPP types
+var baseTypes = map[string]reflect.Type{
+	binapigen.U8:     reflect.TypeOf(uint8(0)),
+	binapigen.I8:     reflect.TypeOf(int8(0)),
+	binapigen.U16:    reflect.TypeOf(uint16(0)),
+	binapigen.I16:    reflect.TypeOf(int16(0)),
+	binapigen.U32:    reflect.TypeOf(uint32(0)),
+	binapigen.I32:    reflect.TypeOf(int32(0)),
+	binapigen.U64:    reflect.TypeOf(uint64(0)),
+	binapigen.I64:    reflect.TypeOf(int64(0)),
+	binapigen.F64:    reflect.TypeOf(float64(0)),
+	binapigen.BOOL:   reflect.TypeOf(false),
+	binapigen.STRING: reflect.TypeOf(""),
+}
+
+// NewMessageType returns the type of the message without binapi type. The
+// types of the fields are the binapi types registered for the VPP types with
+// the same definition, so the messages have the same JSON form as the binapi
+// messages. The other types are built from the API definitions, their enums
+// are numbers and their unions are hex of the union data in the JSON form.
+func (r *Registry) NewMessageType(msg *binapigen.Message) *MessageType {
+	return &MessageType{
+		name: msg.Name,
+		crc:  msg.CRC,
+		kind: messageKind(msg.Message),
+		typ:  reflect.StructOf(r.structFields(msg.Fields)),
+	}
+}
+
+// messageKind returns the type of the message derived from its header fields.
+func messageKind(msg vppapi.Message) api.MessageType {
+	header := make([]string, 3)
+	for i := 1; i < len(msg.Fields) && i < len(header); i++ {
+		header[i] = msg.Fields[i].Name
+	}
+	switch {
+	case header[1] == "client_index" && header[2] == "context":
+		return api.RequestMessage
+	case header[1] == "client_index":
+		return api.EventMessage
+	case header[1] == "context":
+		return api.ReplyMessage
+	}
+	return api.OtherMessage
+}
+
+func (r *Registry) structFields(fields []*binapigen.Field) []reflect.StructField {
+	structFields := make([]reflect.StructField, len(fields))
+	for i, field := range fields {
+		jsonTag := field.Name + ",omitempty"
+		if field.FieldSizeOf != nil {
+			jsonTag = "-"
+		}
+		structFields[i] = reflect.StructField{
+			Name: field.GoName,
+			Type: r.fieldType(field),
+			Tag:  reflect.StructTag(fmt.Sprintf(`binapi:"%s" json:"%s"`, binapiTag(field), jsonTag)),
+		}
+	}
+	return structFields
+}
+
+// binapiTag returns the binapi tag of the field without the default value,
+// which is not used by the codec.
+func binapiTag(field *binapigen.Field) string {
+	typ := strings.TrimSuffix(strings.TrimPrefix(field.Type, "vl_api_"), "_t")
+	if field.Array {
+		switch {
+		case field.Length > 0:
+			typ += fmt.Sprintf("[%d]", field.Length)
+		case field.SizeFrom != "":
+			typ += "[" + field.SizeFrom + "]"
+		default:
+			typ += "[]"
+		}
+	}
+	tag := typ + ",name=" + field.Name
+	switch limit := field.Meta["limit"].(type) {
+	case int:
+		tag += fmt.Sprintf(",limit=%d", limit)
+	case float64: // numbers parsed from JSON
+		tag += fmt.Sprintf(",limit=%d", int(limit))
+	}
+	return tag
+}
+
+func (r *Registry) fieldType(field *binapigen.Field) reflect.Type {
+	var typ reflect.Type
+	switch {
+	case field.TypeEnum != nil:
+		typ = r.namedType(field.TypeEnum.Name, func() reflect.Type {
+			return baseTypes[field.TypeEnum.Type]
+		})
+	case field.TypeAlias != nil:
+		typ = r.aliasType(field.TypeAlias)
+	case field.TypeStruct != nil:
+		typ = r.structType(field.TypeStruct)
+	case field.TypeUnion != nil:
+		typ = r.unionType(field.TypeUnion)
+	default:
+		typ = baseTypes[field.Type]
+	}
+	if !field.Array {
+		return typ
+	}
+	_, isBase := baseTypes[field.Type]
+	switch {
+	case field.Type == binapigen.U8:
+		return reflect.TypeOf([]byte(nil))
+	case field.Type == binapigen.STRING:
+		return typ
+	case !isBase && field.Length > 0:
+		return reflect.ArrayOf(field.Length, typ)
+	}
+	return reflect.SliceOf(typ)
+}
+
+func (r *Registry) aliasType(alias *binapigen.Alias) reflect.Type {
+	return r.namedType(alias.Name, func() reflect.Type {
+		var typ reflect.Type
+		switch {
+		case alias.TypeStruct != nil:
+			typ = r.structType(alias.TypeStruct)
+		case alias.TypeUnion != nil:
+			typ = r.unionType(alias.TypeUnion)
+		default:
+			typ = baseTypes[alias.Type]
+		}
+		if alias.Length > 0 {
+			typ = reflect.ArrayOf(alias.Length, typ)
+		}
+		return typ
+	})
+}
+
+func (r *Registry) structType(typ *binapigen.Struct) reflect.Type {
+	return r.namedType(typ.Name, func() reflect.Type {
+		return reflect.StructOf(r.structFields(typ.Fields))
+	})
+}
+
+func (r *Registry) unionType(union *binapigen.Union) reflect.Type {
+	return r.namedType(union.Name, func() reflect.Type {
+		return reflect.StructOf([]reflect.StructField{{
+			Name: "XXX_UnionData",
+			Type: reflect.ArrayOf(binapigen.UnionSize(union), reflect.TypeOf(byte(0))),
+		}})
+	})
+}
+
+// namedType returns the binapi type registered for the VPP type, if its
+// definition is the same as the definition of the type built by build,
+// or the built type otherwise.
+func (r *Registry) namedType(name string, build func() reflect.Type) reflect.Type {
+	if typ, ok := r.built[name]; ok {
+		return typ
+	}
+	typ := build()
+	for _, binapiType := range r.named[name] {
+		if compatible(binapiType, typ) {
+			typ = binapiType
+			break
+		}
+	}
+	r.built[name] = typ
+	return typ
+}
+
+// compatible returns true if the types have the same binary encoding.
+func compatible(a, b reflect.Type) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Array:
+		return a.Len() == b.Len() && compatible(a.Elem(), b.Elem())
+	case reflect.Slice:
+		return compatible(a.Elem(), b.Elem())
+	case reflect.Struct:
+		if a.NumField() != b.NumField() {
+			return false
+		}
+		for i := 0; i < a.NumField(); i++ {
+			fa, fb := a.Field(i), b.Field(i)
+			if fa.Name != fb.Name || tagTypeName(fa) != tagTypeName(fb) || !compatible(fa.Type, fb.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// tagTypeName returns the VPP type and name of the field from its binapi tag.
+func tagTypeName(f reflect.StructField) string {
+	opts := strings.SplitN(f.Tag.Get("binapi"), ",", 3)
+	return strings.Join(opts[:min(len(opts), 2)], ",")
+}
+
+// addNamedTypes collects the binapi types of the fields of the struct type
+// by the names of their VPP types.
+func (r *Registry) addNamedTypes(t reflect.Type, visited map[reflect.Type]bool) {
+	if visited[t] {
+		return
+	}
+	visited[t] = true
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		typ, _, _ := strings.Cut(f.Tag.Get("binapi"), ",")
+		name, array, _ := strings.Cut(typ, "[")
+		if _, isBase := baseTypes[name]; isBase || name == "" {
+			continue
+		}
+		ft := f.Type
+		if array != "" && (ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array) {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct {
+			r.addNamedTypes(ft, visited)
+		}
+		if !containsType(r.named[name], ft) {
+			r.named[name] = append(r.named[name], ft)
+		}
+	}
+}
+
+func containsType(types []reflect.Type, t reflect.Type) bool {
+	for _, typ := range types {
+		if typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// sortNamedTypes orders the binapi types of each VPP type by their package,
+// so the same type is used for messages of every registry.
+func (r *Registry) sortNamedTypes() {
+	for _, types := range r.named {
+		sort.Slice(types, func(i, j int) bool {
+			return types[i].PkgPath()+"."+types[i].Name() < types[j].PkgPath()+"."+types[j].Name()
+		})
+	}
+}
@@ -0,0 +1,79 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package unionmember resolves the member of VPP API union that is set, which
+// is not part of the union data. The member is selected by enum field, whose
+// entry names end with the member names, e.g. af (ADDRESS_IP4) selecting ip4
+// of address union in address, or proto (FIB_API_PATH_NH_PROTO_IP4) selecting
+// ip4 of address union in nh of fib_path.
+//
+// The unions without the enum field have no known member, the converters use
+// their largest member then, since it preserves all union data.
+package unionmember
+
+import (
+	"strings"
+)
+
+// Match returns index of the member selected by the enum entry, whose name
+// ends with the member name. The names are compared ignoring case and
+// underscores, the longest member name wins. It returns -1 if the entry
+// selects no member.
+func Match(entry string, members []string) int {
+	entry = normalize(entry)
+	match := -1
+	for i, member := range members {
+		name := normalize(member)
+		if strings.HasSuffix(entry, name) && (match < 0 || len(name) > len(normalize(members[match]))) {
+			match = i
+		}
+	}
+	return match
+}
+
+// Resolve finds the enum field selecting the member of union with members.
+// The union is the last field on path, which contains indexes of the fields
+// in the structs containing them, from the outermost one. The enum field is
+// the nearest field preceding a field on the path, searched from the
+// innermost struct, with entries selecting any of the members. The function
+// entries returns names of the entries of field with index i in the struct
+// at level, or nil if it is not an enum field.
+//
+// It returns level and index of the enum field and the member selected by
+// each of its entries, or -1 for entries selecting no member. The level is -1
+// if there is no such enum field.
+func Resolve(path []int, entries func(level, i int) []string, members []string) (level, index int, cases []int) {
+	for level := len(path) - 1; level >= 0; level-- {
+		for i := path[level] - 1; i >= 0; i-- {
+			names := entries(level, i)
+			if len(names) == 0 {
+				continue
+			}
+			cases := make([]int, len(names))
+			found := false
+			for j, name := range names {
+				cases[j] = Match(name, members)
+				found = found || cases[j] >= 0
+			}
+			if found {
+				return level, i, cases
+			}
+		}
+	}
+	return -1, -1, nil
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
@@ -0,0 +1,58 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package unionmember
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		entry   string
+		members []string
+		want    int
+	}{
+		{"ADDRESS_IP4", []string{"ip4", "ip6"}, 0},
+		{"FIB_API_PATH_NH_PROTO_IP6", []string{"IP4", "IP6"}, 1},
+		{"FIB_API_PATH_NH_PROTO_MPLS", []string{"ip4", "ip6"}, -1},
+		{"SUB_IF_QINQ", []string{"q", "qinq"}, 1},
+	}
+	for _, test := range tests {
+		if got := Match(test.entry, test.members); got != test.want {
+			t.Errorf("Match(%q, %v) = %d, want %d", test.entry, test.members, got, test.want)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	// fib_path {sw_if_index, proto, nh {obj_id, address}}
+	entries := func(level, i int) []string {
+		if level == 0 && i == 1 {
+			return []string{"FIB_API_PATH_NH_PROTO_IP4", "FIB_API_PATH_NH_PROTO_IP6", "FIB_API_PATH_NH_PROTO_MPLS"}
+		}
+		return nil
+	}
+	members := []string{"ip4", "ip6"}
+
+	level, index, cases := Resolve([]int{2, 1}, entries, members)
+	if level != 0 || index != 1 || !reflect.DeepEqual(cases, []int{0, 1, -1}) {
+		t.Errorf("Resolve() = %d, %d, %v", level, index, cases)
+	}
+	// the enum field must precede the fields on the path
+	if level, _, _ := Resolve([]int{1, 1}, entries, members); level != -1 {
+		t.Errorf("Resolve() = %d, want -1", level)
+	}
+}
@@ -0,0 +1,558 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package msgconv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+)
+
+// Converter converts messages from one version of VPP API to another.
+// The converter is safe for concurrent use.
+type Converter struct {
+	from *index
+	to   *index
+
+	sameLock sync.Mutex
+	same     map[string]bool // types with the same definition in both versions
+}
+
+// NewConverter returns a converter of messages defined by the from schema
+// into messages defined by the to schema.
+func NewConverter(from, to *vppapi.Schema) *Converter {
+	return &Converter{
+		from: newIndex(from),
+		to:   newIndex(to),
+		same: make(map[string]bool),
+	}
+}
+
+var versionSuffix = regexp.MustCompile(`_v([0-9]+)$`)
+
+// ResolveMessage returns the name of the message in the target schema
+// corresponding to the message name from the source schema. It is the same
+// name if the target schema defines it, otherwise the name of the message
+// with the highest version suffix, e.g. "sw_interface_dump_v2".
+func (c *Converter) ResolveMessage(name string) (string, bool) {
+	if _, ok := c.to.messages[name]; ok {
+		return name, true
+	}
+	base := versionSuffix.ReplaceAllString(name, "")
+	resolved, version := "", -1
+	for msg := range c.to.messages {
+		var v int
+		if msg != base {
+			m := versionSuffix.FindStringSubmatch(msg)
+			if m == nil || strings.TrimSuffix(msg, m[0]) != base {
+				continue
+			}
+			v, _ = strconv.Atoi(m[1])
+		}
+		if v > version {
+			resolved, version = msg, v
+		}
+	}
+	return resolved, version >= 0
+}
+
+// Convert converts the src message of the source version into the dst message
+// of the target version. The dst message is reset before the conversion. The
+// returned report lists the fields which could not be converted exactly.
+func (c *Converter) Convert(src, dst api.Message) (*Report, error) {
+	data, err := codec.EncodeJSON(src)
+	if err != nil {
+		return nil, err
+	}
+	var v map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	out, report, err := c.ConvertValue(src.GetMessageName(), dst.GetMessageName(), v)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = json.Marshal(out); err != nil {
+		return nil, err
+	}
+	if err := codec.DecodeJSON(data, dst); err != nil {
+		return report, fmt.Errorf("converting %s to %s: %w", src.GetMessageName(), dst.GetMessageName(), err)
+	}
+	return report, nil
+}
+
+// ConvertValue converts the message srcName of the source version in the JSON form,
+// as produced by codec.JSONCodec and decoded with numbers as json.Number,
+// into the message dstName of the target version.
+func (c *Converter) ConvertValue(srcName, dstName string, src map[string]any) (map[string]any, *Report, error) {
+	fromMsg, ok := c.from.messages[srcName]
+	if !ok {
+		return nil, nil, fmt.Errorf("message %s not found in source schema", srcName)
+	}
+	toMsg, ok := c.to.messages[dstName]
+	if !ok {
+		return nil, nil, fmt.Errorf("message %s not found in target schema", dstName)
+	}
+	conv := &conversion{Converter: c, report: &Report{From: srcName, To: dstName}}
+	out := conv.fields("", src, payloadFields(fromMsg), payloadFields(toMsg))
+	return out, conv.report, nil
+}
+
+// conversion is a single conversion collecting the issues.
+type conversion struct {
+	*Converter
+	report *Report
+}
+
+func (c *conversion) issue(path string, kind IssueKind, format string, args ...any) {
+	c.report.Issues = append(c.report.Issues, Issue{Path: path, Kind: kind, Detail: fmt.Sprintf(format, args...)})
+}
+
+// fields converts the struct by the names of fields, the fields with length
+// of arrays are skipped as they are derived from the arrays.
+func (c *conversion) fields(path string, src map[string]any, from, to []vppapi.Field) map[string]any {
+	out := make(map[string]any)
+	fromCounts, toCounts := countFields(from), countFields(to)
+	for i := range to {
+		tf := &to[i]
+		if toCounts[tf.Name] {
+			continue
+		}
+		fpath := joinPath(path, tf.Name)
+		ff, ok := fieldByName(from, tf.Name)
+		if !ok || fromCounts[tf.Name] {
+			if def, ok := tf.Meta["default"]; ok && def != nil {
+				out[tf.Name] = defaultValue(def, c.to.baseType(tf.Type))
+				c.issue(fpath, FieldDefaulted, "set to %v", def)
+			} else {
+				c.issue(fpath, FieldAdded, "set to zero value")
+			}
+			continue
+		}
+		if v, ok := src[tf.Name]; ok {
+			if cv := c.field(fpath, v, ff, tf); cv != nil {
+				out[tf.Name] = cv
+			}
+		}
+	}
+	for _, ff := range from {
+		if fromCounts[ff.Name] {
+			continue
+		}
+		if _, ok := fieldByName(to, ff.Name); ok && !toCounts[ff.Name] {
+			continue
+		}
+		if v, ok := src[ff.Name]; ok && !isZero(v) {
+			c.issue(joinPath(path, ff.Name), FieldDropped, "value %s", formatValue(v))
+		}
+	}
+	var unknown []string
+	for key := range src {
+		if _, ok := fieldByName(from, key); !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		c.issue(joinPath(path, key), FieldDropped, "unknown field")
+	}
+	return out
+}
+
+// field converts the value of the field, nil is returned if the value cannot be converted.
+func (c *conversion) field(path string, v any, ff, tf *vppapi.Field) any {
+	switch {
+	case ff.Type == "string" || tf.Type == "string":
+		s, ok := v.(string)
+		if !ok || ff.Type != tf.Type {
+			c.issue(path, TypeChanged, "%s changed to %s", fieldType(ff), fieldType(tf))
+			return nil
+		}
+		if tf.Length > 0 && len(s) > tf.Length {
+			c.issue(path, ValueTruncated, "length %d exceeds %d", len(s), tf.Length)
+			s = s[:tf.Length]
+		}
+		return s
+	case ff.Array != tf.Array:
+		c.issue(path, TypeChanged, "%s changed to %s", fieldType(ff), fieldType(tf))
+		return nil
+	case ff.Array:
+		return c.array(path, v, ff, tf)
+	}
+	return c.value(path, v, ff.Type, tf.Type)
+}
+
+func (c *conversion) array(path string, v any, ff, tf *vppapi.Field) any {
+	if ff.Type == "u8" && tf.Type == "u8" {
+		// byte arrays are converted to hex
+		s, ok := v.(string)
+		if !ok {
+			c.issue(path, TypeChanged, "expected bytes, got %T", v)
+			return nil
+		}
+		if tf.Length > 0 && len(s) > 2*tf.Length {
+			c.issue(path, ValueTruncated, "length %d exceeds %d", len(s)/2, tf.Length)
+			s = s[:2*tf.Length]
+		}
+		return s
+	}
+	list, ok := v.([]any)
+	if !ok {
+		c.issue(path, TypeChanged, "expected array, got %T", v)
+		return nil
+	}
+	if tf.Length > 0 && len(list) > tf.Length {
+		c.issue(path, ValueTruncated, "length %d exceeds %d", len(list), tf.Length)
+		list = list[:tf.Length]
+	}
+	out := make([]any, len(list))
+	for i, item := range list {
+		out[i] = c.value(path+"["+strconv.Itoa(i)+"]", item, ff.Type, tf.Type)
+	}
+	return out
+}
+
+// value converts the value of the type, nil is returned if the value cannot be converted.
+func (c *conversion) value(path string, v any, fromType, toType string) any {
+	fn, tn := typeName(fromType), typeName(toType)
+	if fn == tn && c.sameType(fn) {
+		return v
+	}
+	// aliases of scalar types are converted as their types
+	if alias, ok := c.from.aliases[fn]; ok && alias.Length == 0 {
+		return c.value(path, v, alias.Type, toType)
+	}
+	if alias, ok := c.to.aliases[tn]; ok && alias.Length == 0 {
+		return c.value(path, v, fromType, alias.Type)
+	}
+	fromEnum, isFromEnum := c.from.enums[fn]
+	toEnum, isToEnum := c.to.enums[tn]
+	switch {
+	case isFromEnum && isToEnum:
+		return c.enum(path, v, fromEnum, toEnum)
+	case isFromEnum && isInteger(tn):
+		n, ok := enumNumber(v, fromEnum)
+		if !ok {
+			c.issue(path, EnumUnknown, "value %s not defined in %s", formatValue(v), fromEnum.Name)
+			return nil
+		}
+		return c.number(path, n, tn)
+	case isInteger(fn) && isToEnum:
+		n := c.number(path, v, toEnum.Type)
+		if num, ok := n.(json.Number); ok {
+			for _, entry := range toEnum.Entries {
+				if strconv.FormatUint(uint64(entry.Value), 10) == string(num) {
+					return entry.Name
+				}
+			}
+		}
+		return n
+	case isNumber(fn) && isNumber(tn):
+		return c.number(path, v, tn)
+	case fn == "bool" && tn == "bool":
+		return v
+	}
+	if fromStruct, ok := c.from.structs[fn]; ok {
+		if toStruct, ok := c.to.structs[tn]; ok {
+			if m, ok := v.(map[string]any); ok {
+				return c.fields(path, m, fromStruct.Fields, toStruct.Fields)
+			}
+		}
+	}
+	if fromUnion, ok := c.from.unions[fn]; ok {
+		if toUnion, ok := c.to.unions[tn]; ok {
+			return c.union(path, v, fromUnion, toUnion)
+		}
+	}
+	c.issue(path, TypeChanged, "%s changed to %s", fn, tn)
+	return nil
+}
+
+// number converts the number checking the range of the target type.
+func (c *conversion) number(path string, v any, typ string) any {
+	num, ok := v.(json.Number)
+	if !ok {
+		c.issue(path, TypeChanged, "expected number, got %s", formatValue(v))
+		return nil
+	}
+	if typ == "f64" {
+		return num
+	}
+	bits, signed := integerType(typ)
+	var err error
+	if signed {
+		_, err = strconv.ParseInt(string(num), 10, bits)
+	} else {
+		_, err = strconv.ParseUint(string(num), 10, bits)
+	}
+	if err != nil {
+		c.issue(path, ValueOutOfRange, "value %s does not fit %s", num, typ)
+		return nil
+	}
+	return num
+}
+
+// enum converts the enum by the names of its values.
+func (c *conversion) enum(path string, v any, from, to *vppapi.EnumType) any {
+	s, ok := v.(string)
+	if !ok {
+		return c.number(path, v, to.Type)
+	}
+	var names []string
+	for _, name := range strings.Split(s, "|") {
+		if _, ok := enumValue(to, name); ok {
+			names = append(names, name)
+			continue
+		}
+		c.issue(path, EnumUnknown, "value %s not defined in %s", name, to.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return strings.Join(names, "|")
+}
+
+// union converts the active member of the union by its name.
+func (c *conversion) union(path string, v any, from, to *vppapi.UnionType) any {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) != 1 {
+		c.issue(path, TypeChanged, "unknown active member of union %s", from.Name)
+		return nil
+	}
+	for key, val := range m {
+		ff, ok1 := unionMember(from, key)
+		tf, ok2 := unionMember(to, key)
+		if !ok1 || !ok2 {
+			c.issue(joinPath(path, key), FieldDropped, "member not defined in %s", to.Name)
+			return nil
+		}
+		cv := c.field(joinPath(path, key), val, ff, tf)
+		if cv == nil {
+			return nil
+		}
+		return map[string]any{key: cv}
+	}
+	return nil
+}
+
+// sameType returns true if the type has the same definition in both versions.
+func (c *conversion) sameType(name string) bool {
+	c.sameLock.Lock()
+	defer c.sameLock.Unlock()
+	return c.sameTypeLocked(name)
+}
+
+func (c *Converter) sameTypeLocked(name string) bool {
+	if isBuiltin(name) {
+		return true
+	}
+	if same, ok := c.same[name]; ok {
+		return same
+	}
+	c.same[name] = true // assume the same for recursive references
+	var same bool
+	if a, ok := c.from.aliases[name]; ok {
+		b, ok := c.to.aliases[name]
+		same = ok && a.Length == b.Length && a.Type == b.Type && c.sameTypeLocked(typeName(a.Type))
+	} else if a, ok := c.from.enums[name]; ok {
+		b, ok := c.to.enums[name]
+		same = ok && a.Type == b.Type && fmt.Sprint(a.Entries) == fmt.Sprint(b.Entries)
+	} else if a, ok := c.from.structs[name]; ok {
+		b, ok := c.to.structs[name]
+		same = ok && c.sameFields(a.Fields, b.Fields)
+	} else if a, ok := c.from.unions[name]; ok {
+		b, ok := c.to.unions[name]
+		same = ok && c.sameFields(a.Fields, b.Fields)
+	}
+	c.same[name] = same
+	return same
+}
+
+func (c *Converter) sameFields(a, b []vppapi.Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type || a[i].Length != b[i].Length ||
+			a[i].Array != b[i].Array || a[i].SizeFrom != b[i].SizeFrom {
+			return false
+		}
+		if !c.sameTypeLocked(typeName(a[i].Type)) {
+			return false
+		}
+	}
+	return true
+}
+
+func unionMember(union *vppapi.UnionType, key string) (*vppapi.Field, bool) {
+	for i := range union.Fields {
+		if normalizeName(union.Fields[i].Name) == normalizeName(key) {
+			return &union.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+func enumValue(enum *vppapi.EnumType, name string) (uint32, bool) {
+	for _, entry := range enum.Entries {
+		if entry.Name == name {
+			return entry.Value, true
+		}
+	}
+	return 0, false
+}
+
+// enumNumber returns the number of the enum value, the flags are combined.
+func enumNumber(v any, enum *vppapi.EnumType) (json.Number, bool) {
+	s, ok := v.(string)
+	if !ok {
+		num, ok := v.(json.Number)
+		return num, ok
+	}
+	var n uint32
+	for _, name := range strings.Split(s, "|") {
+		value, ok := enumValue(enum, name)
+		if !ok {
+			return "", false
+		}
+		n |= value
+	}
+	return json.Number(strconv.FormatUint(uint64(n), 10)), true
+}
+
+// defaultValue returns the default value of the field in the JSON form,
+// the defaults are parsed from the API as numbers or strings.
+func defaultValue(def any, typ string) any {
+	switch x := def.(type) {
+	case float64:
+		if typ == "bool" {
+			return x != 0
+		}
+		return json.Number(strconv.FormatFloat(x, 'f', -1, 64))
+	case string:
+		if b, err := strconv.ParseBool(x); err == nil && typ == "bool" {
+			return b
+		}
+		if _, err := strconv.ParseFloat(x, 64); err == nil && isNumber(typ) {
+			return json.Number(x)
+		}
+	}
+	return def
+}
+
+// isZero returns true for zero values in the JSON form, including
+// the text forms of zero addresses.
+func isZero(v any) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !x
+	case json.Number:
+		f, err := x.Float64()
+		return err == nil && f == 0
+	case string:
+		switch x {
+		case "", "0.0.0.0", "::", "0.0.0.0/0", "::/0", "00:00:00:00:00:00":
+			return true
+		}
+		return strings.Trim(x, "0") == "" && len(x)%2 == 0
+	case []any:
+		for _, item := range x {
+			if !isZero(item) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		for _, item := range x {
+			if !isZero(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func formatValue(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func fieldType(f *vppapi.Field) string {
+	typ := typeName(f.Type)
+	switch {
+	case f.Array && f.Length > 0:
+		return fmt.Sprintf("%s[%d]", typ, f.Length)
+	case f.Array:
+		return typ + "[]"
+	}
+	return typ
+}
+
+func isBuiltin(typ string) bool {
+	return typ == "bool" || typ == "string" || isNumber(typ)
+}
+
+func isNumber(typ string) bool {
+	return typ == "f64" || isInteger(typ)
+}
+
+func isInteger(typ string) bool {
+	bits, _ := integerType(typ)
+	return bits > 0
+}
+
+func integerType(typ string) (bits int, signed bool) {
+	switch typ {
+	case "u8", "i8":
+		bits = 8
+	case "u16", "i16":
+		bits = 16
+	case "u32", "i32":
+		bits = 32
+	case "u64", "i64":
+		bits = 64
+	default:
+		return 0, false
+	}
+	return bits, typ[0] == 'i'
+}
+
+func normalizeName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,174 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package msgconv_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/msgconv"
+)
+
+// ipTableV2 is ip_table with is_ip6 removed, shorter name and added flags.
+type ipTableV2 struct {
+	TableID uint32 `binapi:"u32,name=table_id" json:"table_id,omitempty"`
+	Name    string `binapi:"string[8],name=name" json:"name,omitempty"`
+	Flags   uint8  `binapi:"u8,name=flags,default=3" json:"flags,omitempty"`
+}
+
+type ipTableAddDelV2 struct {
+	IsAdd bool      `binapi:"bool,name=is_add,default=true" json:"is_add,omitempty"`
+	Table ipTableV2 `binapi:"ip_table,name=table" json:"table,omitempty"`
+}
+
+func (m *ipTableAddDelV2) Reset()                        { *m = ipTableAddDelV2{} }
+func (*ipTableAddDelV2) GetMessageName() string          { return "ip_table_add_del" }
+func (*ipTableAddDelV2) GetCrcString() string            { return "01234567" }
+func (*ipTableAddDelV2) GetMessageType() api.MessageType { return api.RequestMessage }
+
+func loadSchema(t *testing.T) *vppapi.Schema {
+	file, err := vppapi.ParseFile("../binapigen/vppapi/testdata/ip.api.json")
+	if err != nil {
+		t.Fatalf("parsing schema failed: %v", err)
+	}
+	return &vppapi.Schema{Files: []vppapi.File{*file}}
+}
+
+// newSchema returns the changed version of the ip schema.
+func newSchema(t *testing.T) *vppapi.Schema {
+	schema := loadSchema(t)
+	file := &schema.Files[0]
+	for i := range file.StructTypes {
+		typ := &file.StructTypes[i]
+		if typ.Name != "ip_table" {
+			continue
+		}
+		typ.Fields = []vppapi.Field{
+			typ.Fields[0],
+			{Name: "name", Type: "string", Array: true, Length: 8},
+			{Name: "flags", Type: "u8", Meta: map[string]interface{}{"default": float64(3)}},
+		}
+	}
+	for i := range file.EnumTypes {
+		enum := &file.EnumTypes[i]
+		if enum.Name == "ip_reass_type" {
+			enum.Entries[1].Name = "IP_REASS_TYPE_SHALLOW"
+		}
+	}
+	file.Messages = append(file.Messages, vppapi.Message{Name: "ip_table_add_del_v3"}, vppapi.Message{Name: "ip_table_add_del_v2"})
+	return schema
+}
+
+func TestConvert(t *testing.T) {
+	RegisterTestingT(t)
+
+	conv := msgconv.NewConverter(loadSchema(t), newSchema(t))
+	src := &ip.IPTableAddDel{
+		IsAdd: true,
+		Table: ip.IPTable{TableID: 10, IsIP6: true, Name: "table-ten-long"},
+	}
+	dst := new(ipTableAddDelV2)
+	report, err := conv.Convert(src, dst)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dst).To(Equal(&ipTableAddDelV2{
+		IsAdd: true,
+		Table: ipTableV2{TableID: 10, Name: "table-te", Flags: 3},
+	}))
+	Expect(report.Issues).To(Equal([]msgconv.Issue{
+		{Path: "table.name", Kind: msgconv.ValueTruncated, Detail: "length 14 exceeds 8"},
+		{Path: "table.flags", Kind: msgconv.FieldDefaulted, Detail: "set to 3"},
+		{Path: "table.is_ip6", Kind: msgconv.FieldDropped, Detail: "value true"},
+	}))
+	Expect(report.Lossy()).To(BeTrue())
+	Expect(errors.Is(report.Err(), msgconv.ErrLossy)).To(BeTrue())
+}
+
+func TestConvertLossless(t *testing.T) {
+	RegisterTestingT(t)
+
+	conv := msgconv.NewConverter(loadSchema(t), newSchema(t))
+	src := &ip.IPTableAddDel{Table: ip.IPTable{TableID: 10, Name: "short"}}
+	dst := new(ipTableAddDelV2)
+	report, err := conv.Convert(src, dst)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(dst).To(Equal(&ipTableAddDelV2{Table: ipTableV2{TableID: 10, Name: "short", Flags: 3}}))
+	Expect(report.Lossy()).To(BeFalse())
+	Expect(report.Err()).ToNot(HaveOccurred())
+}
+
+func TestConvertSameSchema(t *testing.T) {
+	RegisterTestingT(t)
+
+	conv := msgconv.NewConverter(loadSchema(t), loadSchema(t))
+	src := &ip.IPRouteAddDel{
+		IsAdd: true,
+		Route: ip.IPRoute{
+			TableID: 1,
+			Prefix:  ip_types.Prefix{Address: ip_types.Address{Af: ip_types.ADDRESS_IP4, Un: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 0})}, Len: 24},
+			NPaths:  1,
+			Paths:   []fib_types.FibPath{{SwIfIndex: 2, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP4, Flags: fib_types.FIB_API_PATH_FLAG_RESOLVE_VIA_HOST}},
+		},
+	}
+	dst := new(ip.IPRouteAddDel)
+	report, err := conv.Convert(src, dst)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(report.Issues).To(BeEmpty())
+	Expect(dst).To(Equal(src))
+}
+
+func TestConvertValueEnum(t *testing.T) {
+	RegisterTestingT(t)
+
+	conv := msgconv.NewConverter(loadSchema(t), newSchema(t))
+	out, report, err := conv.ConvertValue("ip_reassembly_get", "ip_reassembly_get", map[string]any{
+		"is_ip6": true,
+		"type":   "IP_REASS_TYPE_SHALLOW_VIRTUAL",
+		"extra":  json.Number("1"),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out).To(Equal(map[string]any{"is_ip6": true}))
+	Expect(report.Issues).To(Equal([]msgconv.Issue{
+		{Path: "type", Kind: msgconv.EnumUnknown, Detail: "value IP_REASS_TYPE_SHALLOW_VIRTUAL not defined in ip_reass_type"},
+		{Path: "extra", Kind: msgconv.FieldDropped, Detail: "unknown field"},
+	}))
+
+	_, _, err = conv.ConvertValue("ip_table_add_del", "unknown", nil)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestResolveMessage(t *testing.T) {
+	RegisterTestingT(t)
+
+	conv := msgconv.NewConverter(loadSchema(t), newSchema(t))
+	name, ok := conv.ResolveMessage("ip_table_add_del")
+	Expect(ok).To(BeTrue())
+	Expect(name).To(Equal("ip_table_add_del"))
+	name, ok = conv.ResolveMessage("ip_table_add_del_v2")
+	Expect(ok).To(BeTrue())
+	Expect(name).To(Equal("ip_table_add_del_v2"))
+	name, ok = conv.ResolveMessage("ip_table_add_del_v4")
+	Expect(ok).To(BeTrue())
+	Expect(name).To(Equal("ip_table_add_del_v3"))
+	_, ok = conv.ResolveMessage("unknown_msg")
+	Expect(ok).To(BeFalse())
+}
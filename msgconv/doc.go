@@ -0,0 +1,33 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package msgconv converts messages between versions of VPP API.
+//
+// The Converter uses the API schemas of both versions to translate messages
+// field by field using the VPP field names. The fields added in the target
+// version get the default values from the API definition and the conversions
+// losing information, e.g. dropped fields or truncated strings, are reported:
+//
+//	conv := msgconv.NewConverter(oldSchema, newSchema)
+//	report, err := conv.Convert(&interfaces.SwInterfaceDump{}, &newinterfaces.SwInterfaceDump{})
+//	if err != nil {
+//		return err
+//	}
+//	if report.Lossy() {
+//		log.Warn(report)
+//	}
+//
+// The messages are converted using their JSON form as produced by codec.JSONCodec,
+// which can be also converted directly without generated types using ConvertValue.
+package msgconv
@@ -0,0 +1,119 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package msgconv
+
+import (
+	"strings"
+
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// index provides lookup of messages and types of a schema by name.
+type index struct {
+	messages map[string]*vppapi.Message
+	aliases  map[string]*vppapi.AliasType
+	enums    map[string]*vppapi.EnumType
+	structs  map[string]*vppapi.StructType
+	unions   map[string]*vppapi.UnionType
+}
+
+func newIndex(schema *vppapi.Schema) *index {
+	idx := &index{
+		messages: make(map[string]*vppapi.Message),
+		aliases:  make(map[string]*vppapi.AliasType),
+		enums:    make(map[string]*vppapi.EnumType),
+		structs:  make(map[string]*vppapi.StructType),
+		unions:   make(map[string]*vppapi.UnionType),
+	}
+	for i := range schema.Files {
+		file := &schema.Files[i]
+		for j := range file.Messages {
+			idx.messages[file.Messages[j].Name] = &file.Messages[j]
+		}
+		for j := range file.AliasTypes {
+			idx.aliases[file.AliasTypes[j].Name] = &file.AliasTypes[j]
+		}
+		for j := range file.EnumTypes {
+			idx.enums[file.EnumTypes[j].Name] = &file.EnumTypes[j]
+		}
+		for j := range file.EnumflagTypes {
+			idx.enums[file.EnumflagTypes[j].Name] = &file.EnumflagTypes[j]
+		}
+		for j := range file.StructTypes {
+			idx.structs[file.StructTypes[j].Name] = &file.StructTypes[j]
+		}
+		for j := range file.UnionTypes {
+			idx.unions[file.UnionTypes[j].Name] = &file.UnionTypes[j]
+		}
+	}
+	return idx
+}
+
+// typeName returns the name of the type used in field definitions without
+// the 'vl_api_' prefix and '_t' suffix.
+func typeName(typ string) string {
+	if strings.HasPrefix(typ, "vl_api_") && strings.HasSuffix(typ, "_t") {
+		return strings.TrimSuffix(strings.TrimPrefix(typ, "vl_api_"), "_t")
+	}
+	return typ
+}
+
+// baseType returns the name of the type with the scalar aliases resolved.
+func (idx *index) baseType(typ string) string {
+	name := typeName(typ)
+	for {
+		alias, ok := idx.aliases[name]
+		if !ok || alias.Length != 0 {
+			return name
+		}
+		name = typeName(alias.Type)
+	}
+}
+
+// fieldByName returns the field with the name from the fields.
+func fieldByName(fields []vppapi.Field, name string) (*vppapi.Field, bool) {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// countFields returns the names of fields with length of arrays, these
+// are derived from the arrays and not converted.
+func countFields(fields []vppapi.Field) map[string]bool {
+	counts := make(map[string]bool)
+	for _, f := range fields {
+		if f.SizeFrom != "" {
+			counts[f.SizeFrom] = true
+		}
+	}
+	return counts
+}
+
+// payloadFields returns the fields of message without the header fields.
+func payloadFields(msg *vppapi.Message) []vppapi.Field {
+	fields := msg.Fields
+	for len(fields) > 0 {
+		switch strings.ToLower(fields[0].Name) {
+		case "_vl_msg_id", "client_index", "context":
+			fields = fields[1:]
+			continue
+		}
+		break
+	}
+	return fields
+}
@@ -0,0 +1,124 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package msgconv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IssueKind is a kind of issue found during conversion.
+type IssueKind int
+
+const (
+	// FieldAdded is a field missing in the source message set to zero value.
+	FieldAdded IssueKind = iota
+	// FieldDefaulted is a field missing in the source message set to the default value.
+	FieldDefaulted
+	// FieldDropped is a field with non-zero value missing in the target message.
+	FieldDropped
+	// ValueTruncated is a string or array longer than the target field.
+	ValueTruncated
+	// ValueOutOfRange is a number not fitting into the target type.
+	ValueOutOfRange
+	// EnumUnknown is an enum value not defined in the target enum.
+	EnumUnknown
+	// TypeChanged is a field with incompatible type in the target message.
+	TypeChanged
+)
+
+func (k IssueKind) String() string {
+	switch k {
+	case FieldAdded:
+		return "field added"
+	case FieldDefaulted:
+		return "field defaulted"
+	case FieldDropped:
+		return "field dropped"
+	case ValueTruncated:
+		return "value truncated"
+	case ValueOutOfRange:
+		return "value out of range"
+	case EnumUnknown:
+		return "unknown enum value"
+	case TypeChanged:
+		return "type changed"
+	}
+	return fmt.Sprintf("IssueKind(%d)", int(k))
+}
+
+// Lossy returns true for the issues losing information of the source message.
+func (k IssueKind) Lossy() bool {
+	return k != FieldAdded && k != FieldDefaulted
+}
+
+// Issue describes a field which could not be converted exactly.
+type Issue struct {
+	// Path is the path of the field using VPP field names, e.g. "route.paths[0].proto".
+	Path   string
+	Kind   IssueKind
+	Detail string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s (%s)", i.Path, i.Kind, i.Detail)
+}
+
+// Report lists the issues found during conversion of a message.
+type Report struct {
+	// From is the name of the source message.
+	From string
+	// To is the name of the target message.
+	To     string
+	Issues []Issue
+}
+
+// Lossy returns true if the conversion lost any information of the source message.
+func (r *Report) Lossy() bool {
+	for _, issue := range r.Issues {
+		if issue.Kind.Lossy() {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error describing the lossy issues, or nil if the conversion was lossless.
+func (r *Report) Err() error {
+	var lossy []string
+	for _, issue := range r.Issues {
+		if issue.Kind.Lossy() {
+			lossy = append(lossy, issue.String())
+		}
+	}
+	if len(lossy) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w from %s to %s: %s", ErrLossy, r.From, r.To, strings.Join(lossy, "; "))
+}
+
+func (r *Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s -> %s", r.From, r.To)
+	for _, issue := range r.Issues {
+		sb.WriteString("\n  ")
+		sb.WriteString(issue.String())
+	}
+	return sb.String()
+}
+
+// ErrLossy is returned by Report.Err for lossy conversions.
+var ErrLossy = errors.New("lossy conversion")
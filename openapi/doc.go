@@ -0,0 +1,32 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package openapi exports VPP API definitions as JSON Schema and OpenAPI
+// documents.
+//
+// The schemas describe messages and types in the JSON form produced and
+// accepted by the JSON codec of package codec. JSONSchemas returns one JSON
+// Schema document for each API file and NewDocument returns OpenAPI document
+// describing the REST mapping of all RPCs, where each request message is sent
+// by POST to the path returned by Path:
+//
+//	doc, err := openapi.NewDocument(&vppInput.Schema)
+//	if err != nil {
+//		return err
+//	}
+//	data, err := json.MarshalIndent(doc, "", "  ")
+//
+// The REST mapping is served by package restgateway, which is used by the
+// govpp http command.
+package openapi
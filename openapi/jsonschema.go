@@ -0,0 +1,343 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/internal/apischema"
+)
+
+// SchemaFilenameSuffix is the suffix of the JSON Schema document filenames.
+const SchemaFilenameSuffix = ".schema.json"
+
+// The schemas describe the JSON form of the messages produced by the JSON
+// codec of package codec:
+//   - the fields are named by VPP field names, the message header fields and
+//     the length fields of variable-length arrays are omitted,
+//   - enums and flags are symbolic names, or numbers for values without name,
+//   - addresses, prefixes, MAC addresses and timestamps are strings,
+//   - unions are objects with the active member, or hex of the union data,
+//   - byte arrays are hex strings.
+
+// text formats of the types encoded as strings
+var textFormats = map[string]string{
+	"ip4_address":         "ipv4",
+	"ip6_address":         "ipv6",
+	"address":             "ip-address",
+	"prefix":              "ip-prefix",
+	"ip4_prefix":          "ipv4-prefix",
+	"ip6_prefix":          "ipv6-prefix",
+	"address_with_prefix": "ip-prefix",
+	"mac_address":         "mac-address",
+	"timestamp":           "date-time",
+}
+
+// integer ranges of the built-in types
+var integerRanges = map[string][2]json.Number{
+	binapigen.U8:  {"0", "255"},
+	binapigen.I8:  {"-128", "127"},
+	binapigen.U16: {"0", "65535"},
+	binapigen.I16: {"-32768", "32767"},
+	binapigen.U32: {"0", "4294967295"},
+	binapigen.I32: {"-2147483648", "2147483647"},
+	binapigen.U64: {"0", "18446744073709551615"},
+	binapigen.I64: {"-9223372036854775808", "9223372036854775807"},
+}
+
+const hexPattern = "^([0-9a-fA-F]{2})*$"
+
+// JSONSchemas returns JSON Schema documents for API files in schema, mapped
+// by the API file names. Each document defines all messages and types of the
+// file in $defs under their VPP names, e.g. #/$defs/sw_interface_details.
+// The types of imported files are referred in the documents of the files,
+// e.g. ip_types.schema.json#/$defs/address, relative to the document ID.
+func JSONSchemas(schema *vppapi.Schema) (map[string]*Schema, error) {
+	files, err := loadFiles(schema)
+	if err != nil {
+		return nil, err
+	}
+	fileNames := make(map[binapigen.GoImportPath]string, len(files))
+	for _, file := range files {
+		fileNames[file.GoImportPath] = file.Desc.Name
+	}
+	docs := make(map[string]*Schema, len(files))
+	for _, file := range files {
+		b := &builder{refPrefix: "#/$defs/", file: file, fileNames: fileNames}
+		doc := &Schema{
+			Schema: JSONSchemaDialect,
+			ID:     file.Desc.Name + SchemaFilenameSuffix,
+			Title:  file.Desc.Name,
+			Defs:   make(map[string]*Schema),
+		}
+		if file.Version != "" {
+			doc.Description = fmt.Sprintf("VPP API %s %s", file.Desc.Name, file.Version)
+		}
+		b.addDefs(doc.Defs, file)
+		docs[file.Desc.Name] = doc
+	}
+	return docs, nil
+}
+
+// loadFiles returns the API files of schema with resolved types.
+func loadFiles(schema *vppapi.Schema) ([]*binapigen.File, error) {
+	gen, err := apischema.Load(schema)
+	if err != nil {
+		return nil, err
+	}
+	return gen.Files, nil
+}
+
+// builder builds schemas of the API types referring to each other with
+// the refPrefix followed by the VPP name. If fileNames is set, the types
+// outside of file are referred in the documents of their files.
+type builder struct {
+	refPrefix string
+	file      *binapigen.File
+	fileNames map[binapigen.GoImportPath]string
+}
+
+func (b *builder) ref(ident binapigen.GoIdent, name string) *Schema {
+	if b.fileNames != nil && ident.GoImportPath != b.file.GoImportPath {
+		return &Schema{Ref: b.fileNames[ident.GoImportPath] + SchemaFilenameSuffix + b.refPrefix + name}
+	}
+	return &Schema{Ref: b.refPrefix + name}
+}
+
+// addDefs adds schemas of all messages and types of file to defs.
+func (b *builder) addDefs(defs map[string]*Schema, file *binapigen.File) {
+	for _, enum := range file.Enums {
+		defs[enum.Name] = b.enumSchema(enum)
+	}
+	for _, alias := range file.Aliases {
+		defs[alias.Name] = b.aliasSchema(alias)
+	}
+	for _, typ := range file.Structs {
+		defs[typ.Name] = b.structSchema(typ)
+	}
+	for _, union := range file.Unions {
+		defs[union.Name] = b.unionSchema(union)
+	}
+	for _, msg := range file.Messages {
+		defs[msg.Name] = b.messageSchema(msg)
+	}
+}
+
+func (b *builder) messageSchema(msg *binapigen.Message) *Schema {
+	s := b.objectSchema(msg.Fields)
+	s.Title = msg.Name
+	s.Description = msg.Comment
+	s.Deprecated = isDeprecated(msg)
+	return s
+}
+
+func isDeprecated(msg *binapigen.Message) bool {
+	_, ok := msg.Options["deprecated"]
+	return ok || msg.Options["status"] == "deprecated"
+}
+
+func (b *builder) structSchema(typ *binapigen.Struct) *Schema {
+	s := b.objectSchema(typ.Fields)
+	s.Title = typ.Name
+	if format, ok := textFormats[typ.Name]; ok {
+		// the codec encodes the type as text, but accepts also the fields
+		return &Schema{
+			Title: typ.Name,
+			AnyOf: []*Schema{{Type: "string", Format: format}, s},
+		}
+	}
+	return s
+}
+
+func (b *builder) objectSchema(fields []*binapigen.Field) *Schema {
+	s := &Schema{
+		Type:                 "object",
+		Properties:           Properties{},
+		AdditionalProperties: new(bool),
+	}
+	for _, field := range fields {
+		if field.FieldSizeOf != nil {
+			continue
+		}
+		s.Properties = append(s.Properties, Property{
+			Name:   field.Name,
+			Schema: b.fieldSchema(field),
+		})
+	}
+	return s
+}
+
+func (b *builder) unionSchema(union *binapigen.Union) *Schema {
+	s := &Schema{Title: union.Name}
+	for _, field := range union.Fields {
+		s.OneOf = append(s.OneOf, &Schema{
+			Type:                 "object",
+			Properties:           Properties{{Name: field.Name, Schema: b.fieldSchema(field)}},
+			Required:             []string{field.Name},
+			AdditionalProperties: new(bool),
+		})
+	}
+	// union without resolved active member
+	s.OneOf = append(s.OneOf, &Schema{
+		Type:        "string",
+		Pattern:     hexPattern,
+		Description: "union data in hex",
+	})
+	return s
+}
+
+func (b *builder) enumSchema(enum *binapigen.Enum) *Schema {
+	names := &Schema{Type: "string"}
+	if enum.IsFlag {
+		alts := make([]string, len(enum.Entries))
+		for i, entry := range enum.Entries {
+			alts[i] = regexp.QuoteMeta(entry.Name)
+		}
+		name := "(" + strings.Join(alts, "|") + ")"
+		names.Pattern = "^" + name + `(\|` + name + ")*$"
+	} else {
+		for _, entry := range enum.Entries {
+			names.Enum = append(names.Enum, entry.Name)
+		}
+	}
+	// values without name are encoded as numbers
+	return &Schema{
+		Title: enum.Name,
+		AnyOf: []*Schema{names, basicSchema(enum.Type)},
+	}
+}
+
+func (b *builder) aliasSchema(alias *binapigen.Alias) *Schema {
+	var s *Schema
+	switch {
+	case textFormats[alias.Name] != "":
+		s = &Schema{Type: "string", Format: textFormats[alias.Name]}
+	case alias.TypeStruct != nil:
+		s = b.ref(alias.TypeStruct.GoIdent, alias.TypeStruct.Name)
+	case alias.TypeUnion != nil:
+		s = b.ref(alias.TypeUnion.GoIdent, alias.TypeUnion.Name)
+	case alias.Length > 0:
+		s = arraySchema(alias.Type, alias.Length, basicSchema(alias.Type))
+	default:
+		s = basicSchema(alias.Type)
+	}
+	s.Title = alias.Name
+	return s
+}
+
+func (b *builder) fieldSchema(field *binapigen.Field) *Schema {
+	var s *Schema
+	switch {
+	case field.TypeEnum != nil:
+		s = b.ref(field.TypeEnum.GoIdent, field.TypeEnum.Name)
+	case field.TypeAlias != nil:
+		s = b.ref(field.TypeAlias.GoIdent, field.TypeAlias.Name)
+	case field.TypeStruct != nil:
+		s = b.ref(field.TypeStruct.GoIdent, field.TypeStruct.Name)
+	case field.TypeUnion != nil:
+		s = b.ref(field.TypeUnion.GoIdent, field.TypeUnion.Name)
+	default:
+		s = basicSchema(field.Type)
+	}
+	switch {
+	case field.Type == binapigen.STRING:
+		if field.Length > 0 {
+			s.MaxLength = &field.Length
+		}
+	case field.Array:
+		length := field.Length
+		if field.SizeFrom != "" {
+			length = 0
+		}
+		typ := field.Type
+		if field.TypeAlias != nil && field.TypeAlias.Length == 0 && field.TypeAlias.TypeBasic != nil {
+			typ = *field.TypeAlias.TypeBasic
+		}
+		s = arraySchema(typ, length, s)
+	}
+	if field.DefaultValue != nil {
+		if s.Ref != "" {
+			// keywords next to $ref are allowed by JSON Schema 2020-12
+			s = &Schema{Ref: s.Ref}
+		}
+		s.Default = DefaultValue(field.Field)
+	}
+	return s
+}
+
+// DefaultValue returns default value of the field defined by API, converted
+// to its JSON form, or nil if the field has no default value. The API files
+// define the default values of boolean fields as strings.
+func DefaultValue(field vppapi.Field) any {
+	value, ok := field.Meta["default"]
+	if !ok {
+		return nil
+	}
+	if s, ok := value.(string); ok {
+		switch {
+		case field.Type == binapigen.BOOL:
+			if b, err := strconv.ParseBool(s); err == nil {
+				return b
+			}
+		case integerRanges[field.Type] != [2]json.Number{} || field.Type == binapigen.F64:
+			if _, err := strconv.ParseFloat(s, 64); err == nil {
+				return json.Number(s)
+			}
+		}
+	}
+	return value
+}
+
+// arraySchema returns schema of array of elem with the length, or with
+// variable length if zero. Arrays of bytes are encoded as hex.
+func arraySchema(typ string, length int, elem *Schema) *Schema {
+	if typ == binapigen.U8 {
+		s := &Schema{Type: "string", Pattern: hexPattern}
+		if length > 0 {
+			n := 2 * length
+			s.MaxLength = &n
+		}
+		return s
+	}
+	s := &Schema{Type: "array", Items: elem}
+	if length > 0 {
+		s.MaxItems = &length
+	}
+	return s
+}
+
+// basicSchema returns schema of the built-in type.
+func basicSchema(typ string) *Schema {
+	switch typ {
+	case binapigen.BOOL:
+		return &Schema{Type: "boolean"}
+	case binapigen.F64:
+		return &Schema{Type: "number", Format: "double"}
+	case binapigen.STRING:
+		return &Schema{Type: "string"}
+	}
+	if r, ok := integerRanges[typ]; ok {
+		s := &Schema{Type: "integer"}
+		s.Minimum, s.Maximum = &r[0], &r[1]
+		return s
+	}
+	return &Schema{}
+}
@@ -0,0 +1,211 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package openapi
+
+import (
+	"fmt"
+
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+)
+
+// Version is the version of OpenAPI specification of the documents.
+const Version = "3.1.0"
+
+// media types of the responses
+const (
+	MediaTypeJSON   = "application/json"
+	MediaTypeNDJSON = "application/x-ndjson"
+)
+
+// ErrorName is the name of the schema and response of errors.
+const ErrorName = "Error"
+
+const schemasRefPrefix = "#/components/schemas/"
+
+// Document is an OpenAPI document. It has only the objects needed for
+// describing the REST mapping of VPP API.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type PathItem struct {
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas   map[string]*Schema   `json:"schemas"`
+	Responses map[string]*Response `json:"responses,omitempty"`
+}
+
+// Error is the JSON body of error responses.
+type Error struct {
+	// Error is the error message.
+	Error string `json:"error"`
+	// Retval is the VPP API error code for errors returned by VPP.
+	Retval int32 `json:"retval,omitempty"`
+}
+
+// Path returns path of the REST operation sending the request message msg
+// defined by API file.
+func Path(file, msg string) string {
+	return "/vpp/" + file + "/" + msg
+}
+
+// NewDocument returns OpenAPI document describing the REST mapping of RPCs
+// of API files in schema. The request message of each RPC is sent by POST
+// to the path returned by Path, with body in the JSON form of the message.
+// The response is the reply message of the RPC, or the details messages
+// of dump RPCs, which are returned as JSON array, or as newline delimited
+// JSON if requested by the Accept header. The details messages of RPCs
+// with both details and reply are followed by the reply.
+//
+// The schemas of all messages and types are defined as components under
+// their VPP names, e.g. #/components/schemas/sw_interface_details.
+func NewDocument(schema *vppapi.Schema) (*Document, error) {
+	files, err := loadFiles(schema)
+	if err != nil {
+		return nil, err
+	}
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "VPP API",
+			Description: "REST mapping of VPP binary API",
+			Version:     schema.Version,
+		},
+		Paths: make(map[string]*PathItem),
+		Components: Components{
+			Schemas: map[string]*Schema{
+				ErrorName: errorSchema(),
+			},
+			Responses: map[string]*Response{
+				ErrorName: {
+					Description: "Error of the request or VPP API error",
+					Content: map[string]*MediaType{
+						MediaTypeJSON: {Schema: &Schema{Ref: schemasRefPrefix + ErrorName}},
+					},
+				},
+			},
+		},
+	}
+	if doc.Info.Version == "" {
+		doc.Info.Version = "unknown"
+	}
+	b := &builder{refPrefix: schemasRefPrefix}
+	for _, file := range files {
+		b.addDefs(doc.Components.Schemas, file)
+		if file.Service == nil {
+			continue
+		}
+		for _, rpc := range file.Service.RPCs {
+			if rpc.MsgReply == nil {
+				continue
+			}
+			doc.Paths[Path(file.Desc.Name, rpc.MsgRequest.Name)] = &PathItem{
+				Post: b.operation(file, rpc),
+			}
+		}
+	}
+	return doc, nil
+}
+
+func (b *builder) operation(file *binapigen.File, rpc *binapigen.RPC) *Operation {
+	req := rpc.MsgRequest
+	op := &Operation{
+		OperationID: req.Name,
+		Summary:     req.Name,
+		Description: req.Comment,
+		Tags:        []string{file.Desc.Name},
+		Deprecated:  isDeprecated(req),
+		RequestBody: &RequestBody{
+			Content: map[string]*MediaType{
+				MediaTypeJSON: {Schema: b.ref(req.GoIdent, req.Name)},
+			},
+		},
+		Responses: map[string]*Response{
+			"default": {Ref: "#/components/responses/" + ErrorName},
+		},
+	}
+	if !rpc.VPP.Stream {
+		op.Responses["200"] = &Response{
+			Description: fmt.Sprintf("Reply %s", rpc.MsgReply.Name),
+			Content: map[string]*MediaType{
+				MediaTypeJSON: {Schema: b.ref(rpc.MsgReply.GoIdent, rpc.MsgReply.Name)},
+			},
+		}
+		return op
+	}
+	item := b.ref(rpc.MsgReply.GoIdent, rpc.MsgReply.Name)
+	description := fmt.Sprintf("List of %s", rpc.MsgReply.Name)
+	if rpc.MsgStream != nil {
+		item = &Schema{AnyOf: []*Schema{b.ref(rpc.MsgStream.GoIdent, rpc.MsgStream.Name), b.ref(rpc.MsgReply.GoIdent, rpc.MsgReply.Name)}}
+		description = fmt.Sprintf("List of %s followed by %s", rpc.MsgStream.Name, rpc.MsgReply.Name)
+	}
+	op.Responses["200"] = &Response{
+		Description: description,
+		Content: map[string]*MediaType{
+			MediaTypeJSON:   {Schema: &Schema{Type: "array", Items: item}},
+			MediaTypeNDJSON: {Schema: item},
+		},
+	}
+	return op
+}
+
+func errorSchema() *Schema {
+	return &Schema{
+		Title: ErrorName,
+		Type:  "object",
+		Properties: Properties{
+			{Name: "error", Schema: &Schema{Type: "string", Description: "error message"}},
+			{Name: "retval", Schema: &Schema{Type: "integer", Description: "VPP API error code"}},
+		},
+		Required: []string{"error"},
+	}
+}
@@ -0,0 +1,284 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package openapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/fib_types"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/ip_types"
+	"go.fd.io/govpp/binapi/mfib_types"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/openapi"
+)
+
+func loadSchema(t *testing.T) *vppapi.Schema {
+	RegisterTestingT(t)
+
+	file, err := vppapi.ParseFile("../binapigen/vppapi/testdata/ip.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	return &vppapi.Schema{Files: []vppapi.File{*file}, Version: "24.02"}
+}
+
+func TestJSONSchemas(t *testing.T) {
+	docs, err := openapi.JSONSchemas(loadSchema(t))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(docs).To(HaveKey("ip"))
+
+	doc := docs["ip"]
+	Expect(doc.Schema).To(Equal(openapi.JSONSchemaDialect))
+	Expect(doc.ID).To(Equal("ip.schema.json"))
+
+	route := doc.Defs["ip_route_add_del"]
+	Expect(route).ToNot(BeNil())
+	Expect(route.Type).To(Equal("object"))
+	Expect(route.Properties.Get("is_add").Default).To(Equal(true))
+	Expect(route.Properties.Get("route").Ref).To(Equal("#/$defs/ip_route"))
+
+	// the length field is omitted
+	data, err := json.Marshal(doc.Defs["ip_route"].Properties)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(data)).To(HavePrefix(`{"table_id":`))
+	Expect(string(data)).To(ContainSubstring(`"prefix":{"$ref":"#/$defs/prefix"},"paths":{"type":"array","items":{"$ref":"#/$defs/fib_path"}}}`))
+
+	union := doc.Defs["address_union"]
+	Expect(union.OneOf).To(HaveLen(3))
+	Expect(union.OneOf[0].Required).To(Equal([]string{"ip4"}))
+	Expect(doc.Defs["ip4_address"].Format).To(Equal("ipv4"))
+
+	enum := doc.Defs["ip_reass_type"]
+	Expect(enum.AnyOf[0].Enum).To(Equal([]any{"IP_REASS_TYPE_FULL", "IP_REASS_TYPE_SHALLOW_VIRTUAL"}))
+	Expect(enum.AnyOf[1].Type).To(Equal("integer"))
+}
+
+func TestJSONSchemasRefs(t *testing.T) {
+	RegisterTestingT(t)
+
+	files, err := vppapi.ParseDir("../binapigen/vppapi/testdata/src")
+	Expect(err).ShouldNot(HaveOccurred())
+	docs, err := openapi.JSONSchemas(&vppapi.Schema{Files: files})
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(docs).To(HaveKey("ip_types"))
+
+	// the types of imported files are defined only in their documents
+	ip := docs["ip"]
+	Expect(ip.Defs).ToNot(HaveKey("prefix"))
+	Expect(ip.Defs["ip_route"].Properties.Get("prefix").Ref).To(Equal("ip_types.schema.json#/$defs/prefix"))
+
+	var refs int
+	for _, doc := range docs {
+		walkSchema(doc, func(s *openapi.Schema) {
+			if s.Ref == "" {
+				return
+			}
+			refs++
+			_, def, err := resolveRef(docs, doc, s.Ref)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(def).ToNot(BeNil(), "%s: %s", doc.ID, s.Ref)
+		})
+	}
+	Expect(refs).To(BeNumerically(">", 0))
+}
+
+func TestNewDocument(t *testing.T) {
+	doc, err := openapi.NewDocument(loadSchema(t))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(doc.OpenAPI).To(Equal(openapi.Version))
+	Expect(doc.Info.Version).To(Equal("24.02"))
+
+	add := doc.Paths[openapi.Path("ip", "ip_table_add_del")]
+	Expect(add).ToNot(BeNil())
+	Expect(add.Post.OperationID).To(Equal("ip_table_add_del"))
+	Expect(add.Post.RequestBody.Content[openapi.MediaTypeJSON].Schema.Ref).To(Equal("#/components/schemas/ip_table_add_del"))
+	Expect(add.Post.Responses["200"].Content[openapi.MediaTypeJSON].Schema.Ref).To(Equal("#/components/schemas/ip_table_add_del_reply"))
+	Expect(add.Post.Responses["default"].Ref).To(Equal("#/components/responses/Error"))
+
+	dump := doc.Paths[openapi.Path("ip", "ip_table_dump")]
+	Expect(dump).ToNot(BeNil())
+	content := dump.Post.Responses["200"].Content
+	Expect(content[openapi.MediaTypeJSON].Schema.Type).To(Equal("array"))
+	Expect(content[openapi.MediaTypeJSON].Schema.Items.Ref).To(Equal("#/components/schemas/ip_table_details"))
+	Expect(content[openapi.MediaTypeNDJSON].Schema.Ref).To(Equal("#/components/schemas/ip_table_details"))
+
+	Expect(doc.Components.Schemas).To(HaveKey("ip_table_details"))
+	Expect(doc.Components.Schemas).To(HaveKey(openapi.ErrorName))
+
+	data, err := json.Marshal(doc)
+	Expect(err).ShouldNot(HaveOccurred())
+	var out openapi.Document
+	Expect(json.Unmarshal(data, &out)).To(Succeed())
+	Expect(out.Components.Schemas["ip_route"].Properties).To(Equal(doc.Components.Schemas["ip_route"].Properties))
+}
+
+// TestSchemaCodecJSON checks that the schemas describe the messages encoded
+// by the JSON codec.
+func TestSchemaCodecJSON(t *testing.T) {
+	docs, err := openapi.JSONSchemas(loadSchema(t))
+	Expect(err).ShouldNot(HaveOccurred())
+	doc := docs["ip"]
+
+	msgs := []api.Message{
+		&ip.IPTableAddDel{IsAdd: true, Table: ip.IPTable{TableID: 1, Name: "one"}},
+		&ip.IPMrouteAddDel{
+			IsAdd: true,
+			Route: ip.IPMroute{
+				EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL,
+				Prefix: ip_types.Mprefix{
+					Af:         ip_types.ADDRESS_IP4,
+					GrpAddress: ip_types.AddressUnionIP4(ip_types.IP4Address{224, 0, 0, 1}),
+				},
+				NPaths: 1,
+				Paths: []mfib_types.MfibPath{{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT,
+					Path: fib_types.FibPath{
+						Proto: fib_types.FIB_API_PATH_NH_PROTO_IP4,
+						Nh:    fib_types.FibPathNh{Address: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1})},
+					},
+				}},
+			},
+		},
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 2, IsIP6: true}},
+		&ip.SetIPFlowHashV2{
+			Af:             ip_types.ADDRESS_IP6,
+			FlowHashConfig: ip.IP_API_FLOW_HASH_SRC_IP | ip.IP_API_FLOW_HASH_PROTO,
+		},
+	}
+	for _, msg := range msgs {
+		data, err := codec.EncodeJSON(msg)
+		Expect(err).ShouldNot(HaveOccurred())
+		var v any
+		Expect(json.Unmarshal(data, &v)).To(Succeed())
+		Expect(validate(doc, doc.Defs[msg.GetMessageName()], v)).To(Succeed(), string(data))
+	}
+}
+
+// walkSchema calls fn for s and all its subschemas.
+func walkSchema(s *openapi.Schema, fn func(*openapi.Schema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, def := range s.Defs {
+		walkSchema(def, fn)
+	}
+	for _, prop := range s.Properties {
+		walkSchema(prop.Schema, fn)
+	}
+	walkSchema(s.Items, fn)
+	for _, alt := range append(s.AnyOf, s.OneOf...) {
+		walkSchema(alt, fn)
+	}
+}
+
+// resolveRef returns the document and the definition referred by ref from
+// doc, the refs to other documents are resolved by their IDs.
+func resolveRef(docs map[string]*openapi.Schema, doc *openapi.Schema, ref string) (*openapi.Schema, *openapi.Schema, error) {
+	id, name, ok := strings.Cut(ref, "#/$defs/")
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported ref %s", ref)
+	}
+	if id != "" {
+		doc = docs[strings.TrimSuffix(id, openapi.SchemaFilenameSuffix)]
+		if doc == nil || doc.ID != id {
+			return nil, nil, fmt.Errorf("document %s not found", id)
+		}
+	}
+	return doc, doc.Defs[name], nil
+}
+
+// validate validates value v against schema s, it supports only the keywords
+// used by the schemas of VPP API.
+func validate(doc, s *openapi.Schema, v any) error {
+	if s.Ref != "" {
+		return validate(doc, doc.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")], v)
+	}
+	if len(s.AnyOf) > 0 || len(s.OneOf) > 0 {
+		var matched int
+		for _, alt := range append(s.AnyOf, s.OneOf...) {
+			if validate(doc, alt, v) == nil {
+				matched++
+			}
+		}
+		if matched == 0 || (len(s.OneOf) > 0 && matched > 1) {
+			return fmt.Errorf("%s: %v matches %d alternatives", s.Title, v, matched)
+		}
+		return nil
+	}
+	switch s.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%v is not object", v)
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("missing required %s", name)
+			}
+		}
+		for name, value := range obj {
+			prop := s.Properties.Get(name)
+			if prop == nil {
+				return fmt.Errorf("unknown property %s", name)
+			}
+			if err := validate(doc, prop, value); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	case "array":
+		list, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%v is not array", v)
+		}
+		for _, item := range list {
+			if err := validate(doc, s.Items, item); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%v is not string", v)
+		}
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(str) {
+			return fmt.Errorf("%q does not match %s", str, s.Pattern)
+		}
+		if len(s.Enum) > 0 {
+			for _, e := range s.Enum {
+				if e == str {
+					return nil
+				}
+			}
+			return fmt.Errorf("%q is not in enum", str)
+		}
+	case "integer", "number":
+		if _, ok := v.(float64); !ok {
+			return fmt.Errorf("%v is not number", v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%v is not boolean", v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONSchemaDialect is the JSON Schema dialect of the schemas, which is also
+// the default dialect of OpenAPI 3.1.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema document or subschema. It has only the keywords
+// needed for describing the JSON form of VPP API messages.
+type Schema struct {
+	Schema string             `json:"$schema,omitempty"`
+	ID     string             `json:"$id,omitempty"`
+	Ref    string             `json:"$ref,omitempty"`
+	Defs   map[string]*Schema `json:"$defs,omitempty"`
+
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Default     any    `json:"default,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+
+	Type    string       `json:"type,omitempty"`
+	Format  string       `json:"format,omitempty"`
+	Enum    []any        `json:"enum,omitempty"`
+	Minimum *json.Number `json:"minimum,omitempty"`
+	Maximum *json.Number `json:"maximum,omitempty"`
+
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MaxItems *int    `json:"maxItems,omitempty"`
+
+	Properties           Properties `json:"properties,omitempty"`
+	Required             []string   `json:"required,omitempty"`
+	AdditionalProperties *bool      `json:"additionalProperties,omitempty"`
+
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	OneOf []*Schema `json:"oneOf,omitempty"`
+}
+
+// Property is a named property of object schema.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties are the properties of object schema, which are encoded into JSON
+// object in the order of the fields of VPP API message or type.
+type Properties []Property
+
+// Get returns schema of the property with name, or nil if there is none.
+func (p Properties) Get(name string) *Schema {
+	for _, prop := range p {
+		if prop.Name == name {
+			return prop.Schema
+		}
+	}
+	return nil
+}
+
+func (p Properties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, prop := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(prop.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		schema, err := json.Marshal(prop.Schema)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(schema)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	*p = nil
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		prop := Property{Name: tok.(string)}
+		if err := dec.Decode(&prop.Schema); err != nil {
+			return err
+		}
+		*p = append(*p, prop)
+	}
+	_, err := dec.Token()
+	return err
+}
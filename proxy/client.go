@@ -44,7 +44,7 @@ func Connect(addr string) (*Client, error) {
 	return c, nil
 }
 
-// NewStatsClient returns new StatsClient which implements api.StatsProvider.
+// NewStatsClient returns new StatsClient which implements api.StatsProviderContext.
 func (c *Client) NewStatsClient() (*StatsClient, error) {
 	stats := &StatsClient{
 		rpc: c.rpc,
@@ -61,17 +61,39 @@ func (c *Client) NewBinapiClient() (*BinapiClient, error) {
 	return binapi, nil
 }
 
+// implements StatsProviderContext
+var _ api.StatsProviderContext = (*StatsClient)(nil)
+
 type StatsClient struct {
 	rpc *rpc.Client
 }
 
+// call sends the stats request to the server and waits for the response
+// or until the context is done.
+func (s *StatsClient) call(ctx context.Context, req StatsRequest, resp *StatsResponse) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	call := s.rpc.Go("StatsRPC.GetStats", req, resp, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (s *StatsClient) GetSystemStats(sysStats *api.SystemStats) error {
+	return s.GetSystemStatsContext(context.Background(), sysStats)
+}
+
+func (s *StatsClient) GetSystemStatsContext(ctx context.Context, sysStats *api.SystemStats) error {
 	// we need to start with a clean, zeroed item before decoding
 	// 'cause if the new values are 'zero' for the type, they will be ignored
 	// by the decoder. (i.e the old values will be left unchanged).
 	req := StatsRequest{StatsType: "system"}
 	resp := StatsResponse{SysStats: new(api.SystemStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*sysStats = *resp.SysStats
@@ -79,9 +101,13 @@ func (s *StatsClient) GetSystemStats(sysStats *api.SystemStats) error {
 }
 
 func (s *StatsClient) GetNodeStats(nodeStats *api.NodeStats) error {
+	return s.GetNodeStatsContext(context.Background(), nodeStats)
+}
+
+func (s *StatsClient) GetNodeStatsContext(ctx context.Context, nodeStats *api.NodeStats) error {
 	req := StatsRequest{StatsType: "node"}
 	resp := StatsResponse{NodeStats: new(api.NodeStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*nodeStats = *resp.NodeStats
@@ -89,9 +115,13 @@ func (s *StatsClient) GetNodeStats(nodeStats *api.NodeStats) error {
 }
 
 func (s *StatsClient) GetInterfaceStats(ifaceStats *api.InterfaceStats) error {
+	return s.GetInterfaceStatsContext(context.Background(), ifaceStats)
+}
+
+func (s *StatsClient) GetInterfaceStatsContext(ctx context.Context, ifaceStats *api.InterfaceStats) error {
 	req := StatsRequest{StatsType: "interface"}
 	resp := StatsResponse{IfaceStats: new(api.InterfaceStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*ifaceStats = *resp.IfaceStats
@@ -99,9 +129,13 @@ func (s *StatsClient) GetInterfaceStats(ifaceStats *api.InterfaceStats) error {
 }
 
 func (s *StatsClient) GetErrorStats(errStats *api.ErrorStats) error {
+	return s.GetErrorStatsContext(context.Background(), errStats)
+}
+
+func (s *StatsClient) GetErrorStatsContext(ctx context.Context, errStats *api.ErrorStats) error {
 	req := StatsRequest{StatsType: "error"}
 	resp := StatsResponse{ErrStats: new(api.ErrorStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*errStats = *resp.ErrStats
@@ -109,9 +143,13 @@ func (s *StatsClient) GetErrorStats(errStats *api.ErrorStats) error {
 }
 
 func (s *StatsClient) GetBufferStats(bufStats *api.BufferStats) error {
+	return s.GetBufferStatsContext(context.Background(), bufStats)
+}
+
+func (s *StatsClient) GetBufferStatsContext(ctx context.Context, bufStats *api.BufferStats) error {
 	req := StatsRequest{StatsType: "buffer"}
 	resp := StatsResponse{BufStats: new(api.BufferStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*bufStats = *resp.BufStats
@@ -119,9 +157,13 @@ func (s *StatsClient) GetBufferStats(bufStats *api.BufferStats) error {
 }
 
 func (s *StatsClient) GetMemoryStats(memStats *api.MemoryStats) error {
+	return s.GetMemoryStatsContext(context.Background(), memStats)
+}
+
+func (s *StatsClient) GetMemoryStatsContext(ctx context.Context, memStats *api.MemoryStats) error {
 	req := StatsRequest{StatsType: "memory"}
 	resp := StatsResponse{MemStats: new(api.MemoryStats)}
-	if err := s.rpc.Call("StatsRPC.GetStats", req, &resp); err != nil {
+	if err := s.call(ctx, req, &resp); err != nil {
 		return err
 	}
 	*memStats = *resp.MemStats
@@ -0,0 +1,39 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package restgateway serves VPP API as REST service.
+//
+// Each RPC of the API files is served as POST operation at the path returned
+// by openapi.Path, e.g. /vpp/interface/sw_interface_dump, with the request
+// message in the JSON form of the JSON codec of package codec. The unary RPCs
+// return the reply and the dump RPCs return the details messages as JSON
+// array, or as newline delimited JSON if the client accepts the media type
+// application/x-ndjson. The failed calls return openapi.Error with HTTP
+// status derived from the VPP API error, e.g. 404 for NO_SUCH_ENTRY.
+//
+// The gateway is built from the API files at runtime and serves its OpenAPI
+// document at SpecPath:
+//
+//	gw, err := restgateway.NewGateway(conn, schema)
+//	if err != nil {
+//		return err
+//	}
+//	http.ListenAndServe(":8000", gw)
+//
+// The messages are sent to VPP as binapi messages of the imported binapi
+// packages with the same name and CRC as in the API files. The other messages
+// are encoded from the API files and registered to the connection, the
+// operations with such messages fail with 501 Not Implemented if the
+// connection cannot register them, they are listed by Gateway.Unregistered.
+package restgateway
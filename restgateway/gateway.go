@@ -0,0 +1,528 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapigen"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/internal/apischema"
+	"go.fd.io/govpp/openapi"
+)
+
+// SpecPath is the path of the OpenAPI document describing the gateway.
+const SpecPath = "/openapi.json"
+
+// MaxBodySize is the maximum size of the request body.
+const MaxBodySize = 1 << 20
+
+// Gateway serves RPCs of VPP API files as REST operations described by the
+// OpenAPI document of package openapi. The request messages are decoded from
+// the JSON body, sent to VPP and the replies are returned as JSON.
+//
+// The messages are sent to VPP as binapi messages registered by the imported
+// binapi packages with the same name and CRC as in the API definitions. The
+// other messages are encoded from the API definitions, so the API files newer
+// than the imported binapi are served without regenerating the binapi. Their
+// enums without binapi type are numbers and unions without binapi type are
+// hex of the union data in the replies.
+//
+// The messages without binapi type are registered to the connection by its
+// RegisterMessages method, e.g. of core.Connection. The operations with such
+// messages fail with status 501 Not Implemented for the connections without
+// the method, see Unregistered.
+type Gateway struct {
+	conn       api.Connection
+	doc        *openapi.Document
+	operations map[string]*operation
+
+	controlPing reflect.Type
+}
+
+// operation is the REST operation of RPC.
+type operation struct {
+	req     *message
+	reply   *message
+	details *message // nil for RPCs without details
+	// controlPing is true for dump RPCs ended by control ping
+	controlPing bool
+}
+
+// message maps API message to its binapi type.
+type message struct {
+	model *binapigen.Message
+	typ   reflect.Type // nil if the message is not registered
+	// runtime is the type of the message defined from the API definitions,
+	// nil if the message is registered or cannot be registered
+	runtime *apischema.MessageType
+}
+
+// messageRegisterer registers the messages without binapi type to the
+// connection, e.g. core.Connection.
+type messageRegisterer interface {
+	RegisterMessages(msgs ...api.Message)
+}
+
+// NewGateway returns gateway of the RPCs defined by API files in schema,
+// which forwards the requests to VPP using connection conn.
+func NewGateway(conn api.Connection, schema *vppapi.Schema) (*Gateway, error) {
+	doc, err := openapi.NewDocument(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	gen, err := apischema.Load(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := apischema.NewRegistry()
+	g := &Gateway{
+		conn:        conn,
+		doc:         doc,
+		operations:  make(map[string]*operation),
+		controlPing: registry.ControlPing(),
+	}
+	registerer, canRegister := conn.(messageRegisterer)
+	messages := make(map[*binapigen.Message]*message)
+	var runtimeMsgs []api.Message
+	lookup := func(msg *binapigen.Message) *message {
+		if m, ok := messages[msg]; ok {
+			return m
+		}
+		m := &message{
+			model: msg,
+			typ:   registry.MessageType(msg),
+		}
+		if m.typ == nil && canRegister {
+			m.runtime = registry.NewMessageType(msg)
+			runtimeMsgs = append(runtimeMsgs, m.runtime.New())
+		}
+		messages[msg] = m
+		return m
+	}
+
+	for _, file := range gen.Files {
+		if file.Service == nil {
+			continue
+		}
+		for _, rpc := range file.Service.RPCs {
+			if rpc.MsgReply == nil {
+				continue
+			}
+			op := &operation{
+				req:   lookup(rpc.MsgRequest),
+				reply: lookup(rpc.MsgReply),
+			}
+			if rpc.VPP.Stream {
+				if rpc.MsgStream != nil {
+					op.details = lookup(rpc.MsgStream)
+				} else {
+					op.details = op.reply
+					op.controlPing = true
+				}
+			}
+			g.operations[openapi.Path(file.Desc.Name, rpc.MsgRequest.Name)] = op
+		}
+	}
+	if len(runtimeMsgs) > 0 {
+		registerer.RegisterMessages(runtimeMsgs...)
+	}
+
+	return g, nil
+}
+
+// Document returns the OpenAPI document describing the operations.
+func (g *Gateway) Document() *openapi.Document {
+	return g.doc
+}
+
+// Unregistered returns the paths of the operations failing with status
+// 501 Not Implemented, because some of their messages are not registered
+// and the connection cannot register the messages without binapi type.
+func (g *Gateway) Unregistered() []string {
+	var paths []string
+	for path, op := range g.operations {
+		unregistered := op.controlPing && g.controlPing == nil
+		for _, msg := range []*message{op.req, op.reply, op.details} {
+			unregistered = unregistered || msg != nil && msg.typ == nil && msg.runtime == nil
+		}
+		if unregistered {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ServeHTTP serves the operations and the OpenAPI document at SpecPath.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == SpecPath {
+		if req.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+			return
+		}
+		writeJSON(w, http.StatusOK, g.doc)
+		return
+	}
+
+	op, ok := g.operations[req.URL.Path]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no operation at %s", req.URL.Path))
+		return
+	}
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	msg, err := op.req.decode(http.MaxBytesReader(w, req.Body, MaxBodySize))
+	if err != nil {
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+	if op.details == nil {
+		g.invoke(w, req, op, msg)
+	} else {
+		g.dump(w, req, op, msg)
+	}
+}
+
+func (g *Gateway) invoke(w http.ResponseWriter, req *http.Request, op *operation, msg api.Message) {
+	reply, err := op.reply.new()
+	if err != nil {
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+	if err := g.conn.Invoke(req.Context(), msg, reply); err != nil {
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+	data, err := codec.EncodeJSON(reply)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, json.RawMessage(data))
+}
+
+// dump writes the details messages as JSON array, or as newline delimited
+// JSON if accepted by the client, which is written as the messages arrive.
+// The reply of RPCs with details is written after the details messages.
+func (g *Gateway) dump(w http.ResponseWriter, req *http.Request, op *operation, msg api.Message) {
+	if op.controlPing && g.controlPing == nil {
+		err := &unimplementedError{fmt.Sprintf("message %s is not registered", apischema.ControlPingName)}
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+	if _, err := op.details.new(); err != nil {
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+	if _, err := op.reply.new(); err != nil {
+		writeError(w, HTTPStatus(err), err)
+		return
+	}
+
+	var out itemWriter = &arrayWriter{w: w}
+	if acceptsNDJSON(req) {
+		out = &ndjsonWriter{w: w}
+	}
+	err := g.stream(req, op, msg, out.write)
+	out.close(err)
+}
+
+// stream sends the request and passes the received messages to fn.
+func (g *Gateway) stream(req *http.Request, op *operation, msg api.Message, fn func(json.RawMessage) error) error {
+	stream, err := g.conn.NewStream(req.Context())
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if err := stream.SendMsg(msg); err != nil {
+		return err
+	}
+	if op.controlPing {
+		ping := reflect.New(g.controlPing).Interface().(api.Message)
+		if err := stream.SendMsg(ping); err != nil {
+			return err
+		}
+	}
+	for {
+		m, err := stream.RecvMsg()
+		if err != nil {
+			return err
+		}
+		isReply := op.reply.is(m)
+		if !isReply && !op.details.is(m) {
+			// the control ping reply ends the stream
+			return retvalError(stream, m)
+		}
+		if isReply && !op.controlPing {
+			if err := retvalError(stream, m); err != nil {
+				return err
+			}
+		}
+		data, err := codec.EncodeJSON(m)
+		if err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+		if isReply && !op.controlPing {
+			return nil
+		}
+	}
+}
+
+// new returns new instance of the message.
+func (m *message) new() (api.Message, error) {
+	switch {
+	case m.typ != nil:
+		return reflect.New(m.typ).Interface().(api.Message), nil
+	case m.runtime != nil:
+		return m.runtime.New(), nil
+	}
+	return nil, &unimplementedError{fmt.Sprintf("message %s (CRC %s) is not registered", m.model.Name, m.model.CRC)}
+}
+
+// is returns true if msg is the message.
+func (m *message) is(msg api.Message) bool {
+	return msg.GetMessageName() == m.model.Name && msg.GetCrcString() == m.model.CRC
+}
+
+// decode returns message decoded from its JSON form read from r.
+// The fields missing in JSON object get the default values defined by API,
+// and the empty body is decoded as the message with the default values.
+func (m *message) decode(r io.Reader) (api.Message, error) {
+	msg, err := m.new()
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, &requestError{err}
+	}
+	fields := make(map[string]any)
+	if len(bytes.TrimSpace(body)) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		if err := dec.Decode(&fields); err != nil {
+			return nil, &requestError{fmt.Errorf("invalid JSON body: %w", err)}
+		}
+		if dec.More() {
+			return nil, &requestError{errors.New("unexpected data after JSON object")}
+		}
+	}
+	for _, field := range m.model.Fields {
+		if _, ok := fields[field.Name]; ok || field.FieldSizeOf != nil {
+			continue
+		}
+		if value := openapi.DefaultValue(field.Field); value != nil {
+			fields[field.Name] = value
+		}
+	}
+	if m.runtime != nil {
+		enumNumbers(m.model.Fields, fields)
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, &requestError{err}
+	}
+	if err := codec.DecodeJSON(data, msg); err != nil {
+		return nil, &requestError{err}
+	}
+	if err := codec.ValidateMsg(msg); err != nil {
+		return nil, &requestError{err}
+	}
+	return msg, nil
+}
+
+// enumNumbers replaces the enum names in the JSON form of the fields with
+// their values, the enums of the messages without binapi type are decoded
+// only from numbers.
+func enumNumbers(fields []*binapigen.Field, values map[string]any) {
+	for _, field := range fields {
+		if v, ok := values[field.Name]; ok {
+			values[field.Name] = enumFieldNumbers(field, v)
+		}
+	}
+}
+
+func enumFieldNumbers(field *binapigen.Field, v any) any {
+	switch v := v.(type) {
+	case []any:
+		for i := range v {
+			v[i] = enumFieldNumbers(field, v[i])
+		}
+	case map[string]any:
+		switch {
+		case field.TypeStruct != nil:
+			enumNumbers(field.TypeStruct.Fields, v)
+		case field.TypeAlias != nil && field.TypeAlias.TypeStruct != nil:
+			enumNumbers(field.TypeAlias.TypeStruct.Fields, v)
+		}
+	case string:
+		if field.TypeEnum != nil {
+			if n, ok := enumNumber(field.TypeEnum, v); ok {
+				return n
+			}
+		}
+	}
+	return v
+}
+
+// enumNumber returns the value of the enum names joined with '|'.
+func enumNumber(enum *binapigen.Enum, s string) (json.Number, bool) {
+	var value uint32
+	for _, name := range strings.Split(s, "|") {
+		i := slices.IndexFunc(enum.Entries, func(entry vppapi.EnumEntry) bool {
+			return entry.Name == strings.TrimSpace(name)
+		})
+		if i < 0 {
+			return "", false
+		}
+		value |= enum.Entries[i].Value
+	}
+	if strings.HasPrefix(enum.Type, "i") {
+		return json.Number(strconv.FormatInt(int64(int32(value)), 10)), true
+	}
+	return json.Number(strconv.FormatUint(uint64(value), 10)), true
+}
+
+// retvalError returns error for the retval of reply message received from stream.
+func retvalError(stream api.Stream, reply api.Message) error {
+	retval := reflect.ValueOf(reply).Elem().FieldByName("Retval")
+	if !retval.IsValid() || retval.Kind() != reflect.Int32 {
+		return nil
+	}
+	return api.StreamReplyError(stream, reply, int32(retval.Int()))
+}
+
+func acceptsNDJSON(req *http.Request) bool {
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err == nil && mediaType == openapi.MediaTypeNDJSON {
+			return true
+		}
+	}
+	return false
+}
+
+// itemWriter writes the messages of dump response.
+type itemWriter interface {
+	write(item json.RawMessage) error
+	// close completes the response, the error ends the response
+	close(err error)
+}
+
+// arrayWriter collects the messages and writes them as JSON array, so the
+// failed dump is reported by the error status.
+type arrayWriter struct {
+	w     http.ResponseWriter
+	items []json.RawMessage
+}
+
+func (a *arrayWriter) write(item json.RawMessage) error {
+	a.items = append(a.items, item)
+	return nil
+}
+
+func (a *arrayWriter) close(err error) {
+	if err != nil {
+		writeError(a.w, HTTPStatus(err), err)
+		return
+	}
+	if a.items == nil {
+		a.items = []json.RawMessage{}
+	}
+	writeJSON(a.w, http.StatusOK, a.items)
+}
+
+// ndjsonWriter writes the messages as newline delimited JSON. The failure
+// after the first message is written as the last line with the error.
+type ndjsonWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (n *ndjsonWriter) write(item json.RawMessage) error {
+	if !n.started {
+		n.w.Header().Set("Content-Type", openapi.MediaTypeNDJSON)
+		n.w.WriteHeader(http.StatusOK)
+		n.started = true
+	}
+	if _, err := n.w.Write(append(item, '\n')); err != nil {
+		return err
+	}
+	if f, ok := n.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (n *ndjsonWriter) close(err error) {
+	switch {
+	case err == nil && !n.started:
+		n.w.Header().Set("Content-Type", openapi.MediaTypeNDJSON)
+		n.w.WriteHeader(http.StatusOK)
+	case err != nil && !n.started:
+		writeError(n.w, HTTPStatus(err), err)
+	case err != nil:
+		data, _ := json.Marshal(errorBody(err))
+		_, _ = n.w.Write(append(data, '\n'))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(errorBody(err))
+	}
+	w.Header().Set("Content-Type", openapi.MediaTypeJSON)
+	w.WriteHeader(status)
+	_, _ = w.Write(append(data, '\n'))
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorBody(err))
+}
+
+func errorBody(err error) *openapi.Error {
+	body := &openapi.Error{Error: err.Error()}
+	var vppErr api.VPPApiError
+	if errors.As(err, &vppErr) {
+		body.Retval = int32(vppErr)
+	}
+	return body
+}
@@ -0,0 +1,386 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restgateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.fd.io/govpp/adapter/mock"
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/binapi/ip"
+	"go.fd.io/govpp/binapi/memclnt"
+	"go.fd.io/govpp/binapigen/vppapi"
+	"go.fd.io/govpp/codec"
+	"go.fd.io/govpp/core"
+	"go.fd.io/govpp/openapi"
+	"go.fd.io/govpp/restgateway"
+)
+
+type countersGet struct {
+	Cursor uint32 `binapi:"u32,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *countersGet) Reset()                        { *m = countersGet{} }
+func (*countersGet) GetMessageName() string          { return "counters_get" }
+func (*countersGet) GetCrcString() string            { return "3a1f2c4b" }
+func (*countersGet) GetMessageType() api.MessageType { return api.RequestMessage }
+
+type countersGetReply struct {
+	Retval int32  `binapi:"i32,name=retval" json:"retval,omitempty"`
+	Cursor uint32 `binapi:"u32,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *countersGetReply) Reset()                        { *m = countersGetReply{} }
+func (*countersGetReply) GetMessageName() string          { return "counters_get_reply" }
+func (*countersGetReply) GetCrcString() string            { return "53b48f5d" }
+func (*countersGetReply) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+type countersDetails struct {
+	Index uint32 `binapi:"u32,name=index" json:"index,omitempty"`
+	Value uint64 `binapi:"u64,name=value" json:"value,omitempty"`
+}
+
+func (m *countersDetails) Reset()                        { *m = countersDetails{} }
+func (*countersDetails) GetMessageName() string          { return "counters_details" }
+func (*countersDetails) GetCrcString() string            { return "6e2c7d1a" }
+func (*countersDetails) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+func init() {
+	api.RegisterMessage((*countersGet)(nil), "restgateway_test.CountersGet")
+	api.RegisterMessage((*countersGetReply)(nil), "restgateway_test.CountersGetReply")
+	api.RegisterMessage((*countersDetails)(nil), "restgateway_test.CountersDetails")
+}
+
+type testCtx struct {
+	mockVpp *mock.VppAdapter
+	gateway *restgateway.Gateway
+}
+
+func setupTest(t *testing.T) *testCtx {
+	return setupTestFiles(t, "../binapigen/vppapi/testdata/ip.api.json", "testdata/counters.api.json")
+}
+
+func setupTestFiles(t *testing.T, paths ...string) *testCtx {
+	RegisterTestingT(t)
+
+	schema := new(vppapi.Schema)
+	for _, path := range paths {
+		file, err := vppapi.ParseFile(path)
+		Expect(err).ShouldNot(HaveOccurred())
+		schema.Files = append(schema.Files, *file)
+	}
+
+	mockVpp := mock.NewVppAdapter()
+	conn, err := core.Connect(mockVpp)
+	Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(conn.Disconnect)
+
+	gateway, err := restgateway.NewGateway(conn, schema)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return &testCtx{
+		mockVpp: mockVpp,
+		gateway: gateway,
+	}
+}
+
+func (ctx *testCtx) post(path, body string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	ctx.gateway.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeError(rec *httptest.ResponseRecorder) openapi.Error {
+	var body openapi.Error
+	Expect(json.Unmarshal(rec.Body.Bytes(), &body)).To(Succeed())
+	return body
+}
+
+func TestGatewayUnary(t *testing.T) {
+	ctx := setupTest(t)
+
+	var sent ip.IPTableAddDel
+	ctx.mockVpp.MockReplyHandler(func(req mock.MessageDTO) ([]byte, uint16, bool) {
+		Expect(codec.DefaultCodec.DecodeMsg(req.Data, &sent)).To(Succeed())
+		reply := &ip.IPTableAddDelReply{}
+		msgID, err := ctx.mockVpp.GetMsgID(reply.GetMessageName(), reply.GetCrcString())
+		Expect(err).ShouldNot(HaveOccurred())
+		data, err := ctx.mockVpp.ReplyBytes(req, reply)
+		Expect(err).ShouldNot(HaveOccurred())
+		return data, msgID, true
+	})
+
+	// is_add is true by default
+	rec := ctx.post(openapi.Path("ip", "ip_table_add_del"), `{"table": {"table_id": 10, "name": "ten"}}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(rec.Header().Get("Content-Type")).To(Equal(openapi.MediaTypeJSON))
+	Expect(rec.Body.String()).To(MatchJSON(`{"retval": 0}`))
+	Expect(sent).To(Equal(ip.IPTableAddDel{IsAdd: true, Table: ip.IPTable{TableID: 10, Name: "ten"}}))
+
+	rec = ctx.post(openapi.Path("ip", "ip_table_add_del"), `{"is_add": false, "table": {"table_id": 10}}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(sent).To(Equal(ip.IPTableAddDel{Table: ip.IPTable{TableID: 10}}))
+}
+
+func TestGatewayReplyError(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(&ip.IPTableAddDelReply{Retval: int32(api.NO_SUCH_FIB)})
+	rec := ctx.post(openapi.Path("ip", "ip_table_add_del"), `{}`)
+	Expect(rec.Code).To(Equal(http.StatusNotFound))
+	body := decodeError(rec)
+	Expect(body.Retval).To(BeEquivalentTo(api.NO_SUCH_FIB))
+	Expect(body.Error).To(ContainSubstring("ip_table_add_del"))
+}
+
+func TestGatewayBadRequest(t *testing.T) {
+	ctx := setupTest(t)
+
+	for _, body := range []string{
+		`{"table": `,
+		`[]`,
+		`{"is_add": true} {}`,
+		`{"unknown": 1}`,
+		`{"table": {"name": "` + strings.Repeat("x", 100) + `"}}`,
+	} {
+		rec := ctx.post(openapi.Path("ip", "ip_table_add_del"), body)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest), body)
+		Expect(decodeError(rec).Error).ToNot(BeEmpty())
+	}
+}
+
+// connection without RegisterMessages method
+type binapiConnection struct {
+	api.Connection
+}
+
+func TestGatewayNotServed(t *testing.T) {
+	ctx := setupTest(t)
+
+	rec := ctx.post(openapi.Path("ip", "ip_table_details"), `{}`)
+	Expect(rec.Code).To(Equal(http.StatusNotFound))
+	Expect(ctx.gateway.Unregistered()).To(BeEmpty())
+
+	// the binapi message has different CRC than ip_route_lookup in schema
+	// and the connection cannot register messages without binapi type
+	file, err := vppapi.ParseFile("../binapigen/vppapi/testdata/ip.api.json")
+	Expect(err).ShouldNot(HaveOccurred())
+	conn, err := core.Connect(mock.NewVppAdapter())
+	Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Disconnect()
+	gateway, err := restgateway.NewGateway(binapiConnection{conn}, &vppapi.Schema{Files: []vppapi.File{*file}})
+	Expect(err).ShouldNot(HaveOccurred())
+	req := httptest.NewRequest(http.MethodPost, openapi.Path("ip", "ip_route_lookup"), strings.NewReader(`{}`))
+	rec = httptest.NewRecorder()
+	gateway.ServeHTTP(rec, req)
+	Expect(rec.Code).To(Equal(http.StatusNotImplemented))
+	Expect(decodeError(rec).Error).To(ContainSubstring("not registered"))
+	Expect(gateway.Unregistered()).To(ContainElement(openapi.Path("ip", "ip_route_lookup")))
+	Expect(gateway.Unregistered()).ToNot(ContainElement(openapi.Path("ip", "ip_table_add_del")))
+
+	req = httptest.NewRequest(http.MethodGet, openapi.Path("ip", "ip_table_add_del"), nil)
+	rec = httptest.NewRecorder()
+	ctx.gateway.ServeHTTP(rec, req)
+	Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	Expect(rec.Header().Get("Allow")).To(Equal(http.MethodPost))
+}
+
+// messages of example.api without binapi type, not registered
+type exampleSet struct {
+	SwIfIndex uint32 `binapi:"interface_index,name=sw_if_index"`
+	Mode      uint8  `binapi:"example_mode,name=mode"`
+	Limit     uint32 `binapi:"u32,name=limit"`
+	Tag       string `binapi:"string[],name=tag"`
+}
+
+func (*exampleSet) GetMessageName() string          { return "example_set" }
+func (*exampleSet) GetCrcString() string            { return "00000000" }
+func (*exampleSet) GetMessageType() api.MessageType { return api.RequestMessage }
+
+type exampleSetReply struct {
+	Retval int32 `binapi:"i32,name=retval"`
+}
+
+func (*exampleSetReply) GetMessageName() string          { return "example_set_reply" }
+func (*exampleSetReply) GetCrcString() string            { return "00000000" }
+func (*exampleSetReply) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+type exampleGetReply struct {
+	Retval int32  `binapi:"i32,name=retval"`
+	Cursor uint32 `binapi:"u32,name=cursor"`
+}
+
+func (*exampleGetReply) GetMessageName() string          { return "example_get_reply" }
+func (*exampleGetReply) GetCrcString() string            { return "00000000" }
+func (*exampleGetReply) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+type exampleDetails struct {
+	NData uint8  `binapi:"u8,name=n_data"`
+	Data  []byte `binapi:"u8[n_data],name=data"`
+	Name  string `binapi:"string[32],name=name"`
+}
+
+func (*exampleDetails) GetMessageName() string          { return "example_details" }
+func (*exampleDetails) GetCrcString() string            { return "00000000" }
+func (*exampleDetails) GetMessageType() api.MessageType { return api.ReplyMessage }
+
+func TestGatewayWithoutBinapi(t *testing.T) {
+	ctx := setupTestFiles(t,
+		"../binapigen/vppapi/testdata/src/vnet/interface_types.api",
+		"../binapigen/vppapi/testdata/src/plugins/example/example.api")
+	Expect(ctx.gateway.Unregistered()).To(BeEmpty())
+
+	var sent exampleSet
+	ctx.mockVpp.MockReplyHandler(func(req mock.MessageDTO) ([]byte, uint16, bool) {
+		Expect(codec.DefaultCodec.DecodeMsg(req.Data, &sent)).To(Succeed())
+		reply := &exampleSetReply{}
+		msgID, err := ctx.mockVpp.GetMsgID(reply.GetMessageName(), reply.GetCrcString())
+		Expect(err).ShouldNot(HaveOccurred())
+		data, err := ctx.mockVpp.ReplyBytes(req, reply)
+		Expect(err).ShouldNot(HaveOccurred())
+		return data, msgID, true
+	})
+
+	// mode is 1 by default
+	rec := ctx.post(openapi.Path("example", "example_set"), `{"sw_if_index": 5, "tag": "x"}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(rec.Body.String()).To(MatchJSON(`{"retval": 0}`))
+	Expect(sent).To(Equal(exampleSet{SwIfIndex: 5, Mode: 1, Tag: "x"}))
+
+	rec = ctx.post(openapi.Path("example", "example_set"), `{"mode": "EXAMPLE_MODE_SLOW", "limit": 64}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(sent).To(Equal(exampleSet{Mode: 2, Limit: 64}))
+
+	rec = ctx.post(openapi.Path("example", "example_set"), `{"mode": "EXAMPLE_MODE_UNKNOWN"}`)
+	Expect(rec.Code).To(Equal(http.StatusBadRequest), rec.Body.String())
+}
+
+func TestGatewayWithoutBinapiStream(t *testing.T) {
+	ctx := setupTestFiles(t,
+		"../binapigen/vppapi/testdata/src/vnet/interface_types.api",
+		"../binapigen/vppapi/testdata/src/plugins/example/example.api")
+
+	ctx.mockVpp.MockReply(
+		&exampleDetails{NData: 2, Data: []byte{1, 2}, Name: "a"},
+		&exampleGetReply{Cursor: 7},
+	)
+	rec := ctx.post(openapi.Path("example", "example_get"), `{"cursor": 1}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(rec.Body.String()).To(MatchJSON(`[
+		{"data": "0102", "name": "a"},
+		{"retval": 0, "cursor": 7}
+	]`))
+}
+
+func TestGatewayDump(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 1, Name: "one"}},
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 2, IsIP6: true, Name: "two"}},
+	)
+	ctx.mockVpp.MockReply(&memclnt.ControlPingReply{})
+	rec := ctx.post(openapi.Path("ip", "ip_table_dump"), ``)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(rec.Body.String()).To(MatchJSON(`[
+		{"table": {"table_id": 1, "is_ip6": false, "name": "one"}},
+		{"table": {"table_id": 2, "is_ip6": true, "name": "two"}}
+	]`))
+
+	ctx.mockVpp.MockReply(&memclnt.ControlPingReply{})
+	rec = ctx.post(openapi.Path("ip", "ip_table_dump"), `{}`)
+	Expect(rec.Code).To(Equal(http.StatusOK))
+	Expect(rec.Body.String()).To(MatchJSON(`[]`))
+}
+
+func TestGatewayDumpNDJSON(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 1, Name: "one"}},
+		&ip.IPTableDetails{Table: ip.IPTable{TableID: 2, Name: "two"}},
+	)
+	ctx.mockVpp.MockReply(&memclnt.ControlPingReply{})
+	rec := ctx.post(openapi.Path("ip", "ip_table_dump"), `{}`, "Accept", "application/x-ndjson, application/json;q=0.5")
+	Expect(rec.Code).To(Equal(http.StatusOK))
+	Expect(rec.Header().Get("Content-Type")).To(Equal(openapi.MediaTypeNDJSON))
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	Expect(lines).To(HaveLen(2))
+	Expect(lines[0]).To(MatchJSON(`{"table": {"table_id": 1, "is_ip6": false, "name": "one"}}`))
+	Expect(lines[1]).To(MatchJSON(`{"table": {"table_id": 2, "is_ip6": false, "name": "two"}}`))
+}
+
+func TestGatewayStreamReply(t *testing.T) {
+	ctx := setupTest(t)
+
+	ctx.mockVpp.MockReply(
+		&countersDetails{Index: 1, Value: 10},
+		&countersDetails{Index: 2, Value: 20},
+		&countersGetReply{Cursor: 3},
+	)
+	rec := ctx.post(openapi.Path("counters", "counters_get"), `{"cursor": 0}`)
+	Expect(rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+	Expect(rec.Body.String()).To(MatchJSON(`[
+		{"index": 1, "value": 10},
+		{"index": 2, "value": 20},
+		{"retval": 0, "cursor": 3}
+	]`))
+
+	ctx.mockVpp.MockReply(
+		&countersDetails{Index: 1, Value: 10},
+		&countersGetReply{Retval: int32(api.INVALID_VALUE)},
+	)
+	rec = ctx.post(openapi.Path("counters", "counters_get"), `{}`)
+	Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	Expect(decodeError(rec).Retval).To(BeEquivalentTo(api.INVALID_VALUE))
+
+	// the error after streamed messages is the last line
+	ctx.mockVpp.MockReply(
+		&countersDetails{Index: 1, Value: 10},
+		&countersGetReply{Retval: int32(api.INVALID_VALUE)},
+	)
+	rec = ctx.post(openapi.Path("counters", "counters_get"), `{}`, "Accept", openapi.MediaTypeNDJSON)
+	Expect(rec.Code).To(Equal(http.StatusOK))
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	Expect(lines).To(HaveLen(2))
+	var last openapi.Error
+	Expect(json.Unmarshal([]byte(lines[1]), &last)).To(Succeed())
+	Expect(last.Error).To(HavePrefix("counters_get (CRC 3a1f2c4b) failed: counters_get_reply returned VPPApiError: Invalid value (-7) (conn "))
+	Expect(last.Retval).To(BeEquivalentTo(api.INVALID_VALUE))
+}
+
+func TestGatewayDocument(t *testing.T) {
+	ctx := setupTest(t)
+
+	req := httptest.NewRequest(http.MethodGet, restgateway.SpecPath, nil)
+	rec := httptest.NewRecorder()
+	ctx.gateway.ServeHTTP(rec, req)
+	Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var doc openapi.Document
+	Expect(json.Unmarshal(rec.Body.Bytes(), &doc)).To(Succeed())
+	Expect(doc.Paths).To(HaveKey(openapi.Path("ip", "ip_table_dump")))
+	Expect(doc.Paths).To(HaveKey(openapi.Path("counters", "counters_get")))
+}
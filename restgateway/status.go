@@ -0,0 +1,79 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package restgateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.fd.io/govpp/api"
+	"go.fd.io/govpp/core"
+)
+
+// requestError is the error of invalid request.
+type requestError struct {
+	err error
+}
+
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// unimplementedError is the error of operation that cannot be served.
+type unimplementedError struct {
+	msg string
+}
+
+func (e *unimplementedError) Error() string {
+	return e.msg
+}
+
+// HTTPStatus returns HTTP status code for the error of VPP API call. The code
+// is derived from the category of VPP API error returned in retval, or from
+// the failure of the call itself.
+func HTTPStatus(err error) int {
+	var compatErr *api.CompatibilityError
+	var reqErr *requestError
+	var unimplErr *unimplementedError
+	var sizeErr *http.MaxBytesError
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case errors.As(err, &sizeErr):
+		return http.StatusRequestEntityTooLarge
+	case errors.As(err, &reqErr):
+		return http.StatusBadRequest
+	case errors.As(err, &unimplErr), errors.As(err, &compatErr):
+		return http.StatusNotImplemented
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, core.ErrReplyTimeout):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, core.ErrNotConnected):
+		return http.StatusServiceUnavailable
+	case api.IsNotFound(err):
+		return http.StatusNotFound
+	case api.IsAlreadyExists(err), api.IsInUse(err):
+		return http.StatusConflict
+	case api.IsInvalidArgument(err):
+		return http.StatusBadRequest
+	case api.IsUnimplemented(err):
+		return http.StatusNotImplemented
+	}
+	return http.StatusInternalServerError
+}
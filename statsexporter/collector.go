@@ -0,0 +1,432 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package statsexporter provides Prometheus collector for the VPP stats segment.
+//
+// The Collector reads stats via adapter.StatsAPI on every scrape and exposes
+// simple, combined, error, gauge, scalar and histogram stats as Prometheus
+// metrics. Stat names are converted to metric names (e.g. /if/rx becomes
+// vpp_interface_rx_packets_total and vpp_interface_rx_bytes_total) and vector
+// indexes are resolved to labels (interface, node, error reason).
+package statsexporter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.fd.io/govpp/adapter"
+)
+
+// DefaultNamespace is the default prefix of metric names.
+const DefaultNamespace = "vpp"
+
+const (
+	interfaceStatsPrefix = "/if/"
+	interfaceStatsNames  = interfaceStatsPrefix + "names"
+	nodeStatsPrefix      = "/sys/node/"
+	nodeStatsNames       = nodeStatsPrefix + "names"
+	errorStatsPrefix     = "/err/"
+)
+
+// maxRetries is the number of attempts to read the stats when the directory changes
+const maxRetries = 3
+
+// implements prometheus.Collector
+var _ prometheus.Collector = (*Collector)(nil)
+
+// Option is a Collector option
+type Option func(*Collector)
+
+// WithNamespace sets the prefix of metric names, defaults to DefaultNamespace.
+func WithNamespace(namespace string) Option {
+	return func(c *Collector) {
+		c.namespace = namespace
+	}
+}
+
+// WithInclude sets regex patterns of the exported stats, all stats are exported by default.
+func WithInclude(patterns ...string) Option {
+	return func(c *Collector) {
+		c.include = append(c.include, patterns...)
+	}
+}
+
+// WithExclude sets regex patterns of the stats excluded from export.
+func WithExclude(patterns ...string) Option {
+	return func(c *Collector) {
+		c.exclude = append(c.exclude, patterns...)
+	}
+}
+
+// WithPerThread sets whether the counters are exported for every thread
+// separately with the thread label (default), or reduced for all threads.
+func WithPerThread(perThread bool) Option {
+	return func(c *Collector) {
+		c.perThread = perThread
+	}
+}
+
+// Collector is a prometheus.Collector exporting VPP stats.
+type Collector struct {
+	stats     adapter.StatsAPI
+	namespace string
+	include   []string
+	exclude   []string
+	perThread bool
+
+	excludeRe []*regexp.Regexp
+	errorDesc *prometheus.Desc
+
+	mu      sync.Mutex
+	dir     *adapter.StatDir
+	metrics []metricEntry
+	descs   map[string]*prometheus.Desc // by name and label names
+	threads []string                    // cached thread label values
+}
+
+// metricEntry describes how the stat entry on the same position
+// in the stat dir is exported
+type metricEntry struct {
+	desc      *prometheus.Desc
+	bytesDesc *prometheus.Desc
+	valueType prometheus.ValueType
+	// labels are the resolved names of the vector indexes
+	labels []string
+	// constLabels are label values preceding the index label
+	constLabels []string
+}
+
+// NewCollector returns a new collector exporting stats read by the stats adapter.
+// The adapter must be connected before the collector is used.
+func NewCollector(stats adapter.StatsAPI, options ...Option) (*Collector, error) {
+	c := &Collector{
+		stats:     stats,
+		namespace: DefaultNamespace,
+		perThread: true,
+		descs:     make(map[string]*prometheus.Desc),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	for _, pattern := range c.include {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("compiling regexp failed: %v", err)
+		}
+	}
+	for _, pattern := range c.exclude {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compiling regexp failed: %v", err)
+		}
+		c.excludeRe = append(c.excludeRe, r)
+	}
+	c.errorDesc = prometheus.NewDesc(prometheus.BuildFQName(c.namespace, "stats", "error"), "Error reading VPP stats", nil, nil)
+	return c, nil
+}
+
+// Describe does not send any descriptors since the exported metrics depend
+// on the stats present in the stats segment, which makes the collector unchecked.
+func (c *Collector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect reads the stats and sends them as metrics.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.update(); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.errorDesc, err)
+		return
+	}
+	for i, entry := range c.dir.Entries {
+		if c.metrics[i].desc != nil {
+			c.collectEntry(ch, &c.metrics[i], entry.Data)
+		}
+	}
+}
+
+// update reads the stats, the stat dir is prepared again when it changes.
+func (c *Collector) update() error {
+	var err error
+	for r := 0; r < maxRetries; r++ {
+		if c.dir == nil {
+			err = c.prepare()
+		} else if err = c.stats.UpdateDir(c.dir); err != nil {
+			c.dir = nil
+		}
+		if !errors.Is(err, adapter.ErrStatsDirStale) && !errors.Is(err, adapter.ErrStatsDataBusy) {
+			break
+		}
+	}
+	return err
+}
+
+// prepare prepares the stat dir and resolves metrics for its entries.
+func (c *Collector) prepare() error {
+	patterns := c.include
+	if len(patterns) > 0 {
+		// names are required for resolving labels
+		patterns = append(patterns[:len(patterns):len(patterns)], "^"+interfaceStatsNames+"$", "^"+nodeStatsNames+"$")
+	}
+	dir, err := c.stats.PrepareDir(patterns...)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string][]string)
+	for _, entry := range dir.Entries {
+		if s, ok := entry.Data.(adapter.NameStat); ok {
+			name := string(entry.Name)
+			names[name] = make([]string, len(s))
+			for i, n := range s {
+				names[name][i] = string(n)
+			}
+		}
+	}
+
+	entries := dir.Entries[:0]
+	c.metrics = c.metrics[:0]
+	for _, entry := range dir.Entries {
+		if c.excluded(entry.Name) {
+			continue
+		}
+		entries = append(entries, entry)
+		c.metrics = append(c.metrics, c.newMetricEntry(entry, names))
+	}
+	dir.Entries = entries
+	c.dir = dir
+	return nil
+}
+
+func (c *Collector) excluded(name []byte) bool {
+	for _, r := range c.excludeRe {
+		if r.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newMetricEntry resolves the metric name, type and labels for the stat entry,
+// the entries which are not exported have nil desc.
+func (c *Collector) newMetricEntry(entry adapter.StatEntry, names map[string][]string) metricEntry {
+	name := string(entry.Name)
+	if entry.Symlink || entry.Data == nil {
+		// symlinks duplicate the values of other entries
+		return metricEntry{}
+	}
+	var m metricEntry
+	var subsystem, metric, help string
+	var labelNames []string
+	switch {
+	case strings.HasPrefix(name, errorStatsPrefix) && isCounter(entry.Data):
+		node, reason, _ := strings.Cut(strings.TrimPrefix(name, errorStatsPrefix), "/")
+		subsystem, metric, help = "", "errors", "VPP error counters"
+		labelNames = []string{"node", "reason"}
+		m.constLabels = []string{node, reason}
+	case strings.HasPrefix(name, interfaceStatsPrefix):
+		subsystem, metric = "interface", strings.TrimPrefix(name, interfaceStatsPrefix)
+		labelNames = []string{"interface"}
+		m.labels = names[interfaceStatsNames]
+	case strings.HasPrefix(name, nodeStatsPrefix):
+		subsystem, metric = "node", strings.TrimPrefix(name, nodeStatsPrefix)
+		labelNames = []string{"node"}
+		m.labels = names[nodeStatsNames]
+	default:
+		metric = name
+	}
+	if help == "" {
+		help = "VPP stat " + name
+	}
+
+	switch entry.Data.(type) {
+	case adapter.ScalarStat, adapter.GaugeStat:
+		m.valueType = prometheus.GaugeValue
+		m.desc = c.desc(subsystem, metric, "", help, nil)
+		return m
+	case adapter.ErrorStat:
+		m.valueType = prometheus.CounterValue
+	case adapter.SimpleCounterStat:
+		m.valueType = prometheus.CounterValue
+	case adapter.CombinedCounterStat:
+		m.valueType = prometheus.CounterValue
+		if labelNames == nil {
+			labelNames = []string{"index"}
+		}
+		m.desc = c.desc(subsystem, metric, "packets_total", help+" (packets)", c.withThread(labelNames))
+		m.bytesDesc = c.desc(subsystem, metric, "bytes_total", help+" (bytes)", c.withThread(labelNames))
+		return m
+	case adapter.HistogramLog2Stat:
+		m.desc = c.desc(subsystem, metric, "", help, c.withThread(nil))
+		return m
+	default:
+		return metricEntry{}
+	}
+	if labelNames == nil {
+		labelNames = []string{"index"}
+	}
+	m.desc = c.desc(subsystem, metric, "total", help, c.withThread(labelNames))
+	return m
+}
+
+func (c *Collector) withThread(labelNames []string) []string {
+	if c.perThread {
+		return append(labelNames[:len(labelNames):len(labelNames)], "thread")
+	}
+	return labelNames
+}
+
+// desc returns cached metric descriptor, the metrics with the same name
+// and label names share the descriptor.
+func (c *Collector) desc(subsystem, metric, suffix, help string, labelNames []string) *prometheus.Desc {
+	fqName := prometheus.BuildFQName(c.namespace, subsystem, metricName(metric))
+	if suffix != "" {
+		fqName += "_" + suffix
+	}
+	key := strings.Join(append([]string{fqName}, labelNames...), "\x00")
+	if d, ok := c.descs[key]; ok {
+		return d
+	}
+	d := prometheus.NewDesc(fqName, help, labelNames, nil)
+	c.descs[key] = d
+	return d
+}
+
+var invalidMetricChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// metricName converts the stat name to a valid metric name.
+func metricName(name string) string {
+	return strings.Trim(invalidMetricChars.ReplaceAllString(name, "_"), "_")
+}
+
+// thread returns the label value for the thread index.
+func (c *Collector) thread(t int) string {
+	for len(c.threads) <= t {
+		c.threads = append(c.threads, strconv.Itoa(len(c.threads)))
+	}
+	return c.threads[t]
+}
+
+// label returns the label value for the vector index. The indexes without
+// name, e.g. of deleted interfaces, are labeled by the index to keep the
+// series unique.
+func (m *metricEntry) label(i int) string {
+	if i < len(m.labels) && m.labels[i] != "" {
+		return m.labels[i]
+	}
+	return strconv.Itoa(i)
+}
+
+func (c *Collector) collectEntry(ch chan<- prometheus.Metric, m *metricEntry, data adapter.Stat) {
+	send := func(desc *prometheus.Desc, val float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, m.valueType, val, labels...)
+	}
+	switch s := data.(type) {
+	case adapter.ScalarStat:
+		send(m.desc, float64(s))
+	case adapter.GaugeStat:
+		send(m.desc, float64(s))
+	case adapter.ErrorStat:
+		c.collectErrors(m, len(s), func(t int) uint64 { return uint64(s[t]) }, send)
+	case adapter.SimpleCounterStat:
+		if m.constLabels != nil {
+			// error counters have single value per thread
+			c.collectErrors(m, len(s), func(t int) (val uint64) {
+				for _, v := range s[t] {
+					val += uint64(v)
+				}
+				return val
+			}, send)
+			return
+		}
+		if c.perThread {
+			for t, thread := range s {
+				for i, val := range thread {
+					send(m.desc, float64(val), m.label(i), c.thread(t))
+				}
+			}
+			return
+		}
+		for i, val := range adapter.ReduceSimpleCounterStat(s) {
+			send(m.desc, float64(val), m.label(i))
+		}
+	case adapter.CombinedCounterStat:
+		if c.perThread {
+			for t, thread := range s {
+				for i, val := range thread {
+					send(m.desc, float64(val.Packets()), m.label(i), c.thread(t))
+					send(m.bytesDesc, float64(val.Bytes()), m.label(i), c.thread(t))
+				}
+			}
+			return
+		}
+		for i, val := range adapter.ReduceCombinedCounterStat(s) {
+			send(m.desc, float64(val[0]), m.label(i))
+			send(m.bytesDesc, float64(val[1]), m.label(i))
+		}
+	case adapter.HistogramLog2Stat:
+		if c.perThread {
+			for t, bin := range s {
+				ch <- histogram(m.desc, bin, c.thread(t))
+			}
+			return
+		}
+		ch <- histogram(m.desc, s.Merge())
+	}
+}
+
+func (c *Collector) collectErrors(m *metricEntry, threads int, value func(t int) uint64, send func(*prometheus.Desc, float64, ...string)) {
+	if c.perThread {
+		for t := 0; t < threads; t++ {
+			send(m.desc, float64(value(t)), m.constLabels[0], m.constLabels[1], c.thread(t))
+		}
+		return
+	}
+	var total uint64
+	for t := 0; t < threads; t++ {
+		total += value(t)
+	}
+	send(m.desc, float64(total), m.constLabels...)
+}
+
+func histogram(desc *prometheus.Desc, bin adapter.HistogramLog2Bin, labels ...string) prometheus.Metric {
+	total := bin.Total()
+	var sum float64
+	if total > 0 {
+		// the sum is estimated from the bin midpoints
+		sum = bin.Mean() * float64(total)
+	}
+	buckets := make(map[float64]uint64, len(bin.Counts))
+	for _, b := range bin.ClassicBuckets() {
+		if !math.IsInf(b.UpperBound, 1) {
+			buckets[b.UpperBound] = b.CumulativeCount
+		}
+	}
+	return prometheus.MustNewConstHistogram(desc, total, sum, buckets, labels...)
+}
+
+// isCounter returns true for the stats holding single counter per thread and index.
+func isCounter(data adapter.Stat) bool {
+	switch data.(type) {
+	case adapter.ErrorStat, adapter.SimpleCounterStat:
+		return true
+	}
+	return false
+}
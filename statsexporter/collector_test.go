@@ -0,0 +1,176 @@
+//  Copyright (c) 2026 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package statsexporter
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"go.fd.io/govpp/adapter"
+	"go.fd.io/govpp/adapter/statsnapshot"
+)
+
+func newEntry(index uint32, name string, data adapter.Stat) adapter.StatEntry {
+	return adapter.StatEntry{
+		StatIdentifier: adapter.StatIdentifier{Index: index, Name: []byte(name)},
+		Type:           data.Type(),
+		Data:           data,
+	}
+}
+
+func testStats() adapter.StatsAPI {
+	return statsnapshot.NewSnapshotStatsAdapter(&statsnapshot.Snapshot{
+		Entries: []adapter.StatEntry{
+			newEntry(0, "/sys/vector_rate", adapter.ScalarStat(2.5)),
+			newEntry(1, "/if/names", adapter.NameStat{adapter.Name("local0"), adapter.Name("eth0")}),
+			newEntry(2, "/if/drops", adapter.SimpleCounterStat{{1, 2}, {3, 4}}),
+			newEntry(3, "/if/rx", adapter.CombinedCounterStat{{{1, 64}, {2, 128}}, {{3, 192}, {4, 256}}}),
+			newEntry(4, "/sys/node/names", adapter.NameStat{adapter.Name("ip4-input")}),
+			newEntry(5, "/sys/node/calls", adapter.SimpleCounterStat{{5}, {6}}),
+			newEntry(6, "/err/ip4-input/bad checksum", adapter.SimpleCounterStat{{7}, {8}}),
+			newEntry(7, "/mem/statseg/used", adapter.GaugeStat(1024)),
+			newEntry(8, "/latency", adapter.HistogramLog2Stat{{MinExp: 0, Counts: []uint64{1, 2}}, {MinExp: 0, Counts: []uint64{0, 1}}}),
+			{StatIdentifier: adapter.StatIdentifier{Index: 9, Name: []byte("/interfaces/eth0/drops")}, Type: adapter.Symlink,
+				Symlink: true, Data: adapter.SimpleCounterStat{{2}, {4}}},
+		},
+	})
+}
+
+func TestCollectorReduced(t *testing.T) {
+	RegisterTestingT(t)
+
+	c, err := NewCollector(testStats(), WithPerThread(false), WithExclude("^/latency"))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP vpp_errors_total VPP error counters
+# TYPE vpp_errors_total counter
+vpp_errors_total{node="ip4-input",reason="bad checksum"} 15
+# HELP vpp_interface_drops_total VPP stat /if/drops
+# TYPE vpp_interface_drops_total counter
+vpp_interface_drops_total{interface="local0"} 4
+vpp_interface_drops_total{interface="eth0"} 6
+# HELP vpp_interface_rx_bytes_total VPP stat /if/rx (bytes)
+# TYPE vpp_interface_rx_bytes_total counter
+vpp_interface_rx_bytes_total{interface="local0"} 256
+vpp_interface_rx_bytes_total{interface="eth0"} 384
+# HELP vpp_interface_rx_packets_total VPP stat /if/rx (packets)
+# TYPE vpp_interface_rx_packets_total counter
+vpp_interface_rx_packets_total{interface="local0"} 4
+vpp_interface_rx_packets_total{interface="eth0"} 6
+# HELP vpp_mem_statseg_used VPP stat /mem/statseg/used
+# TYPE vpp_mem_statseg_used gauge
+vpp_mem_statseg_used 1024
+# HELP vpp_node_calls_total VPP stat /sys/node/calls
+# TYPE vpp_node_calls_total counter
+vpp_node_calls_total{node="ip4-input"} 11
+# HELP vpp_sys_vector_rate VPP stat /sys/vector_rate
+# TYPE vpp_sys_vector_rate gauge
+vpp_sys_vector_rate 2.5
+`))).To(Succeed())
+}
+
+func TestCollectorPerThread(t *testing.T) {
+	RegisterTestingT(t)
+
+	c, err := NewCollector(testStats(), WithNamespace("test"), WithInclude("^/if/drops", "^/err/", "^/latency"))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP test_errors_total VPP error counters
+# TYPE test_errors_total counter
+test_errors_total{node="ip4-input",reason="bad checksum",thread="0"} 7
+test_errors_total{node="ip4-input",reason="bad checksum",thread="1"} 8
+# HELP test_interface_drops_total VPP stat /if/drops
+# TYPE test_interface_drops_total counter
+test_interface_drops_total{interface="local0",thread="0"} 1
+test_interface_drops_total{interface="eth0",thread="0"} 2
+test_interface_drops_total{interface="local0",thread="1"} 3
+test_interface_drops_total{interface="eth0",thread="1"} 4
+# HELP test_latency VPP stat /latency
+# TYPE test_latency histogram
+test_latency_bucket{thread="0",le="1"} 1
+test_latency_bucket{thread="0",le="3"} 3
+test_latency_bucket{thread="0",le="+Inf"} 3
+test_latency_sum{thread="0"} 6
+test_latency_count{thread="0"} 3
+test_latency_bucket{thread="1",le="1"} 0
+test_latency_bucket{thread="1",le="3"} 1
+test_latency_bucket{thread="1",le="+Inf"} 1
+test_latency_sum{thread="1"} 2.5
+test_latency_count{thread="1"} 1
+`))).To(Succeed())
+}
+
+func TestCollectorDeletedInterface(t *testing.T) {
+	RegisterTestingT(t)
+
+	stats := statsnapshot.NewSnapshotStatsAdapter(&statsnapshot.Snapshot{
+		Entries: []adapter.StatEntry{
+			// the names of deleted interfaces are cleared
+			newEntry(1, "/if/names", adapter.NameStat{adapter.Name("local0"), nil, adapter.Name(""), adapter.Name("eth1")}),
+			newEntry(2, "/if/drops", adapter.SimpleCounterStat{{1, 2, 3, 4, 5}}),
+			newEntry(3, "/latency", adapter.HistogramLog2Stat{{MinExp: 0, Counts: []uint64{0, 0}}}),
+		},
+	})
+	c, err := NewCollector(stats, WithPerThread(false))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP vpp_interface_drops_total VPP stat /if/drops
+# TYPE vpp_interface_drops_total counter
+vpp_interface_drops_total{interface="local0"} 1
+vpp_interface_drops_total{interface="1"} 2
+vpp_interface_drops_total{interface="2"} 3
+vpp_interface_drops_total{interface="eth1"} 4
+vpp_interface_drops_total{interface="4"} 5
+# HELP vpp_latency VPP stat /latency
+# TYPE vpp_latency histogram
+vpp_latency_bucket{le="1"} 0
+vpp_latency_bucket{le="3"} 0
+vpp_latency_bucket{le="+Inf"} 0
+vpp_latency_sum 0
+vpp_latency_count 0
+`))).To(Succeed())
+}
+
+func TestCollectorInvalidPattern(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := NewCollector(testStats(), WithExclude("("))
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestCollectorDesc(t *testing.T) {
+	RegisterTestingT(t)
+
+	c, err := NewCollector(testStats())
+	Expect(err).ShouldNot(HaveOccurred())
+
+	d := c.desc("interface", "drops", "total", "help", []string{"interface"})
+	Expect(c.desc("interface", "drops", "total", "other help", []string{"interface"})).To(BeIdenticalTo(d))
+	Expect(c.desc("interface", "drops", "total", "help", []string{"interface", "thread"})).ToNot(BeIdenticalTo(d))
+	Expect(c.desc("interface", "drops", "total", "help", nil)).ToNot(BeIdenticalTo(d))
+}
+
+func TestMetricName(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(metricName("/nat44-ed/total-users")).To(Equal("nat44_ed_total_users"))
+	Expect(metricName("/mem/stat segment/used")).To(Equal("mem_stat_segment_used"))
+}